@@ -2,11 +2,26 @@ package cmd
 
 import (
 	"database/sql"
+	"time"
 
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application"
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/adapter/notification"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/adapter/postgres"
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/adapter/postgres/database"
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/serialization"
 	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	maxAccountRecoveryAttempts          = uint(5)
+	maxAccountRecoveryAttemptsPerClient = uint(20)
+	accountRecoveryAttemptsWindow       = 15 * time.Minute
+	maxMFACodeAttempts                  = uint(5)
+	mfaCodeAttemptsWindow               = 5 * time.Minute
+	minIntegrityKeyLength               = 32
 )
 
 func Bootstrap(config *Config, logger *shared.Logger) (*DIContainer, error) {
@@ -48,13 +63,48 @@ func Bootstrap(config *Config, logger *shared.Logger) (*DIContainer, error) {
 
 	/***/
 
+	secretCipher, err := serialization.NewSecretCipherFromBase64(config.Encryption.EventSecretsKey)
+	if err != nil {
+		logger.Errorf("bootstrap: failed to create the cipher for event secrets: %s", err)
+
+		return nil, err
+	}
+
+	if err = assertIntegrityKeys(config); err != nil {
+		logger.Errorf("bootstrap: the event store integrity keys are invalid: %s", err)
+
+		return nil, err
+	}
+
+	eventPayloadCodecs, err := buildEventPayloadCodecs(config.EventStore.PayloadCodec, secretCipher)
+	if err != nil {
+		logger.Errorf("bootstrap: failed to build the event payload codecs: %s", err)
+
+		return nil, err
+	}
+
+	sendAccountRecoveryToken, err := buildAccountRecoveryTokenSender(config, logger)
+	if err != nil {
+		logger.Errorf("bootstrap: failed to build the account recovery token sender: %s", err)
+
+		return nil, err
+	}
+
+	/***/
+
 	logger.Info("bootstrap: building DI container ...")
 
 	diContainer, err := NewDIContainer(
 		db,
-		serialization.MarshalCustomerEvent,
-		serialization.UnmarshalCustomerEvent,
+		eventPayloadCodecs,
 		customer.BuildUniqueEmailAddressAssertions,
+		sendAccountRecoveryToken,
+		postgres.NewRateLimiter(db, rateLimitWindowsTableName, maxAccountRecoveryAttempts, accountRecoveryAttemptsWindow).Allow,
+		postgres.NewRateLimiter(db, rateLimitWindowsTableName, maxAccountRecoveryAttemptsPerClient, accountRecoveryAttemptsWindow).Allow,
+		postgres.NewRateLimiter(db, rateLimitWindowsTableName, maxMFACodeAttempts, mfaCodeAttemptsWindow).Allow,
+		buildBackgroundTaskRunner(logger),
+		[]byte(config.Integrity.ChainKey),
+		[]byte(config.Integrity.TombstoneSigningKey),
 	)
 	if err != nil {
 		logger.Errorf("bootstrap: failed to build the DI container: %s", err)
@@ -64,3 +114,89 @@ func Bootstrap(config *Config, logger *shared.Logger) (*DIContainer, error) {
 
 	return diContainer, nil
 }
+
+// assertIntegrityKeys rejects short keys, because the hash chains and tombstones are only as strong as their keys.
+func assertIntegrityKeys(config *Config) error {
+	keys := []struct {
+		envKey string
+		value  string
+	}{
+		{envKey: ConfigExpectedEnvKeys["intECK"], value: config.Integrity.ChainKey},
+		{envKey: ConfigExpectedEnvKeys["intTSK"], value: config.Integrity.TombstoneSigningKey},
+	}
+
+	for _, key := range keys {
+		if len(key.value) < minIntegrityKeyLength {
+			err := errors.Newf("%s must have at least %d bytes", key.envKey, minIntegrityKeyLength)
+			return shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, "assertIntegrityKeys")
+		}
+	}
+
+	return nil
+}
+
+// buildAccountRecoveryTokenSender only falls back to logging instead of sending if the dev mode is explicitly enabled.
+func buildAccountRecoveryTokenSender(config *Config, logger *shared.Logger) (application.ForSendingAccountRecoveryTokens, error) {
+	smtpConfig := config.Notification
+
+	if smtpConfig.DevMode {
+		logger.Warn("bootstrap: notification dev mode is enabled, account recovery tokens are not sent")
+
+		return notification.NewLoggingAccountRecoveryTokenSender(logger).SendAccountRecoveryToken, nil
+	}
+
+	if smtpConfig.SMTPHostAndPort == "" || smtpConfig.SMTPSenderAddress == "" {
+		err := errors.New("the SMTP host and sender address must be configured if the notification dev mode is disabled")
+		return nil, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, "buildAccountRecoveryTokenSender")
+	}
+
+	sender := notification.NewSMTPAccountRecoveryTokenSender(
+		smtpConfig.SMTPHostAndPort,
+		smtpConfig.SMTPUsername,
+		smtpConfig.SMTPPassword,
+		smtpConfig.SMTPSenderAddress,
+	)
+
+	return sender.SendAccountRecoveryToken, nil
+}
+
+// buildBackgroundTaskRunner can only log failed tasks, because nobody waits for them.
+func buildBackgroundTaskRunner(logger *shared.Logger) application.ForRunningBackgroundTasks {
+	return func(task func() error) {
+		go func() {
+			if err := task(); err != nil {
+				logger.Errorf("background task failed: %s", err)
+			}
+		}()
+	}
+}
+
+// buildEventPayloadCodecs always supports reading both formats, payloadCodec (json or protobuf) decides how new events are written.
+func buildEventPayloadCodecs(payloadCodec string, secretCipher *serialization.SecretCipher) (es.EventPayloadCodecs, error) {
+	var codecs es.EventPayloadCodecs
+
+	switch payloadCodec {
+	case "json":
+		codecs.WriteFormat = es.JSONPayloads
+	case "protobuf":
+		codecs.WriteFormat = es.BinaryPayloads
+	default:
+		err := errors.Newf("unknown event payload codec [%s], expected json or protobuf", payloadCodec)
+		return codecs, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, "buildEventPayloadCodecs")
+	}
+
+	jsonRegistry, err := serialization.NewCustomerEventRegistry(secretCipher)
+	if err != nil {
+		return codecs, errors.Wrap(err, "buildEventPayloadCodecs")
+	}
+
+	protobufRegistry, err := serialization.NewCustomerEventProtobufRegistry(secretCipher)
+	if err != nil {
+		return codecs, errors.Wrap(err, "buildEventPayloadCodecs")
+	}
+
+	codecs.MarshalJSON, codecs.UnmarshalJSON = jsonRegistry.Marshal, jsonRegistry.Unmarshal
+	codecs.MarshalBinary, codecs.UnmarshalBinary = protobufRegistry.Marshal, protobufRegistry.Unmarshal
+
+	return codecs, nil
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"os"
+	"strconv"
 
 	"github.com/AntonStoeckl/go-iddd/service/shared"
 	"github.com/cockroachdb/errors"
@@ -13,20 +14,50 @@ type Config struct {
 		MigrationsPathCustomer string
 	}
 	GRPC struct {
-		HostAndPort string
+		HostAndPort      string
+		AdminHostAndPort string
+		AdminToken       string
 	}
 	REST struct {
 		HostAndPort string
 	}
+	Encryption struct {
+		EventSecretsKey string
+	}
+	Integrity struct {
+		ChainKey            string
+		TombstoneSigningKey string
+	}
+	EventStore struct {
+		PayloadCodec string
+	}
+	Notification struct {
+		SMTPHostAndPort   string
+		SMTPUsername      string
+		SMTPPassword      string
+		SMTPSenderAddress string
+		DevMode           bool
+	}
 }
 
 // This is also used by Config_test.go to check that all keys exist in Env,
 // so always add new keys here!
 var ConfigExpectedEnvKeys = map[string]string{
-	"pgDSN":  "POSTGRES_DSN",
-	"pgMPC":  "POSTGRES_MIGRATIONS_PATH_CUSTOMER",
-	"grpcHP": "GRPC_HOST_AND_PORT",
-	"restHP": "REST_HOST_AND_PORT",
+	"pgDSN":   "POSTGRES_DSN",
+	"pgMPC":   "POSTGRES_MIGRATIONS_PATH_CUSTOMER",
+	"grpcHP":  "GRPC_HOST_AND_PORT",
+	"grpcAHP": "GRPC_ADMIN_HOST_AND_PORT",
+	"grpcAT":  "GRPC_ADMIN_TOKEN",
+	"restHP":  "REST_HOST_AND_PORT",
+	"encESK":  "EVENT_SECRETS_ENCRYPTION_KEY",
+	"intECK":  "EVENT_CHAIN_KEY",
+	"intTSK":  "EVENT_TOMBSTONE_SIGNING_KEY",
+	"esEPC":   "EVENT_PAYLOAD_CODEC",
+	"smtpHP":  "SMTP_HOST_AND_PORT",
+	"smtpUN":  "SMTP_USERNAME",
+	"smtpPW":  "SMTP_PASSWORD",
+	"smtpSA":  "SMTP_SENDER_ADDRESS",
+	"ntfDM":   "NOTIFICATION_DEV_MODE",
 }
 
 func MustBuildConfigFromEnv(logger *shared.Logger) *Config {
@@ -46,10 +77,54 @@ func MustBuildConfigFromEnv(logger *shared.Logger) *Config {
 		logger.Panicf(msg, err)
 	}
 
+	if conf.GRPC.AdminHostAndPort, err = conf.stringFromEnv(ConfigExpectedEnvKeys["grpcAHP"]); err != nil {
+		logger.Panicf(msg, err)
+	}
+
+	if conf.GRPC.AdminToken, err = conf.stringFromEnv(ConfigExpectedEnvKeys["grpcAT"]); err != nil {
+		logger.Panicf(msg, err)
+	}
+
 	if conf.REST.HostAndPort, err = conf.stringFromEnv(ConfigExpectedEnvKeys["restHP"]); err != nil {
 		logger.Panicf(msg, err)
 	}
 
+	if conf.Encryption.EventSecretsKey, err = conf.stringFromEnv(ConfigExpectedEnvKeys["encESK"]); err != nil {
+		logger.Panicf(msg, err)
+	}
+
+	if conf.Integrity.ChainKey, err = conf.stringFromEnv(ConfigExpectedEnvKeys["intECK"]); err != nil {
+		logger.Panicf(msg, err)
+	}
+
+	if conf.Integrity.TombstoneSigningKey, err = conf.stringFromEnv(ConfigExpectedEnvKeys["intTSK"]); err != nil {
+		logger.Panicf(msg, err)
+	}
+
+	if conf.EventStore.PayloadCodec, err = conf.stringFromEnv(ConfigExpectedEnvKeys["esEPC"]); err != nil {
+		logger.Panicf(msg, err)
+	}
+
+	if conf.Notification.SMTPHostAndPort, err = conf.stringFromEnv(ConfigExpectedEnvKeys["smtpHP"]); err != nil {
+		logger.Panicf(msg, err)
+	}
+
+	if conf.Notification.SMTPUsername, err = conf.stringFromEnv(ConfigExpectedEnvKeys["smtpUN"]); err != nil {
+		logger.Panicf(msg, err)
+	}
+
+	if conf.Notification.SMTPPassword, err = conf.stringFromEnv(ConfigExpectedEnvKeys["smtpPW"]); err != nil {
+		logger.Panicf(msg, err)
+	}
+
+	if conf.Notification.SMTPSenderAddress, err = conf.stringFromEnv(ConfigExpectedEnvKeys["smtpSA"]); err != nil {
+		logger.Panicf(msg, err)
+	}
+
+	if conf.Notification.DevMode, err = conf.boolFromEnv(ConfigExpectedEnvKeys["ntfDM"]); err != nil {
+		logger.Panicf(msg, err)
+	}
+
 	return conf
 }
 
@@ -61,3 +136,17 @@ func (conf Config) stringFromEnv(envKey string) (string, error) {
 
 	return envVal, nil
 }
+
+func (conf Config) boolFromEnv(envKey string) (bool, error) {
+	envVal, err := conf.stringFromEnv(envKey)
+	if err != nil {
+		return false, err
+	}
+
+	boolVal, err := strconv.ParseBool(envVal)
+	if err != nil {
+		return false, errors.Mark(errors.Newf("config value [%s] must be true or false", envKey), shared.ErrTechnical)
+	}
+
+	return boolVal, nil
+}
@@ -14,25 +14,58 @@ import (
 
 const (
 	eventStoreTableName           = "eventstore"
+	streamsTableName              = "eventstore_streams"
+	tombstonesTableName           = "eventstore_tombstones"
+	quarantineTableName           = "eventstore_quarantine"
 	uniqueEmailAddressesTableName = "unique_email_addresses"
+	customAttributeDefsTableName  = "custom_attribute_definitions"
+	customerListTableName         = "customer_list"
+	duplicateCandidatesTableName  = "duplicate_customer_candidates"
+	funnelEventsTableName         = "customer_funnel_events"
+	funnelRegistrationsTableName  = "customer_funnel_registrations"
+	rateLimitWindowsTableName     = "rate_limit_windows"
 )
 
 type DIContainer struct {
 	postgresDBConn                    *sql.DB
 	customerEventStore                *postgres.CustomerEventStore
-	marshalCustomerEvent              es.MarshalDomainEvent
-	unmarshalCustomerEvent            es.UnmarshalDomainEvent
+	eventStoreIntegrity               *postgres.EventStoreIntegrity
+	eventQuarantine                   *es.EventQuarantine
+	customAttributeDefinitions        *postgres.CustomAttributeDefinitions
+	customerList                      *postgres.CustomerList
+	duplicateCustomerCandidates       *postgres.DuplicateCustomerCandidates
+	customerFunnelAnalytics           *postgres.CustomerFunnelAnalytics
+	eventPayloadCodecs                es.EventPayloadCodecs
 	buildUniqueEmailAddressAssertions customer.ForBuildingUniqueEmailAddressAssertions
 	customerCommandHandler            *application.CustomerCommandHandler
 	customerQueryHandler              *application.CustomerQueryHandler
+	customAttributeDefCommandHandler  *application.CustomAttributeDefinitionCommandHandler
+	accountRecoveryCommandHandler     *application.AccountRecoveryCommandHandler
+	duplicateCustomerHandler          *application.DuplicateCustomerHandler
+	customerAnalyticsQueryHandler     *application.CustomerAnalyticsQueryHandler
+	sendAccountRecoveryToken          application.ForSendingAccountRecoveryTokens
+	limitAccountRecoveryAttempts      application.ForLimitingAccountRecoveryAttempts
+	limitAccountRecoveryPerClient     application.ForLimitingAccountRecoveryAttempts
+	limitMFACodeAttempts              application.ForLimitingMFACodeAttempts
+	runInBackground                   application.ForRunningBackgroundTasks
+	chainKey                          []byte
+	tombstoneSigningKey               []byte
 	customerGRPCServer                customergrpc.CustomerServer
+	eventStoreAdminGRPCServer         customergrpc.EventStoreAdminServer
+	customerAdminGRPCServer           customergrpc.CustomerAdminServer
 }
 
 func NewDIContainer(
 	postgresDBConn *sql.DB,
-	marshalCustomerEvent es.MarshalDomainEvent,
-	unmarshalCustomerEvent es.UnmarshalDomainEvent,
+	eventPayloadCodecs es.EventPayloadCodecs,
 	buildUniqueEmailAddressAssertions customer.ForBuildingUniqueEmailAddressAssertions,
+	sendAccountRecoveryToken application.ForSendingAccountRecoveryTokens,
+	limitAccountRecoveryAttempts application.ForLimitingAccountRecoveryAttempts,
+	limitAccountRecoveryPerClient application.ForLimitingAccountRecoveryAttempts,
+	limitMFACodeAttempts application.ForLimitingMFACodeAttempts,
+	runInBackground application.ForRunningBackgroundTasks,
+	chainKey []byte,
+	tombstoneSigningKey []byte,
 ) (*DIContainer, error) {
 
 	if postgresDBConn == nil {
@@ -41,9 +74,15 @@ func NewDIContainer(
 
 	container := &DIContainer{
 		postgresDBConn:                    postgresDBConn,
-		marshalCustomerEvent:              marshalCustomerEvent,
-		unmarshalCustomerEvent:            unmarshalCustomerEvent,
+		eventPayloadCodecs:                eventPayloadCodecs,
 		buildUniqueEmailAddressAssertions: buildUniqueEmailAddressAssertions,
+		sendAccountRecoveryToken:          sendAccountRecoveryToken,
+		limitAccountRecoveryAttempts:      limitAccountRecoveryAttempts,
+		limitAccountRecoveryPerClient:     limitAccountRecoveryPerClient,
+		limitMFACodeAttempts:              limitMFACodeAttempts,
+		runInBackground:                   runInBackground,
+		chainKey:                          chainKey,
+		tombstoneSigningKey:               tombstoneSigningKey,
 	}
 
 	container.init()
@@ -52,10 +91,22 @@ func NewDIContainer(
 }
 
 func (container DIContainer) init() {
+	container.GetCustomerList()
+	container.GetCustomerFunnelAnalytics()
+	container.GetEventStoreIntegrity()
+	container.GetEventQuarantine()
 	container.GetCustomerEventStore()
+	container.GetCustomAttributeDefinitions()
+	container.GetDuplicateCustomerCandidates()
 	container.GetCustomerCommandHandler()
 	container.GetCustomerQueryHandler()
+	container.GetCustomAttributeDefinitionCommandHandler()
+	container.GetAccountRecoveryCommandHandler()
+	container.GetDuplicateCustomerHandler()
+	container.GetCustomerAnalyticsQueryHandler()
 	container.GetCustomerGRPCServer()
+	container.GetEventStoreAdminGRPCServer()
+	container.GetCustomerAdminGRPCServer()
 }
 
 func (container DIContainer) GetPostgresDBConn() *sql.DB {
@@ -67,22 +118,101 @@ func (container DIContainer) GetCustomerEventStore() *postgres.CustomerEventStor
 		container.customerEventStore = postgres.NewCustomerEventStore(
 			container.postgresDBConn,
 			eventStoreTableName,
-			container.marshalCustomerEvent,
-			container.unmarshalCustomerEvent,
+			streamsTableName,
+			container.chainKey,
+			container.eventPayloadCodecs,
 			uniqueEmailAddressesTableName,
 			container.buildUniqueEmailAddressAssertions,
+			container.GetCustomerList(),
+			container.GetCustomerFunnelAnalytics(),
+			container.GetEventStoreIntegrity(),
+			container.GetEventQuarantine(),
 		)
 	}
 
 	return container.customerEventStore
 }
 
+func (container DIContainer) GetEventQuarantine() *es.EventQuarantine {
+	if container.eventQuarantine == nil {
+		container.eventQuarantine = es.NewEventQuarantine(
+			container.postgresDBConn,
+			quarantineTableName,
+		)
+	}
+
+	return container.eventQuarantine
+}
+
+func (container DIContainer) GetEventStoreIntegrity() *postgres.EventStoreIntegrity {
+	if container.eventStoreIntegrity == nil {
+		container.eventStoreIntegrity = postgres.NewEventStoreIntegrity(
+			container.postgresDBConn,
+			eventStoreTableName,
+			streamsTableName,
+			tombstonesTableName,
+			container.chainKey,
+			container.tombstoneSigningKey,
+		)
+	}
+
+	return container.eventStoreIntegrity
+}
+
+func (container DIContainer) GetCustomerList() *postgres.CustomerList {
+	if container.customerList == nil {
+		container.customerList = postgres.NewCustomerList(
+			container.postgresDBConn,
+			customerListTableName,
+		)
+	}
+
+	return container.customerList
+}
+
+func (container DIContainer) GetCustomerFunnelAnalytics() *postgres.CustomerFunnelAnalytics {
+	if container.customerFunnelAnalytics == nil {
+		container.customerFunnelAnalytics = postgres.NewCustomerFunnelAnalytics(
+			container.postgresDBConn,
+			funnelEventsTableName,
+			funnelRegistrationsTableName,
+		)
+	}
+
+	return container.customerFunnelAnalytics
+}
+
+func (container DIContainer) GetDuplicateCustomerCandidates() *postgres.DuplicateCustomerCandidates {
+	if container.duplicateCustomerCandidates == nil {
+		container.duplicateCustomerCandidates = postgres.NewDuplicateCustomerCandidates(
+			container.postgresDBConn,
+			duplicateCandidatesTableName,
+			customerListTableName,
+		)
+	}
+
+	return container.duplicateCustomerCandidates
+}
+
+func (container DIContainer) GetCustomAttributeDefinitions() *postgres.CustomAttributeDefinitions {
+	if container.customAttributeDefinitions == nil {
+		container.customAttributeDefinitions = postgres.NewCustomAttributeDefinitions(
+			container.postgresDBConn,
+			customAttributeDefsTableName,
+		)
+	}
+
+	return container.customAttributeDefinitions
+}
+
 func (container DIContainer) GetCustomerCommandHandler() *application.CustomerCommandHandler {
 	if container.customerCommandHandler == nil {
 		container.customerCommandHandler = application.NewCustomerCommandHandler(
 			container.GetCustomerEventStore().RetrieveEventStream,
 			container.GetCustomerEventStore().StartEventStream,
 			container.GetCustomerEventStore().AppendToEventStream,
+			container.GetCustomAttributeDefinitions().RetrieveDefinition,
+			container.limitMFACodeAttempts,
 		)
 	}
 
@@ -93,12 +223,63 @@ func (container DIContainer) GetCustomerQueryHandler() *application.CustomerQuer
 	if container.customerQueryHandler == nil {
 		container.customerQueryHandler = application.NewCustomerQueryHandler(
 			container.GetCustomerEventStore().RetrieveEventStream,
+			container.GetCustomerEventStore().RetrieveCustomerIDByEmailAddress,
+			container.GetCustomerList().RetrievePage,
+			container.GetCustomerList().Search,
 		)
 	}
 
 	return container.customerQueryHandler
 }
 
+func (container DIContainer) GetCustomAttributeDefinitionCommandHandler() *application.CustomAttributeDefinitionCommandHandler {
+	if container.customAttributeDefCommandHandler == nil {
+		container.customAttributeDefCommandHandler = application.NewCustomAttributeDefinitionCommandHandler(
+			container.GetCustomAttributeDefinitions().StoreDefinition,
+		)
+	}
+
+	return container.customAttributeDefCommandHandler
+}
+
+func (container DIContainer) GetAccountRecoveryCommandHandler() *application.AccountRecoveryCommandHandler {
+	if container.accountRecoveryCommandHandler == nil {
+		container.accountRecoveryCommandHandler = application.NewAccountRecoveryCommandHandler(
+			container.GetCustomerEventStore().RetrieveCustomerIDByEmailAddress,
+			container.GetCustomerEventStore().RetrieveEventStream,
+			container.GetCustomerEventStore().AppendToEventStream,
+			container.sendAccountRecoveryToken,
+			container.limitAccountRecoveryAttempts,
+			container.limitAccountRecoveryPerClient,
+			container.runInBackground,
+		)
+	}
+
+	return container.accountRecoveryCommandHandler
+}
+
+func (container DIContainer) GetDuplicateCustomerHandler() *application.DuplicateCustomerHandler {
+	if container.duplicateCustomerHandler == nil {
+		container.duplicateCustomerHandler = application.NewDuplicateCustomerHandler(
+			container.GetDuplicateCustomerCandidates().Detect,
+			container.GetDuplicateCustomerCandidates().RetrieveCandidates,
+			container.GetDuplicateCustomerCandidates().MarkAsNotDuplicate,
+		)
+	}
+
+	return container.duplicateCustomerHandler
+}
+
+func (container DIContainer) GetCustomerAnalyticsQueryHandler() *application.CustomerAnalyticsQueryHandler {
+	if container.customerAnalyticsQueryHandler == nil {
+		container.customerAnalyticsQueryHandler = application.NewCustomerAnalyticsQueryHandler(
+			container.GetCustomerFunnelAnalytics().RetrievePeriods,
+		)
+	}
+
+	return container.customerAnalyticsQueryHandler
+}
+
 func (container DIContainer) GetCustomerGRPCServer() customergrpc.CustomerServer {
 	if container.customerGRPCServer == nil {
 		container.customerGRPCServer = customergrpc.NewCustomerServer(
@@ -106,10 +287,49 @@ func (container DIContainer) GetCustomerGRPCServer() customergrpc.CustomerServer
 			container.GetCustomerCommandHandler().ConfirmCustomerEmailAddress,
 			container.GetCustomerCommandHandler().ChangeCustomerEmailAddress,
 			container.GetCustomerCommandHandler().ChangeCustomerName,
+			container.GetCustomerCommandHandler().SetCustomerPassword,
+			container.GetCustomerCommandHandler().ChangeCustomerPassword,
+			container.GetCustomerQueryHandler().VerifyCredentials,
+			container.GetAccountRecoveryCommandHandler().RequestAccountRecovery,
+			container.GetAccountRecoveryCommandHandler().CompleteAccountRecovery,
+			container.GetCustomerCommandHandler().StartCustomerMFAEnrollment,
+			container.GetCustomerCommandHandler().ConfirmCustomerMFAEnrollment,
+			container.GetCustomerCommandHandler().DisableCustomerMFA,
+			container.GetCustomerCommandHandler().VerifyCustomerMFACode,
+			container.GetCustomerCommandHandler().SetCustomerAttribute,
+			container.GetCustomAttributeDefinitionCommandHandler().DefineCustomAttribute,
 			container.GetCustomerCommandHandler().DeleteCustomer,
 			container.GetCustomerQueryHandler().CustomerViewByID,
+			container.GetCustomerQueryHandler().CustomerViewByEmailAddress,
+			container.GetCustomerQueryHandler().ListCustomers,
+			container.GetCustomerQueryHandler().SearchCustomers,
+			container.GetDuplicateCustomerHandler().ListDuplicateCandidates,
+			container.GetDuplicateCustomerHandler().MarkAsNotDuplicate,
+			container.GetCustomerAnalyticsQueryHandler().RegistrationFunnel,
+			container.GetCustomerQueryHandler().ExportCustomerData,
 		)
 	}
 
 	return container.customerGRPCServer
 }
+
+func (container DIContainer) GetEventStoreAdminGRPCServer() customergrpc.EventStoreAdminServer {
+	if container.eventStoreAdminGRPCServer == nil {
+		container.eventStoreAdminGRPCServer = customergrpc.NewEventStoreAdminServer(
+			container.GetCustomerEventStore().QueryEvents,
+			container.GetCustomerEventStore().CountEventsPerDay,
+		)
+	}
+
+	return container.eventStoreAdminGRPCServer
+}
+
+func (container DIContainer) GetCustomerAdminGRPCServer() customergrpc.CustomerAdminServer {
+	if container.customerAdminGRPCServer == nil {
+		container.customerAdminGRPCServer = customergrpc.NewCustomerAdminServer(
+			container.GetCustomerCommandHandler().ImportCustomer,
+		)
+	}
+
+	return container.customerAdminGRPCServer
+}
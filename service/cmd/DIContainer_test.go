@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
 	"github.com/AntonStoeckl/go-iddd/service/shared"
 	"github.com/AntonStoeckl/go-iddd/service/shared/es"
 	"github.com/cockroachdb/errors"
@@ -24,11 +25,25 @@ func TestNewDIContainer(t *testing.T) {
 			return nil, nil
 		}
 
+		eventPayloadCodecs := es.EventPayloadCodecs{
+			WriteFormat:     es.JSONPayloads,
+			MarshalJSON:     marshalDomainEvent,
+			UnmarshalJSON:   unmarshalDomainEvent,
+			MarshalBinary:   marshalDomainEvent,
+			UnmarshalBinary: unmarshalDomainEvent,
+		}
+
 		diContainer, err := NewDIContainer(
 			db,
-			marshalDomainEvent,
-			unmarshalDomainEvent,
+			eventPayloadCodecs,
 			customer.BuildUniqueEmailAddressAssertions,
+			func(emailAddress value.EmailAddress, recoveryToken string) error { return nil },
+			func(key string) error { return nil },
+			func(key string) error { return nil },
+			func(key string) error { return nil },
+			func(task func() error) { _ = task() },
+			[]byte("event-chain-key"),
+			[]byte("tombstone-signing-key"),
 		)
 
 		Convey("Then it should succeed", func() {
@@ -45,9 +60,15 @@ func TestNewDIContainer(t *testing.T) {
 
 		_, err := NewDIContainer(
 			db,
-			func(event es.DomainEvent) ([]byte, error) { return nil, nil },
-			func(name string, payload []byte, streamVersion uint) (es.DomainEvent, error) { return nil, nil },
+			es.EventPayloadCodecs{},
 			func(recordedEvents ...es.DomainEvent) customer.UniqueEmailAddressAssertions { return nil },
+			func(emailAddress value.EmailAddress, recoveryToken string) error { return nil },
+			func(key string) error { return nil },
+			func(key string) error { return nil },
+			func(key string) error { return nil },
+			func(task func() error) { _ = task() },
+			[]byte("event-chain-key"),
+			[]byte("tombstone-signing-key"),
 		)
 
 		Convey("Then it should fail", func() {
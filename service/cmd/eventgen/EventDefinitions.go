@@ -0,0 +1,336 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+	"unicode"
+
+	"github.com/cockroachdb/errors"
+)
+
+// EventDefinitions is the content of a definitions file, e.g. CustomerEvents.json.
+type EventDefinitions struct {
+	Name          string                         `json:"name"`
+	ProtoPackage  string                         `json:"protoPackage"`
+	DomainPackage string                         `json:"domainPackage"`
+	ValuePackage  string                         `json:"valuePackage"`
+	ValueTypes    map[string]ValueTypeDefinition `json:"valueTypes"`
+	Events        []EventDefinition              `json:"events"`
+}
+
+// ValueTypeDefinition describes how a value object is split into primitives for serialization and rebuilt from them.
+// A part without a name takes the name of the event field.
+type ValueTypeDefinition struct {
+	Rebuild string                `json:"rebuild"`
+	Parts   []ValuePartDefinition `json:"parts"`
+	Example string                `json:"example"`
+}
+
+// ValuePartDefinition can be optional, otherwise unmarshaling fails if a string part is missing or empty.
+// Optional parts are tagged with schema:"optional", so that their JSON Schema allows empty values.
+type ValuePartDefinition struct {
+	Name     string `json:"name"`
+	Getter   string `json:"getter"`
+	Type     string `json:"type"`
+	Optional bool   `json:"optional"`
+}
+
+type EventDefinition struct {
+	Name   string            `json:"name"`
+	Fields []FieldDefinition `json:"fields"`
+}
+
+// FieldDefinition has either the name of a value type or the primitive type string as type.
+// Fields must only be added at the end, because their position defines the protobuf field numbers.
+// JSONPrefix is prepended to the JSON keys of its parts, Example overrides the example of the value type.
+// Optional fields may be empty, like optional value parts.
+type FieldDefinition struct {
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	JSONPrefix string `json:"jsonPrefix"`
+	Example    string `json:"example"`
+	Optional   bool   `json:"optional"`
+}
+
+/***** resolved definitions, as used by the templates *****/
+
+type eventSet struct {
+	Name                 string
+	DomainPackage        string
+	DomainPackageName    string
+	ValuePackage         string
+	SerializationPackage string
+	ProtoPackage         string
+	DefinitionsFileName  string
+	Events               []eventSpec
+}
+
+// ProtoFileName is the name of the generated .proto file, e.g. generated_customer_events.proto.
+func (set eventSet) ProtoFileName() string {
+	var snakeCase strings.Builder
+
+	for i, r := range set.Name {
+		if i > 0 && unicode.IsUpper(r) {
+			snakeCase.WriteRune('_')
+		}
+
+		snakeCase.WriteRune(unicode.ToLower(r))
+	}
+
+	return "generated_" + snakeCase.String() + "_events.proto"
+}
+
+func (set eventSet) UsesValuePackage() bool {
+	for _, event := range set.Events {
+		if event.UsesValuePackage() {
+			return true
+		}
+	}
+
+	return false
+}
+
+type eventSpec struct {
+	Name   string
+	Fields []fieldSpec
+}
+
+func (event eventSpec) UsesValuePackage() bool {
+	for _, field := range event.Fields {
+		if field.IsValueObject {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RequiredParts are the parts which unmarshaling checks, only strings can be required.
+func (event eventSpec) RequiredParts() []partSpec {
+	var parts []partSpec
+
+	for _, part := range event.Parts() {
+		if part.Type == "string" && !part.Optional {
+			parts = append(parts, part)
+		}
+	}
+
+	return parts
+}
+
+func (event eventSpec) Parts() []partSpec {
+	var parts []partSpec
+
+	for _, field := range event.Fields {
+		parts = append(parts, field.Parts...)
+	}
+
+	return parts
+}
+
+type fieldSpec struct {
+	Name          string
+	Getter        string
+	Type          string
+	IsValueObject bool
+	Rebuild       string
+	Parts         []partSpec
+	Example       string
+}
+
+type partSpec struct {
+	Name        string
+	Type        string
+	ProtoType   string
+	ProtoNumber int
+	JSONKey     string
+	JSONField   string
+	Getter      string
+	Optional    bool
+}
+
+// protoTypes are the types which parts can have, the generated code relies on the fact
+// that JSON and protobuf use the same Go type for them.
+var protoTypes = map[string]string{
+	"string":   "string",
+	"[]string": "repeated string",
+}
+
+func readEventDefinitions(fileName string) (EventDefinitions, error) {
+	var definitions EventDefinitions
+
+	content, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return definitions, errors.Wrap(err, "readEventDefinitions")
+	}
+
+	if err = json.Unmarshal(content, &definitions); err != nil {
+		return definitions, errors.Wrapf(err, "readEventDefinitions: %s", fileName)
+	}
+
+	return definitions, nil
+}
+
+func resolveEventDefinitions(
+	definitions EventDefinitions,
+	definitionsFileName string,
+	serializationPackage string,
+) (eventSet, error) {
+
+	set := eventSet{
+		Name:                 definitions.Name,
+		DomainPackage:        definitions.DomainPackage,
+		DomainPackageName:    path.Base(definitions.DomainPackage),
+		ValuePackage:         definitions.ValuePackage,
+		SerializationPackage: serializationPackage,
+		ProtoPackage:         definitions.ProtoPackage,
+		DefinitionsFileName:  definitionsFileName,
+	}
+
+	if set.Name == "" || set.DomainPackage == "" || set.ProtoPackage == "" {
+		return eventSet{}, errors.New("resolveEventDefinitions: name, domainPackage and protoPackage must be defined")
+	}
+
+	eventNames := make(map[string]bool)
+
+	for _, eventDefinition := range definitions.Events {
+		if !isExported(eventDefinition.Name) {
+			return eventSet{}, errors.Newf("resolveEventDefinitions: event name [%s] must be exported", eventDefinition.Name)
+		}
+
+		if eventNames[eventDefinition.Name] {
+			return eventSet{}, errors.Newf("resolveEventDefinitions: event [%s] is defined twice", eventDefinition.Name)
+		}
+
+		eventNames[eventDefinition.Name] = true
+		resolvedEvent := eventSpec{Name: eventDefinition.Name}
+		protoNumber := 1
+
+		for _, fieldDefinition := range eventDefinition.Fields {
+			resolvedField, err := resolveField(definitions, fieldDefinition)
+			if err != nil {
+				return eventSet{}, errors.Wrapf(err, "resolveEventDefinitions: event [%s]", eventDefinition.Name)
+			}
+
+			resolvedEvent.Fields = append(resolvedEvent.Fields, resolvedField)
+		}
+
+		// field number 1 is the meta, the parts follow in the order of their definition
+		for i := range resolvedEvent.Fields {
+			for j := range resolvedEvent.Fields[i].Parts {
+				protoNumber++
+				resolvedEvent.Fields[i].Parts[j].ProtoNumber = protoNumber
+			}
+		}
+
+		set.Events = append(set.Events, resolvedEvent)
+	}
+
+	return set, nil
+}
+
+func resolveField(definitions EventDefinitions, definition FieldDefinition) (fieldSpec, error) {
+	if definition.Name == "" || isExported(definition.Name) || definition.Name == "meta" {
+		return fieldSpec{}, errors.Newf("field name [%s] must be unexported and must not be meta", definition.Name)
+	}
+
+	resolved := fieldSpec{
+		Name:    definition.Name,
+		Getter:  exported(definition.Name),
+		Type:    definition.Type,
+		Rebuild: definition.Name,
+		Example: definition.Example,
+	}
+
+	if definition.Type == "string" {
+		resolved.Parts = []partSpec{newPart(definition.Name, "string", definition.JSONPrefix, "")}
+		resolved.Parts[0].ProtoType = protoTypes["string"]
+		resolved.Parts[0].Optional = definition.Optional
+
+		if resolved.Example == "" {
+			resolved.Example = fmt.Sprintf("%q", definition.Name)
+		}
+
+		return resolved, nil
+	}
+
+	valueType, ok := definitions.ValueTypes[definition.Type]
+	if !ok {
+		return fieldSpec{}, errors.Newf("field [%s] has the unknown type [%s]", definition.Name, definition.Type)
+	}
+
+	if definitions.ValuePackage == "" || valueType.Rebuild == "" || len(valueType.Parts) == 0 {
+		return fieldSpec{}, errors.Newf("value type [%s] needs valuePackage, rebuild and parts", definition.Type)
+	}
+
+	valuePackageName := path.Base(definitions.ValuePackage)
+	resolved.Type = valuePackageName + "." + definition.Type
+	resolved.IsValueObject = true
+
+	if resolved.Example == "" {
+		resolved.Example = valueType.Example
+	}
+
+	var partNames []string
+
+	for _, partDefinition := range valueType.Parts {
+		partName := partDefinition.Name
+		if partName == "" {
+			if len(valueType.Parts) > 1 {
+				return fieldSpec{}, errors.Newf("value type [%s] has more than one part, so all parts need names", definition.Type)
+			}
+
+			partName = definition.Name
+		}
+
+		protoType, ok := protoTypes[partDefinition.Type]
+		if !ok {
+			return fieldSpec{}, errors.Newf("value type [%s] has a part with the unsupported type [%s]", definition.Type, partDefinition.Type)
+		}
+
+		resolvedPart := newPart(partName, partDefinition.Type, definition.JSONPrefix, partDefinition.Getter)
+		resolvedPart.ProtoType = protoType
+		resolvedPart.Optional = definition.Optional || partDefinition.Optional
+		resolved.Parts = append(resolved.Parts, resolvedPart)
+		partNames = append(partNames, partName)
+	}
+
+	resolved.Rebuild = fmt.Sprintf("%s.%s(%s)", valuePackageName, valueType.Rebuild, strings.Join(partNames, ", "))
+
+	if resolved.Example == "" {
+		return fieldSpec{}, errors.Newf("field [%s] needs an example for the round-trip test", definition.Name)
+	}
+
+	return resolved, nil
+}
+
+func newPart(name string, partType string, jsonPrefix string, getter string) partSpec {
+	jsonKey := name
+	if jsonPrefix != "" {
+		jsonKey = jsonPrefix + exported(name)
+	}
+
+	resolved := partSpec{
+		Name:      name,
+		Type:      partType,
+		JSONKey:   jsonKey,
+		JSONField: exported(jsonKey),
+	}
+
+	if getter != "" {
+		resolved.Getter = "." + getter + "()"
+	}
+
+	return resolved
+}
+
+func exported(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+func isExported(name string) bool {
+	return name != "" && exported(name) == name
+}
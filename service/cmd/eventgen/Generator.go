@@ -0,0 +1,353 @@
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"strings"
+	"text/template"
+
+	"github.com/cockroachdb/errors"
+)
+
+type generatedFiles struct {
+	domain        map[string][]byte
+	serialization map[string][]byte
+}
+
+var templateFuncs = template.FuncMap{
+	"unexported": func(name string) string {
+		return strings.ToLower(name[:1]) + name[1:]
+	},
+	"streamVersion": func(index int) int {
+		return index + 1
+	},
+}
+
+func generate(set eventSet) (generatedFiles, error) {
+	files := generatedFiles{
+		domain:        make(map[string][]byte),
+		serialization: make(map[string][]byte),
+	}
+
+	for _, event := range set.Events {
+		data := struct {
+			eventSet
+			Event eventSpec
+		}{set, event}
+
+		content, err := render(domainEventTemplate, data)
+		if err != nil {
+			return generatedFiles{}, errors.Wrapf(err, "generate: event [%s]", event.Name)
+		}
+
+		files.domain[event.Name+".go"] = content
+	}
+
+	content, err := render(eventMappingTemplate, set)
+	if err != nil {
+		return generatedFiles{}, errors.Wrap(err, "generate: event mapping")
+	}
+
+	files.serialization["Generated"+set.Name+"Events.go"] = content
+
+	content, err = render(eventMappingTestTemplate, set)
+	if err != nil {
+		return generatedFiles{}, errors.Wrap(err, "generate: event mapping test")
+	}
+
+	files.serialization["Generated"+set.Name+"Events_test.go"] = content
+
+	var buffer bytes.Buffer
+
+	if err = protoTemplate.Execute(&buffer, set); err != nil {
+		return generatedFiles{}, errors.Wrap(err, "generate: proto messages")
+	}
+
+	files.serialization[set.ProtoFileName()] = buffer.Bytes()
+
+	return files, nil
+}
+
+func render(tmpl *template.Template, data interface{}) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	if err := tmpl.Execute(&buffer, data); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buffer.Bytes())
+}
+
+var domainEventTemplate = template.Must(template.New("domainEvent").Funcs(templateFuncs).Parse(
+	`// Code generated by eventgen from {{.DefinitionsFileName}}. DO NOT EDIT.
+
+package {{.DomainPackageName}}
+
+import (
+{{- if .Event.UsesValuePackage}}
+	"{{.ValuePackage}}"
+{{- end}}
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+)
+{{with .Event}}
+type {{.Name}} struct {
+{{- range .Fields}}
+	{{.Name}} {{.Type}}
+{{- end}}
+	meta es.EventMeta
+}
+
+func Build{{.Name}}(
+{{- range .Fields}}
+	{{.Name}} {{.Type}},
+{{- end}}
+	streamVersion uint,
+) {{.Name}} {
+
+	event := {{.Name}}{
+{{- range .Fields}}
+		{{.Name}}: {{.Name}},
+{{- end}}
+	}
+
+	event.meta = es.BuildEventMeta(event, streamVersion)
+
+	return event
+}
+
+func Rebuild{{.Name}}(
+{{- range .Parts}}
+	{{.Name}} {{.Type}},
+{{- end}}
+	meta es.EventMeta,
+) {{.Name}} {
+
+	event := {{.Name}}{
+{{- range .Fields}}
+		{{.Name}}: {{.Rebuild}},
+{{- end}}
+		meta: meta,
+	}
+
+	return event
+}
+{{$eventName := .Name}}{{range .Fields}}
+func (event {{$eventName}}) {{.Getter}}() {{.Type}} {
+	return event.{{.Name}}
+}
+{{end}}
+func (event {{.Name}}) Meta() es.EventMeta {
+	return event.meta
+}
+
+func (event {{.Name}}) IsFailureEvent() bool {
+	return false
+}
+
+func (event {{.Name}}) FailureReason() error {
+	return nil
+}
+{{- end}}
+`))
+
+var eventMappingTemplate = template.Must(template.New("eventMapping").Funcs(templateFuncs).Parse(
+	`// Code generated by eventgen from {{.DefinitionsFileName}}. DO NOT EDIT.
+
+package {{.SerializationPackage}}
+
+import (
+	"{{.DomainPackage}}"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+)
+
+func generated{{.Name}}EventRegistrations() []es.EventRegistration {
+	return []es.EventRegistration{
+{{- range .Events}}
+		{
+			Name:       "{{.Name}}",
+			Event:      {{$.DomainPackageName}}.{{.Name}}{},
+			ToPayload:  {{unexported .Name}}ToPayload,
+			NewPayload: func() interface{} { return &{{.Name}}ForJSON{} },
+			Rebuild:    rebuild{{.Name}},
+		},
+{{- end}}
+	}
+}
+
+func generated{{.Name}}EventProtoRegistrations() []es.EventRegistration {
+	return []es.EventRegistration{
+{{- range .Events}}
+		{
+			Name:       "{{.Name}}",
+			Event:      {{$.DomainPackageName}}.{{.Name}}{},
+			ToPayload:  {{unexported .Name}}ToProtoPayload,
+			NewPayload: func() interface{} { return &{{.Name}}ForProto{} },
+			Rebuild:    rebuild{{.Name}}FromProto,
+		},
+{{- end}}
+	}
+}
+{{range .Events}}
+type {{.Name}}ForJSON struct {
+{{- range .Parts}}
+	{{.JSONField}} {{.Type}} ` + "`" + `json:"{{.JSONKey}}"{{if .Optional}} schema:"optional"{{end}}` + "`" + `
+{{- end}}
+	Meta es.EventMetaForJSON ` + "`" + `json:"meta"` + "`" + `
+}
+{{end}}
+{{- range $event := .Events}}
+func {{unexported $event.Name}}ToPayload(event es.DomainEvent) (interface{}, error) {
+	actualEvent := event.({{$.DomainPackageName}}.{{$event.Name}})
+
+	payload := &{{$event.Name}}ForJSON{
+{{- range $field := $event.Fields}}{{range $field.Parts}}
+		{{.JSONField}}: actualEvent.{{$field.Getter}}(){{.Getter}},
+{{- end}}{{end}}
+		Meta: marshalEventMeta(event),
+	}
+
+	return payload, nil
+}
+
+func rebuild{{$event.Name}}(payload interface{}, streamVersion uint) (es.DomainEvent, error) {
+	unmarshaledData := payload.(*{{$event.Name}}ForJSON)
+{{- if $event.RequiredParts}}
+
+	if err := assertPayloadFieldsAreSet(
+{{- range $event.RequiredParts}}
+		payloadField{"{{.JSONKey}}", unmarshaledData.{{.JSONField}}},
+{{- end}}
+	); err != nil {
+		return nil, err
+	}
+{{- end}}
+
+	event := {{$.DomainPackageName}}.Rebuild{{$event.Name}}(
+{{- range $event.Parts}}
+		unmarshaledData.{{.JSONField}},
+{{- end}}
+		unmarshalEventMeta(unmarshaledData.Meta, streamVersion),
+	)
+
+	return event, nil
+}
+
+func {{unexported $event.Name}}ToProtoPayload(event es.DomainEvent) (interface{}, error) {
+	actualEvent := event.({{$.DomainPackageName}}.{{$event.Name}})
+
+	payload := &{{$event.Name}}ForProto{
+		Meta: marshalEventMetaToProto(event),
+{{- range $field := $event.Fields}}{{range $field.Parts}}
+		{{.JSONField}}: actualEvent.{{$field.Getter}}(){{.Getter}},
+{{- end}}{{end}}
+	}
+
+	return payload, nil
+}
+
+func rebuild{{$event.Name}}FromProto(payload interface{}, streamVersion uint) (es.DomainEvent, error) {
+	unmarshaledData := payload.(*{{$event.Name}}ForProto)
+{{- if $event.RequiredParts}}
+
+	if err := assertPayloadFieldsAreSet(
+{{- range $event.RequiredParts}}
+		payloadField{"{{.JSONKey}}", unmarshaledData.{{.JSONField}}},
+{{- end}}
+	); err != nil {
+		return nil, err
+	}
+{{- end}}
+
+	event := {{$.DomainPackageName}}.Rebuild{{$event.Name}}(
+{{- range $event.Parts}}
+		unmarshaledData.{{.JSONField}},
+{{- end}}
+		unmarshalEventMetaFromProto(unmarshaledData.Meta, streamVersion),
+	)
+
+	return event, nil
+}
+{{end}}`))
+
+var protoTemplate = template.Must(template.New("proto").Funcs(templateFuncs).Parse(
+	`// Code generated by eventgen from {{.DefinitionsFileName}}. DO NOT EDIT.
+
+syntax = "proto3";
+package {{.ProtoPackage}};
+
+option go_package = "{{.SerializationPackage}}";
+
+import "event_meta.proto";
+{{range .Events}}
+message {{.Name}}ForProto {
+    EventMetaForProto meta = 1;
+{{- range .Parts}}
+    {{.ProtoType}} {{.JSONKey}} = {{.ProtoNumber}};
+{{- end}}
+}
+{{end}}`))
+
+var eventMappingTestTemplate = template.Must(template.New("eventMappingTest").Funcs(templateFuncs).Parse(
+	`// Code generated by eventgen from {{.DefinitionsFileName}}. DO NOT EDIT.
+
+package {{.SerializationPackage}}
+
+import (
+	"fmt"
+	"testing"
+
+	"{{.DomainPackage}}"
+{{- if .UsesValuePackage}}
+	"{{.ValuePackage}}"
+{{- end}}
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	jsoniter "github.com/json-iterator/go"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMarshalAndUnmarshalGenerated{{.Name}}Events(t *testing.T) {
+	events := []es.DomainEvent{
+{{- range $index, $event := .Events}}
+		{{$.DomainPackageName}}.Build{{$event.Name}}(
+{{- range $event.Fields}}
+			{{.Example}},
+{{- end}}
+			{{streamVersion $index}},
+		),
+{{- end}}
+	}
+
+	jsonRegistry := es.NewEventRegistry(jsoniter.ConfigFastest)
+	protoRegistry := es.NewEventRegistry(protobufCodec{})
+
+	registries := map[string]*es.EventRegistry{"JSON": jsonRegistry, "protobuf": protoRegistry}
+
+	if err := jsonRegistry.Register(generated{{.Name}}EventRegistrations()...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := protoRegistry.Register(generated{{.Name}}EventProtoRegistrations()...); err != nil {
+		t.Fatal(err)
+	}
+
+	for format, registry := range registries {
+		for _, event := range events {
+			registry := registry
+			originalEvent := event
+			eventName := originalEvent.Meta().EventName()
+
+			Convey(fmt.Sprintf("When %s is marshaled and unmarshaled as %s", eventName, format), t, func() {
+				payload, err := registry.Marshal(originalEvent)
+				So(err, ShouldBeNil)
+
+				unmarshaledEvent, err := registry.Unmarshal(eventName, payload, originalEvent.Meta().StreamVersion())
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then the unmarshaled %s should resemble the original %s", eventName, eventName), func() {
+					So(unmarshaledEvent, ShouldResemble, originalEvent)
+				})
+			})
+		}
+	}
+}
+`))
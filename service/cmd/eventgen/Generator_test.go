@@ -0,0 +1,93 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const (
+	domainDir        = "../../customeraccounts/hexagon/application/domain"
+	serializationDir = "../../customeraccounts/infrastructure/serialization"
+)
+
+func TestGenerate(t *testing.T) {
+	Convey("Given the Customer event definitions", t, func() {
+		definitionsFile := filepath.Join(domainDir, "CustomerEvents.json")
+
+		Convey("When the events are generated", func() {
+			files, err := generateFromFile(definitionsFile, serializationDir)
+			So(err, ShouldBeNil)
+
+			Convey("Then the generated files should be up to date (run go generate otherwise)", func() {
+				assertFilesAreUpToDate(domainDir, files.domain)
+				assertFilesAreUpToDate(serializationDir, files.serialization)
+			})
+		})
+	})
+}
+
+func assertFilesAreUpToDate(dir string, files map[string][]byte) {
+	for name, content := range files {
+		existing, err := ioutil.ReadFile(filepath.Join(dir, name))
+		So(err, ShouldBeNil)
+		So(string(existing), ShouldEqual, string(content))
+	}
+}
+
+func TestResolveEventDefinitions_WithInvalidDefinitions(t *testing.T) {
+	Convey("Given event definitions", t, func() {
+		definitions := EventDefinitions{
+			Name:          "Customer",
+			ProtoPackage:  "customerevents",
+			DomainPackage: "example.com/domain",
+			ValuePackage:  "example.com/domain/value",
+			ValueTypes: map[string]ValueTypeDefinition{
+				"CustomerID": {Rebuild: "RebuildCustomerID", Parts: []ValuePartDefinition{{Getter: "String", Type: "string"}}},
+			},
+		}
+
+		Convey("When an event is defined twice", func() {
+			event := EventDefinition{Name: "CustomerDeleted", Fields: []FieldDefinition{{Name: "customerID", Type: "CustomerID", Example: "x"}}}
+			definitions.Events = []EventDefinition{event, event}
+
+			_, err := resolveEventDefinitions(definitions, "CustomerEvents.json", "serialization")
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldBeError)
+			})
+		})
+
+		Convey("When a field has an unknown type", func() {
+			definitions.Events = []EventDefinition{{Name: "CustomerDeleted", Fields: []FieldDefinition{{Name: "emailAddress", Type: "EmailAddress"}}}}
+
+			_, err := resolveEventDefinitions(definitions, "CustomerEvents.json", "serialization")
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldBeError)
+			})
+		})
+
+		Convey("When a value type field has no example", func() {
+			definitions.Events = []EventDefinition{{Name: "CustomerDeleted", Fields: []FieldDefinition{{Name: "customerID", Type: "CustomerID"}}}}
+
+			_, err := resolveEventDefinitions(definitions, "CustomerEvents.json", "serialization")
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldBeError)
+			})
+		})
+
+		Convey("When a field is exported", func() {
+			definitions.Events = []EventDefinition{{Name: "CustomerDeleted", Fields: []FieldDefinition{{Name: "Reason", Type: "string"}}}}
+
+			_, err := resolveEventDefinitions(definitions, "CustomerEvents.json", "serialization")
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldBeError)
+			})
+		})
+	})
+}
@@ -0,0 +1,78 @@
+// Eventgen generates domain events, their JSON and protobuf mapping and a round-trip test from an event definitions file.
+//
+// For each event it writes <EventName>.go into the domain directory. Into the serialization directory it writes
+// Generated<Name>Events.go with the *ForJSON types, the payload conversions and the registrations for an
+// es.EventRegistry, plus a round-trip test, and generated_<name>_events.proto with the *ForProto messages,
+// which must be compiled with protoc afterwards. The generated code uses marshalEventMeta, unmarshalEventMeta,
+// marshalEventMetaToProto, unmarshalEventMetaFromProto and protobufCodec, which must exist in the serialization package,
+// and the proto file imports event_meta.proto from there.
+//
+// It is meant to be run via go generate, see domain/CustomerEvents.go.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	definitionsFile := flag.String("definitions", "", "the JSON file with the event definitions")
+	domainDir := flag.String("domain-dir", ".", "the directory of the domain package")
+	serializationDir := flag.String("serialization-dir", "", "the directory of the serialization package")
+	flag.Parse()
+
+	if *definitionsFile == "" || *serializationDir == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(*definitionsFile, *domainDir, *serializationDir); err != nil {
+		fmt.Fprintf(os.Stderr, "eventgen: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(definitionsFile string, domainDir string, serializationDir string) error {
+	files, err := generateFromFile(definitionsFile, serializationDir)
+	if err != nil {
+		return err
+	}
+
+	if err = writeFiles(domainDir, files.domain); err != nil {
+		return err
+	}
+
+	return writeFiles(serializationDir, files.serialization)
+}
+
+func generateFromFile(definitionsFile string, serializationDir string) (generatedFiles, error) {
+	definitions, err := readEventDefinitions(definitionsFile)
+	if err != nil {
+		return generatedFiles{}, err
+	}
+
+	absSerializationDir, err := filepath.Abs(serializationDir)
+	if err != nil {
+		return generatedFiles{}, err
+	}
+
+	set, err := resolveEventDefinitions(definitions, filepath.Base(definitionsFile), filepath.Base(absSerializationDir))
+	if err != nil {
+		return generatedFiles{}, err
+	}
+
+	return generate(set)
+}
+
+func writeFiles(dir string, files map[string][]byte) error {
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), content, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+// Eventschemas writes the JSON Schemas of the current payload versions of all Customer events into a directory,
+// one file per event and version, e.g. CustomerRegistered.v1.json. Files of older versions are left untouched.
+//
+// It is meant to be run via go generate, see serialization/CustomerEventJSONSchemas.go.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/serialization"
+)
+
+func main() {
+	dir := flag.String("dir", "", "the directory for the schema files")
+	flag.Parse()
+
+	if *dir == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(*dir); err != nil {
+		fmt.Fprintf(os.Stderr, "eventschemas: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir string) error {
+	schemas, err := serialization.CustomerEventJSONSchemas()
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	for fileName, content := range schemas {
+		if err = ioutil.WriteFile(filepath.Join(dir, fileName), content, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
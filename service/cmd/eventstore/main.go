@@ -0,0 +1,504 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/cmd"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/adapter/eventdump"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/adapter/postgres"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+)
+
+const (
+	usage = `usage:
+  eventstore export -out <file> -pseudonymization-key <key> [-dev-password <password>]
+  eventstore import -file <file>
+  eventstore backup -dir <directory> [-per-stream]
+  eventstore restore -dir <directory> [-customer <customerID>]
+  eventstore verify
+  eventstore convert-payloads
+  eventstore rechain
+  eventstore check
+  eventstore streams [-after <streamID>] [-max <n>]`
+
+	globalBackupFileName = "eventstore.ndjson"
+	customerStreamPrefix = "customer-"
+)
+
+func main() {
+	logger := shared.NewStandardLogger()
+
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+
+	switch os.Args[1] {
+	case "export":
+		err = runExport(os.Args[2:], logger)
+	case "import":
+		err = runImport(os.Args[2:], logger)
+	case "backup":
+		err = runBackup(os.Args[2:], logger)
+	case "restore":
+		err = runRestore(os.Args[2:], logger)
+	case "verify":
+		err = runVerify(os.Args[2:], logger)
+	case "convert-payloads":
+		err = runConvertPayloads(os.Args[2:], logger)
+	case "rechain":
+		err = runRechain(os.Args[2:], logger)
+	case "check":
+		err = runCheck(os.Args[2:], logger)
+	case "streams":
+		err = runStreams(os.Args[2:], logger)
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		logger.Errorf("eventstore %s: %s", os.Args[1], err)
+		os.Exit(1)
+	}
+}
+
+func runExport(args []string, logger *shared.Logger) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	outputPath := flags.String("out", "", "the NDJSON file to write (required)")
+	key := flags.String("pseudonymization-key", "", "the secret key for deriving the pseudonyms, the same key gives the same pseudonyms (required)")
+	devPassword := flags.String("dev-password", "dev-password-1", "the password which replaces the passwords of all Customers")
+	_ = flags.Parse(args)
+
+	if *outputPath == "" || *key == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	plainPassword, err := value.BuildPlainPassword(*devPassword)
+	if err != nil {
+		return err
+	}
+
+	passwordHash, err := value.GeneratePasswordHash(plainPassword)
+	if err != nil {
+		return err
+	}
+
+	pseudonymizer := eventdump.NewPseudonymizer([]byte(*key), passwordHash)
+
+	eventStore, closeDB, err := bootstrapEventStore(logger)
+	if err != nil {
+		return err
+	}
+
+	defer closeDB()
+
+	output, err := os.Create(*outputPath)
+	if err != nil {
+		return err
+	}
+
+	defer output.Close()
+
+	records := eventdump.NewRecordWriter(output)
+	streams := 0
+
+	err = eventStore.ExportEventStreams(func(stream []eventdump.Record) error {
+		pseudonymized, err := pseudonymizer.PseudonymizeStream(stream)
+		if err != nil {
+			return err
+		}
+
+		streams++
+
+		return records.Write(pseudonymized...)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("eventstore export: wrote %d pseudonymized streams to %s", streams, *outputPath)
+
+	return nil
+}
+
+func runImport(args []string, logger *shared.Logger) error {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	inputPath := flags.String("file", "", "the NDJSON file written by export (required)")
+	_ = flags.Parse(args)
+
+	if *inputPath == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	eventStore, closeDB, err := bootstrapEventStore(logger)
+	if err != nil {
+		return err
+	}
+
+	defer closeDB()
+
+	streams, err := importFile(eventStore, *inputPath, allStreams)
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("eventstore import: imported %d streams from %s", streams, *inputPath)
+
+	return nil
+}
+
+func runBackup(args []string, logger *shared.Logger) error {
+	flags := flag.NewFlagSet("backup", flag.ExitOnError)
+	directoryPath := flags.String("dir", "", "the directory for the NDJSON files and their checksums in "+eventdump.ChecksumsFileName+" (required)")
+	perStream := flags.Bool("per-stream", false, "write one file per stream instead of one "+globalBackupFileName)
+	_ = flags.Parse(args)
+
+	if *directoryPath == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	eventStore, closeDB, err := bootstrapEventStore(logger)
+	if err != nil {
+		return err
+	}
+
+	defer closeDB()
+
+	directory, err := eventdump.NewBackupDirectory(*directoryPath)
+	if err != nil {
+		return err
+	}
+
+	streams := 0
+
+	if *perStream {
+		err = eventStore.ExportEventStreams(func(stream []eventdump.Record) error {
+			streams++
+
+			return directory.WriteFile(stream[0].StreamID+".ndjson", func(records *eventdump.RecordWriter) error {
+				return records.Write(stream...)
+			})
+		})
+	} else {
+		err = directory.WriteFile(globalBackupFileName, func(records *eventdump.RecordWriter) error {
+			return eventStore.ExportEventStreams(func(stream []eventdump.Record) error {
+				streams++
+
+				return records.Write(stream...)
+			})
+		})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err = directory.Close(); err != nil {
+		return err
+	}
+
+	logger.Infof("eventstore backup: wrote %d streams to %s", streams, *directoryPath)
+
+	return nil
+}
+
+func runRestore(args []string, logger *shared.Logger) error {
+	flags := flag.NewFlagSet("restore", flag.ExitOnError)
+	directoryPath := flags.String("dir", "", "the directory written by backup (required)")
+	customerID := flags.String("customer", "", "only restore the stream of this Customer, e.g. after it was purged by mistake")
+	_ = flags.Parse(args)
+
+	if *directoryPath == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	fileNames, err := eventdump.ReadBackupDirectory(*directoryPath)
+	if err != nil {
+		return err
+	}
+
+	eventStore, closeDB, err := bootstrapEventStore(logger)
+	if err != nil {
+		return err
+	}
+
+	defer closeDB()
+
+	shouldRestore := allStreams
+
+	if *customerID != "" {
+		shouldRestore = func(streamID string) bool { return streamID == customerStreamPrefix+*customerID }
+	} else {
+		isEmpty, err := eventStore.IsEmpty()
+		if err != nil {
+			return err
+		}
+
+		if !isEmpty {
+			return errors.New("the event store must be empty to restore all streams, use -customer to restore a single stream")
+		}
+	}
+
+	streams := 0
+
+	for _, fileName := range fileNames {
+		restored, err := importFile(eventStore, filepath.Join(*directoryPath, fileName), shouldRestore)
+		if err != nil {
+			return errors.Wrap(err, fileName)
+		}
+
+		streams += restored
+	}
+
+	if *customerID != "" && streams == 0 {
+		return errors.Newf("the backup does not contain the Customer [%s]", *customerID)
+	}
+
+	logger.Infof("eventstore restore: restored %d streams from %s", streams, *directoryPath)
+
+	return nil
+}
+
+// importFile imports all streams of an NDJSON file for which shouldImport returns true.
+func importFile(eventStore *postgres.CustomerEventStore, path string, shouldImport func(streamID string) bool) (int, error) {
+	input, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+
+	defer input.Close()
+
+	records := eventdump.NewRecordReader(input)
+	streams := 0
+
+	for {
+		stream, err := records.NextStream()
+
+		if err == io.EOF {
+			return streams, nil
+		}
+
+		if err != nil {
+			return streams, err
+		}
+
+		if !shouldImport(stream[0].StreamID) {
+			continue
+		}
+
+		if err = eventStore.ImportEventStream(stream); err != nil {
+			return streams, errors.Wrapf(err, "stream [%s]", stream[0].StreamID)
+		}
+
+		streams++
+	}
+}
+
+func runVerify(args []string, logger *shared.Logger) error {
+	flags := flag.NewFlagSet("verify", flag.ExitOnError)
+	_ = flags.Parse(args)
+
+	diContainer, err := cmd.Bootstrap(cmd.MustBuildConfigFromEnv(logger), logger)
+	if err != nil {
+		return err
+	}
+
+	defer diContainer.GetPostgresDBConn().Close()
+
+	breaks, streams, err := diContainer.GetEventStoreIntegrity().Verify()
+	if err != nil {
+		return err
+	}
+
+	for _, integrityBreak := range breaks {
+		logger.Warnf(
+			"eventstore verify: stream [%s] version %d: %s",
+			integrityBreak.StreamID,
+			integrityBreak.StreamVersion,
+			integrityBreak.Problem,
+		)
+	}
+
+	if len(breaks) > 0 {
+		return errors.Newf("found %d breaks in %d streams", len(breaks), streams)
+	}
+
+	logger.Infof("eventstore verify: the hash chains of all %d streams and all tombstones are intact", streams)
+
+	return nil
+}
+
+// runConvertPayloads rewrites all events into the format of EVENT_PAYLOAD_CODEC. The hash chains of converted streams are
+// recomputed, so it refuses to run if the event store is not intact - converting would hide the breaks.
+func runConvertPayloads(args []string, logger *shared.Logger) error {
+	flags := flag.NewFlagSet("convert-payloads", flag.ExitOnError)
+	_ = flags.Parse(args)
+
+	diContainer, err := cmd.Bootstrap(cmd.MustBuildConfigFromEnv(logger), logger)
+	if err != nil {
+		return err
+	}
+
+	defer diContainer.GetPostgresDBConn().Close()
+
+	breaks, _, err := diContainer.GetEventStoreIntegrity().Verify()
+	if err != nil {
+		return err
+	}
+
+	if len(breaks) > 0 {
+		return errors.Newf("found %d breaks, run eventstore verify for details", len(breaks))
+	}
+
+	streams, err := diContainer.GetCustomerEventStore().ConvertEventPayloads()
+	if err != nil {
+		return errors.Wrapf(err, "after converting %d streams", streams)
+	}
+
+	logger.Infof("eventstore convert-payloads: converted %d streams", streams)
+
+	return nil
+}
+
+// runRechain recomputes the hash chains with EVENT_CHAIN_KEY, e.g. after the key was changed. It can't check the chains
+// first, because they were computed with another key, so the event store must be verified with the old key before.
+func runRechain(args []string, logger *shared.Logger) error {
+	flags := flag.NewFlagSet("rechain", flag.ExitOnError)
+	_ = flags.Parse(args)
+
+	diContainer, err := cmd.Bootstrap(cmd.MustBuildConfigFromEnv(logger), logger)
+	if err != nil {
+		return err
+	}
+
+	defer diContainer.GetPostgresDBConn().Close()
+
+	streams, err := diContainer.GetCustomerEventStore().RechainEventStreams()
+	if err != nil {
+		return errors.Wrapf(err, "after rechaining %d streams", streams)
+	}
+
+	logger.Infof("eventstore rechain: rechained %d streams", streams)
+
+	return nil
+}
+
+// runCheck unmarshals all stored events and puts the ones which fail into the quarantine.
+func runCheck(args []string, logger *shared.Logger) error {
+	flags := flag.NewFlagSet("check", flag.ExitOnError)
+	_ = flags.Parse(args)
+
+	diContainer, err := cmd.Bootstrap(cmd.MustBuildConfigFromEnv(logger), logger)
+	if err != nil {
+		return err
+	}
+
+	defer diContainer.GetPostgresDBConn().Close()
+
+	eventStore := diContainer.GetCustomerEventStore().WithCorruptEventPolicy(es.QuarantineCorruptEvents)
+
+	streams, err := eventStore.CheckEventStreams()
+	if err != nil {
+		return err
+	}
+
+	corruptEvents, err := diContainer.GetEventQuarantine().RetrieveCorruptEvents()
+	if err != nil {
+		return err
+	}
+
+	for _, corruptEvent := range corruptEvents {
+		logger.Warnf(
+			"eventstore check: stream [%s] version %d event id %d (%s): %s",
+			corruptEvent.StreamID,
+			corruptEvent.StreamVersion,
+			corruptEvent.EventID,
+			corruptEvent.EventName,
+			corruptEvent.Reason,
+		)
+	}
+
+	if len(corruptEvents) > 0 {
+		return errors.Newf("found %d quarantined events", len(corruptEvents))
+	}
+
+	logger.Infof("eventstore check: all events of %d streams can be unmarshaled", streams)
+
+	return nil
+}
+
+// runStreams lists the Customer streams with their current versions from the streams table, one page at a time.
+func runStreams(args []string, logger *shared.Logger) error {
+	flags := flag.NewFlagSet("streams", flag.ExitOnError)
+	afterStreamID := flags.String("after", "", "start after this stream ID, which is printed at the end of the previous page")
+	maxStreams := flags.Uint("max", 100, "the maximum number of streams to list")
+	_ = flags.Parse(args)
+
+	diContainer, err := cmd.Bootstrap(cmd.MustBuildConfigFromEnv(logger), logger)
+	if err != nil {
+		return err
+	}
+
+	defer diContainer.GetPostgresDBConn().Close()
+
+	streams, err := diContainer.GetCustomerEventStore().ListEventStreams(*afterStreamID, *maxStreams)
+	if err != nil {
+		return err
+	}
+
+	for _, stream := range streams {
+		state := "active"
+		if stream.IsDeleted {
+			state = "deleted"
+		}
+
+		fmt.Printf(
+			"%s\tversion %d\t%s\tcreated %s\tupdated %s\n",
+			stream.StreamID.String(),
+			stream.CurrentVersion,
+			state,
+			stream.CreatedAt.Format(time.RFC3339),
+			stream.UpdatedAt.Format(time.RFC3339),
+		)
+	}
+
+	if uint(len(streams)) == *maxStreams {
+		logger.Infof("eventstore streams: continue with -after %s", streams[len(streams)-1].StreamID.String())
+	}
+
+	return nil
+}
+
+func allStreams(streamID string) bool {
+	return true
+}
+
+func bootstrapEventStore(logger *shared.Logger) (*postgres.CustomerEventStore, func(), error) {
+	config := cmd.MustBuildConfigFromEnv(logger)
+
+	diContainer, err := cmd.Bootstrap(config, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	closeDB := func() { _ = diContainer.GetPostgresDBConn().Close() }
+
+	return diContainer.GetCustomerEventStore(), closeDB, nil
+}
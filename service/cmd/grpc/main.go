@@ -4,6 +4,7 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"time"
 
 	"github.com/AntonStoeckl/go-iddd/service/cmd"
 	customergrpc "github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/adapter/grpc"
@@ -13,9 +14,15 @@ import (
 	"google.golang.org/grpc/reflection"
 )
 
+const (
+	duplicateCustomerDetectionInterval = time.Hour
+	minAdminTokenLength                = 32
+)
+
 var (
-	diContainer *cmd.DIContainer
-	grpcServer  *grpc.Server
+	diContainer     *cmd.DIContainer
+	grpcServer      *grpc.Server
+	adminGRPCServer *grpc.Server
 )
 
 func main() {
@@ -33,6 +40,8 @@ func main() {
 	signal.Notify(stopSignalChannel, os.Interrupt)
 
 	go mustStartGRPC(config, logger)
+	go mustStartAdminGRPC(config, logger)
+	go startDuplicateCustomerDetection(logger)
 
 	waitForStopSignal(stopSignalChannel, logger)
 }
@@ -58,6 +67,57 @@ func mustStartGRPC(config *cmd.Config, logger *shared.Logger) {
 	}
 }
 
+// mustStartAdminGRPC serves the event store and Customer admin services on their own address, which should only be reachable by operators
+// (e.g. bound to localhost, which is the default in the example env files). All calls must send the admin token.
+func mustStartAdminGRPC(config *cmd.Config, logger *shared.Logger) {
+	logger.Info("configuring admin gRPC server ...")
+
+	if len(config.GRPC.AdminToken) < minAdminTokenLength {
+		logger.Errorf("the admin token must have at least %d bytes", minAdminTokenLength)
+		shutdown(logger)
+	}
+
+	listener, err := net.Listen("tcp", config.GRPC.AdminHostAndPort)
+	if err != nil {
+		logger.Errorf("failed to listen: %v", err)
+		shutdown(logger)
+	}
+
+	authentication := customergrpc.NewAdminTokenAuthentication(config.GRPC.AdminToken)
+
+	adminGRPCServer = grpc.NewServer(
+		grpc.UnaryInterceptor(authentication.UnaryInterceptor),
+		grpc.StreamInterceptor(authentication.StreamInterceptor),
+	)
+	customergrpc.RegisterEventStoreAdminServer(adminGRPCServer, diContainer.GetEventStoreAdminGRPCServer())
+	customergrpc.RegisterCustomerAdminServer(adminGRPCServer, diContainer.GetCustomerAdminGRPCServer())
+	reflection.Register(adminGRPCServer)
+
+	logger.Infof("starting admin gRPC server listening at %s ...", config.GRPC.AdminHostAndPort)
+
+	if err := adminGRPCServer.Serve(listener); err != nil {
+		logger.Errorf("admin gRPC server failed to serve: %s", err)
+		shutdown(logger)
+	}
+}
+
+func startDuplicateCustomerDetection(logger *shared.Logger) {
+	logger.Infof("starting duplicate customer detection every %s ...", duplicateCustomerDetectionInterval)
+
+	ticker := time.NewTicker(duplicateCustomerDetectionInterval)
+
+	for {
+		numberOfNewCandidates, err := diContainer.GetDuplicateCustomerHandler().DetectDuplicateCustomers()
+		if err != nil {
+			logger.Warnf("duplicate customer detection failed: %s", err)
+		} else {
+			logger.Infof("duplicate customer detection found %d new candidates", numberOfNewCandidates)
+		}
+
+		<-ticker.C
+	}
+}
+
 func waitForStopSignal(stopSignalChannel chan os.Signal, logger *shared.Logger) {
 	logger.Info("start waiting for stop signal ...")
 
@@ -79,6 +139,11 @@ func shutdown(logger *shared.Logger) {
 		grpcServer.GracefulStop()
 	}
 
+	if adminGRPCServer != nil {
+		logger.Info("shutdown: stopping admin gRPC server gracefully ...")
+		adminGRPCServer.GracefulStop()
+	}
+
 	postgresDBConn := diContainer.GetPostgresDBConn()
 
 	if postgresDBConn != nil {
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/AntonStoeckl/go-iddd/service/cmd"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/adapter/importing"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+)
+
+func main() {
+	logger := shared.NewStandardLogger()
+
+	inputPath := flag.String("file", "", "the CSV or NDJSON file to import (required)")
+	format := flag.String("format", "", "csv or ndjson (default: derived from the file extension)")
+	reportPath := flag.String("report", "", "the NDJSON result report, an existing report resumes the import (default: <file>.report.ndjson or <file>.dry-run.report.ndjson)")
+	dryRun := flag.Bool("dry-run", false, "only validate the rows, don't import them")
+	parallelism := flag.Uint("parallelism", 4, "the number of rows imported in parallel")
+	flag.Parse()
+
+	if *inputPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if *format == "" {
+		*format = strings.TrimPrefix(strings.ToLower(filepath.Ext(*inputPath)), ".")
+	}
+
+	if *reportPath == "" {
+		*reportPath = *inputPath + ".report.ndjson"
+
+		if *dryRun {
+			*reportPath = *inputPath + ".dry-run.report.ndjson"
+		}
+	}
+
+	var importCustomer hexagon.ForImportingCustomers
+
+	if !*dryRun {
+		config := cmd.MustBuildConfigFromEnv(logger)
+
+		diContainer, err := cmd.Bootstrap(config, logger)
+		if err != nil {
+			os.Exit(1)
+		}
+
+		defer diContainer.GetPostgresDBConn().Close()
+
+		importCustomer = diContainer.GetCustomerCommandHandler().ImportCustomer
+	}
+
+	if err := runImport(*inputPath, *format, *reportPath, importing.NewImporter(importCustomer, *dryRun, *parallelism), logger); err != nil {
+		logger.Errorf("import: %s", err)
+		os.Exit(1)
+	}
+}
+
+func runImport(inputPath, format, reportPath string, importer *importing.Importer, logger *shared.Logger) error {
+	input, err := os.Open(inputPath)
+	if err != nil {
+		return err
+	}
+
+	defer input.Close()
+
+	rows, err := importing.NewRowReader(input, format)
+	if err != nil {
+		return err
+	}
+
+	processedRows := make(map[uint64]bool)
+
+	if existingReport, err := os.Open(reportPath); err == nil {
+		processedRows, err = importing.ReadProcessedRows(existingReport)
+		_ = existingReport.Close()
+
+		if err != nil {
+			return err
+		}
+
+		logger.Infof("import: resuming, skipping %d rows which are finished in %s", len(processedRows), reportPath)
+	}
+
+	report, err := os.OpenFile(reportPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	defer report.Close()
+
+	if len(processedRows) > 0 {
+		// terminate a last line which might be truncated by a crash
+		if _, err = report.WriteString("\n"); err != nil {
+			return err
+		}
+	}
+
+	summary, err := importer.Import(rows, processedRows, importing.NewReportWriter(report).Write)
+
+	for status, count := range summary {
+		logger.Infof("import: %d rows %s", count, status)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("import: finished, see %s for the result of each row", reportPath)
+
+	return nil
+}
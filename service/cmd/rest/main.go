@@ -2,14 +2,17 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"time"
 
 	"github.com/AntonStoeckl/go-iddd/service/cmd"
 	customergrpc "github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/adapter/grpc"
 	customerrest "github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/adapter/rest"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/serialization"
 	"github.com/AntonStoeckl/go-iddd/service/shared"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
@@ -45,7 +48,13 @@ func mustStartREST(config *cmd.Config, logger *shared.Logger) {
 
 	ctx, cancelCtx = context.WithTimeout(context.Background(), ctxTimeout)
 
-	grpcClientConn, err = grpc.DialContext(ctx, config.GRPC.HostAndPort, grpc.WithInsecure(), grpc.WithBlock())
+	grpcClientConn, err = grpc.DialContext(
+		ctx,
+		config.GRPC.HostAndPort,
+		grpc.WithInsecure(),
+		grpc.WithBlock(),
+		grpc.WithUnaryInterceptor(customerrest.ExpectedVersionFromIfMatch),
+	)
 	if err != nil {
 		logger.Errorf("fail to dial: %s", err)
 		shutdown(logger)
@@ -53,6 +62,10 @@ func mustStartREST(config *cmd.Config, logger *shared.Logger) {
 
 	rmux := runtime.NewServeMux(
 		runtime.WithProtoErrorHandler(customerrest.CustomHTTPError),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.HTTPBodyMarshaler{Marshaler: &runtime.JSONPb{OrigName: true}}),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithIncomingHeaderMatcher(customerrest.IncomingHeaderMatcher),
+		runtime.WithForwardResponseOption(customerrest.ForwardETag),
 	)
 
 	client := customergrpc.NewCustomerClient(grpcClientConn)
@@ -73,6 +86,15 @@ func mustStartREST(config *cmd.Config, logger *shared.Logger) {
 		},
 	)
 
+	// Serve the JSON Schemas of the event payloads, the directory listing shows all events and versions
+	mux.Handle(
+		serialization.CustomerEventSchemasURLPath,
+		http.StripPrefix(
+			serialization.CustomerEventSchemasURLPath,
+			http.FileServer(http.Dir("service/customeraccounts/infrastructure/serialization/schemas")),
+		),
+	)
+
 	restServer = &http.Server{
 		Addr:    config.REST.HostAndPort,
 		Handler: mux,
@@ -80,6 +102,7 @@ func mustStartREST(config *cmd.Config, logger *shared.Logger) {
 
 	logger.Infof("starting REST server listening at %s ...", config.REST.HostAndPort)
 	logger.Infof("will serve Swagger file at: http://%s/v1/customer/swagger.json", config.REST.HostAndPort)
+	logger.Infof("will serve event JSON Schemas at: http://%s%s", config.REST.HostAndPort, serialization.CustomerEventSchemasURLPath)
 
 	if err = restServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 		logger.Errorf("REST server failed to listenAndServe: %s", err)
@@ -87,6 +110,15 @@ func mustStartREST(config *cmd.Config, logger *shared.Logger) {
 	}
 }
 
+// outgoingHeaderMatcher passes Content-Disposition unprefixed, so that data exports are downloaded as files.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if strings.ToLower(key) == "content-disposition" {
+		return "Content-Disposition", true
+	}
+
+	return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
+}
+
 func waitForStopSignal(stopSignalChannel chan os.Signal, logger *shared.Logger) {
 	logger.Info("start waiting for stop signal ...")
 
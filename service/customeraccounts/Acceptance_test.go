@@ -2,7 +2,9 @@ package customeraccounts_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/AntonStoeckl/go-iddd/service/cmd"
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon"
@@ -19,14 +21,37 @@ import (
 var atStartCustomerEventStream application.ForStartingCustomerEventStreams
 var atAppendToCustomerEventStream application.ForAppendingToCustomerEventStreams
 var atPurgeCustomerEventStream application.ForPurgingCustomerEventStreams
+var atSentAccountRecoveryTokens = make(map[string]string)
+
+const atClientAddress = "127.0.0.1"
 
 type acceptanceTestCollaborators struct {
 	registerCustomer            hexagon.ForRegisteringCustomers
 	confirmCustomerEmailAddress hexagon.ForConfirmingCustomerEmailAddresses
 	changeCustomerEmailAddress  hexagon.ForChangingCustomerEmailAddresses
 	changeCustomerName          hexagon.ForChangingCustomerNames
+	setCustomerPassword         hexagon.ForSettingCustomerPasswords
+	changeCustomerPassword      hexagon.ForChangingCustomerPasswords
+	verifyCustomerCredentials   hexagon.ForVerifyingCustomerCredentials
+	requestAccountRecovery      hexagon.ForRequestingCustomerAccountRecoveries
+	completeAccountRecovery     hexagon.ForCompletingCustomerAccountRecoveries
+	startMFAEnrollment          hexagon.ForStartingCustomerMFAEnrollments
+	confirmMFAEnrollment        hexagon.ForConfirmingCustomerMFAEnrollments
+	disableMFA                  hexagon.ForDisablingCustomerMFA
+	verifyMFACode               hexagon.ForVerifyingCustomerMFACodes
+	setCustomerAttribute        hexagon.ForSettingCustomerAttributes
+	defineCustomAttribute       hexagon.ForDefiningCustomAttributes
 	deleteCustomer              hexagon.ForDeletingCustomers
 	customerViewByID            hexagon.ForRetrievingCustomerViews
+	customerViewByEmailAddress  hexagon.ForRetrievingCustomerViewsByEmailAddress
+	listCustomers               hexagon.ForListingCustomers
+	searchCustomers             hexagon.ForSearchingCustomers
+	detectDuplicateCustomers    hexagon.ForDetectingDuplicateCustomers
+	listDuplicateCandidates     hexagon.ForListingDuplicateCustomerCandidates
+	markAsNotDuplicate          hexagon.ForMarkingCustomersAsNotDuplicate
+	registrationFunnel          hexagon.ForRetrievingCustomerRegistrationFunnels
+	importCustomer              hexagon.ForImportingCustomers
+	exportCustomerData          hexagon.ForExportingCustomerData
 }
 
 type acceptanceTestArtifacts struct {
@@ -93,7 +118,7 @@ func TestCustomerAcceptanceScenarios_ForRegisteringCustomers(t *testing.T) {
 				customerID, _ = givenCustomerRegistered(aa)
 
 				Convey("And given the first Customer deleted her account", func() {
-					err = ac.deleteCustomer(customerID.String())
+					err = ac.deleteCustomer(customerID.String(), 0)
 					So(err, ShouldBeNil)
 
 					Convey(fmt.Sprintf("When another Customer registers with the same email address [%s]", aa.emailAddress), func() {
@@ -106,7 +131,7 @@ func TestCustomerAcceptanceScenarios_ForRegisteringCustomers(t *testing.T) {
 				})
 
 				Convey(fmt.Sprintf("Or given the first Customer changed her email address to [%s]", aa.newEmailAddress), func() {
-					err = ac.changeCustomerEmailAddress(customerID.String(), aa.newEmailAddress)
+					err = ac.changeCustomerEmailAddress(customerID.String(), aa.newEmailAddress, 0)
 					So(err, ShouldBeNil)
 
 					Convey(fmt.Sprintf("When another Customer registers with the same email address [%s]", aa.emailAddress), func() {
@@ -161,6 +186,52 @@ func TestCustomerAcceptanceScenarios_ForRegisteringCustomers(t *testing.T) {
 	})
 }
 
+func TestCustomerAcceptanceScenarios_ForImportingCustomers(t *testing.T) {
+	ac := bootstrapAcceptanceTestCollaborators()
+
+	Convey("Prepare test artifacts", t, func() {
+		var err error
+		var customerID value.CustomerID
+		var expectedCustomerView customer.View
+		var actualCustomerView customer.View
+
+		aa := acceptanceTestArtifacts{
+			emailAddress: "sheila@jackson.net",
+			givenName:    "Sheila",
+			familyName:   "Jackson",
+		}
+
+		Convey("\nSCENARIO: A Customer with a confirmed email address is imported from another system", func() {
+			Convey(fmt.Sprintf("When a Customer is imported as [%s %s] with the confirmed [%s]", aa.givenName, aa.familyName, aa.emailAddress), func() {
+				customerID, err = ac.importCustomer(aa.emailAddress, aa.givenName, aa.familyName, true)
+				So(err, ShouldBeNil)
+
+				Convey("Then the account should show the imported data with a confirmed email address", func() {
+					actualCustomerView, err = ac.customerViewByID(customerID.String())
+					So(err, ShouldBeNil)
+					expectedCustomerView = buildDefaultCustomerViewForAcceptanceTest(customerID, aa)
+					expectedCustomerView.IsEmailAddressConfirmed = true
+					expectedCustomerView.Version = 2
+					So(actualCustomerView, ShouldResemble, expectedCustomerView)
+				})
+
+				Convey("And when the same Customer is imported again", func() {
+					_, err = ac.importCustomer(aa.emailAddress, aa.givenName, aa.familyName, true)
+
+					Convey("Then it should fail as duplicate", func() {
+						So(errors.Is(err, shared.ErrDuplicate), ShouldBeTrue)
+					})
+				})
+			})
+		})
+
+		Reset(func() {
+			err = atPurgeCustomerEventStream(customerID)
+			So(err, ShouldBeNil)
+		})
+	})
+}
+
 func TestCustomerAcceptanceScenarios_ForConfirmingCustomerEmailAddresses(t *testing.T) {
 	ac := bootstrapAcceptanceTestCollaborators()
 
@@ -183,7 +254,7 @@ func TestCustomerAcceptanceScenarios_ForConfirmingCustomerEmailAddresses(t *test
 				customerID, confirmationHash = givenCustomerRegistered(aa)
 
 				Convey("When he confirms his email address", func() {
-					err = ac.confirmCustomerEmailAddress(customerID.String(), confirmationHash.String())
+					err = ac.confirmCustomerEmailAddress(customerID.String(), confirmationHash.String(), 0)
 					So(err, ShouldBeNil)
 
 					Convey("Then his email address should be confirmed", func() {
@@ -195,7 +266,7 @@ func TestCustomerAcceptanceScenarios_ForConfirmingCustomerEmailAddresses(t *test
 						So(actualCustomerView, ShouldResemble, expectedCustomerView)
 
 						Convey("And when he confirms his email address again", func() {
-							err = ac.confirmCustomerEmailAddress(customerID.String(), confirmationHash.String())
+							err = ac.confirmCustomerEmailAddress(customerID.String(), confirmationHash.String(), 0)
 							So(err, ShouldBeNil)
 
 							Convey("Then his email address should still be confirmed", func() {
@@ -214,7 +285,7 @@ func TestCustomerAcceptanceScenarios_ForConfirmingCustomerEmailAddresses(t *test
 				customerID, _ = givenCustomerRegistered(aa)
 
 				Convey("When he tries to confirm his email address with a wrong confirmation hash", func() {
-					err = ac.confirmCustomerEmailAddress(customerID.String(), "invalid_confirmation_hash")
+					err = ac.confirmCustomerEmailAddress(customerID.String(), "invalid_confirmation_hash", 0)
 
 					Convey("Then he should receive an error", func() {
 						So(errors.Is(err, shared.ErrDomainConstraintsViolation), ShouldBeTrue)
@@ -239,7 +310,7 @@ func TestCustomerAcceptanceScenarios_ForConfirmingCustomerEmailAddresses(t *test
 					givenCustomerEmailAddressWasConfirmed(customerID, aa, 2)
 
 					Convey("When he tries to confirm his email address again with a wrong confirmation hash", func() {
-						err = ac.confirmCustomerEmailAddress(customerID.String(), "invalid_confirmation_hash")
+						err = ac.confirmCustomerEmailAddress(customerID.String(), "invalid_confirmation_hash", 0)
 
 						Convey("Then he should receive an error", func() {
 							So(errors.Is(err, shared.ErrDomainConstraintsViolation), ShouldBeTrue)
@@ -269,7 +340,7 @@ func TestCustomerAcceptanceScenarios_ForConfirmingCustomerEmailAddresses(t *test
 						confirmationHash = givenCustomerEmailAddressWasChanged(customerID, aa, 3)
 
 						Convey("When he confirms his changed email address", func() {
-							err = ac.confirmCustomerEmailAddress(customerID.String(), confirmationHash.String())
+							err = ac.confirmCustomerEmailAddress(customerID.String(), confirmationHash.String(), 0)
 							So(err, ShouldBeNil)
 
 							Convey(fmt.Sprintf("Then his email address should be [%s] and confirmed", aa.newEmailAddress), func() {
@@ -292,7 +363,7 @@ func TestCustomerAcceptanceScenarios_ForConfirmingCustomerEmailAddresses(t *test
 				customerID, confirmationHash = givenCustomerRegistered(aa)
 
 				Convey("When he supplies an empty confirmation hash", func() {
-					err = ac.confirmCustomerEmailAddress(customerID.String(), "")
+					err = ac.confirmCustomerEmailAddress(customerID.String(), "", 0)
 
 					Convey("Then he should receive an error", func() {
 						So(err, ShouldBeError)
@@ -334,7 +405,7 @@ func TestCustomerAcceptanceScenarios_ForChangingCustomerEmailAddresses(t *testin
 					givenCustomerEmailAddressWasConfirmed(customerID, aa, 2)
 
 					Convey(fmt.Sprintf("When she changes her email address to [%s]", aa.newEmailAddress), func() {
-						err = ac.changeCustomerEmailAddress(customerID.String(), aa.newEmailAddress)
+						err = ac.changeCustomerEmailAddress(customerID.String(), aa.newEmailAddress, 0)
 						So(err, ShouldBeNil)
 
 						Convey(fmt.Sprintf("Then her email address should be [%s] and unconfirmed", aa.newEmailAddress), func() {
@@ -346,7 +417,7 @@ func TestCustomerAcceptanceScenarios_ForChangingCustomerEmailAddresses(t *testin
 							So(actualCustomerView, ShouldResemble, expectedCustomerView)
 
 							Convey(fmt.Sprintf("And when she tries to change her email address to [%s] again", aa.newEmailAddress), func() {
-								err = ac.changeCustomerEmailAddress(customerID.String(), aa.newEmailAddress)
+								err = ac.changeCustomerEmailAddress(customerID.String(), aa.newEmailAddress, 0)
 								So(err, ShouldBeNil)
 
 								Convey(fmt.Sprintf("Then her email address should still be [%s]", aa.newEmailAddress), func() {
@@ -372,7 +443,7 @@ func TestCustomerAcceptanceScenarios_ForChangingCustomerEmailAddresses(t *testin
 						otherCustomerID, _ = givenCustomerRegistered(aa)
 
 						Convey(fmt.Sprintf("When she also tries to change her email address to [%s]", aa.newEmailAddress), func() {
-							err = ac.changeCustomerEmailAddress(otherCustomerID.String(), aa.newEmailAddress)
+							err = ac.changeCustomerEmailAddress(otherCustomerID.String(), aa.newEmailAddress, 0)
 
 							Convey("Then she should receive an error", func() {
 								So(err, ShouldBeError)
@@ -391,7 +462,7 @@ func TestCustomerAcceptanceScenarios_ForChangingCustomerEmailAddresses(t *testin
 				customerID, _ = givenCustomerRegistered(aa)
 
 				Convey(fmt.Sprintf("When she supplies an invalid email address [%s]", invalidEmailAddress), func() {
-					err = ac.changeCustomerEmailAddress(customerID.String(), invalidEmailAddress)
+					err = ac.changeCustomerEmailAddress(customerID.String(), invalidEmailAddress, 0)
 
 					Convey("Then she should receive an error", func() {
 						So(err, ShouldBeError)
@@ -433,7 +504,7 @@ func TestCustomerAcceptanceScenarios_ForChangingCustomerNames(t *testing.T) {
 				customerID, _ = givenCustomerRegistered(aa)
 
 				Convey(fmt.Sprintf("When he changes his name to [%s %s]", aa.newGivenName, aa.newFamilyName), func() {
-					err = ac.changeCustomerName(customerID.String(), aa.newGivenName, aa.newFamilyName)
+					err = ac.changeCustomerName(customerID.String(), aa.newGivenName, aa.newFamilyName, 0)
 					So(err, ShouldBeNil)
 
 					Convey(fmt.Sprintf("Then his name should be [%s %s]", aa.newGivenName, aa.newFamilyName), func() {
@@ -446,7 +517,7 @@ func TestCustomerAcceptanceScenarios_ForChangingCustomerNames(t *testing.T) {
 						So(actualCustomerView, ShouldResemble, expectedCustomerView)
 
 						Convey(fmt.Sprintf("And when he tries to change his name to [%s %s] again", aa.newGivenName, aa.newFamilyName), func() {
-							err = ac.changeCustomerName(customerID.String(), aa.newGivenName, aa.newFamilyName)
+							err = ac.changeCustomerName(customerID.String(), aa.newGivenName, aa.newFamilyName, 0)
 							So(err, ShouldBeNil)
 
 							Convey(fmt.Sprintf("Then his name should still be [%s %s]", aa.newGivenName, aa.newFamilyName), func() {
@@ -460,12 +531,43 @@ func TestCustomerAcceptanceScenarios_ForChangingCustomerNames(t *testing.T) {
 			})
 		})
 
+		Convey("\nSCENARIO: A Customer changes his name based on an outdated version of his account", func() {
+			Convey(fmt.Sprintf("Given a Customer registered as [%s %s] with [%s]", aa.givenName, aa.familyName, aa.emailAddress), func() {
+				customerID, _ = givenCustomerRegistered(aa)
+
+				Convey(fmt.Sprintf("And given his name was changed to [%s %s] after he read version 1", aa.newGivenName, aa.newFamilyName), func() {
+					err = ac.changeCustomerName(customerID.String(), aa.newGivenName, aa.newFamilyName, 1)
+					So(err, ShouldBeNil)
+
+					Convey("When he changes his name expecting version 1", func() {
+						err = ac.changeCustomerName(customerID.String(), aa.givenName, aa.familyName, 1)
+
+						Convey("Then he should receive an error which reports version 2", func() {
+							So(err, ShouldBeError)
+							So(errors.Is(err, shared.ErrConcurrencyConflict), ShouldBeTrue)
+
+							var wrongExpectedVersion *es.WrongExpectedVersionError
+							So(errors.As(err, &wrongExpectedVersion), ShouldBeTrue)
+							So(wrongExpectedVersion.ActualVersion, ShouldEqual, 2)
+
+							Convey(fmt.Sprintf("And his name should still be [%s %s]", aa.newGivenName, aa.newFamilyName), func() {
+								actualCustomerView, err = ac.customerViewByID(customerID.String())
+								So(err, ShouldBeNil)
+								So(actualCustomerView.GivenName, ShouldEqual, aa.newGivenName)
+								So(actualCustomerView.Version, ShouldEqual, 2)
+							})
+						})
+					})
+				})
+			})
+		})
+
 		Convey("\nSCENARIO: A Customer tries to change his name with invalid input", func() {
 			Convey(fmt.Sprintf("Given a Customer registered as [%s %s] with [%s]", aa.givenName, aa.familyName, aa.emailAddress), func() {
 				customerID, _ = givenCustomerRegistered(aa)
 
 				Convey("When he supplies an empty given name", func() {
-					err = ac.changeCustomerName(customerID.String(), "", aa.familyName)
+					err = ac.changeCustomerName(customerID.String(), "", aa.familyName, 0)
 
 					Convey("Then he should receive an error", func() {
 						So(err, ShouldBeError)
@@ -474,7 +576,7 @@ func TestCustomerAcceptanceScenarios_ForChangingCustomerNames(t *testing.T) {
 				})
 
 				Convey("When he supplies an empty family name", func() {
-					err = ac.changeCustomerName(customerID.String(), aa.givenName, "")
+					err = ac.changeCustomerName(customerID.String(), aa.givenName, "", 0)
 
 					Convey("Then he should receive an error", func() {
 						So(err, ShouldBeError)
@@ -491,135 +593,97 @@ func TestCustomerAcceptanceScenarios_ForChangingCustomerNames(t *testing.T) {
 	})
 }
 
-func TestCustomerAcceptanceScenarios_ForAddingBillingProfiles(t *testing.T) {
+func TestCustomerAcceptanceScenarios_ForRetrievingCustomersByEmailAddress(t *testing.T) {
+	ac := bootstrapAcceptanceTestCollaborators()
+
 	Convey("Prepare test artifacts", t, func() {
+		var err error
+		var customerID value.CustomerID
+		var expectedCustomerView customer.View
+		var actualCustomerView customer.View
+
 		aa := acceptanceTestArtifacts{
-			emailAddress: "karen@jackson.net",
-			givenName:    "Karen",
-			familyName:   "Jackson",
+			emailAddress: "debbie@gallagher.net",
+			givenName:    "Debbie",
+			familyName:   "Gallagher",
 		}
 
-		Convey("\nSCENARIO: A Customer adds a billing profile to her account", func() {
+		Convey("\nSCENARIO: A support agent looks up a Customer by email address", func() {
 			Convey(fmt.Sprintf("Given a Customer registered as [%s %s] with [%s]", aa.givenName, aa.familyName, aa.emailAddress), func() {
-				Convey("When she adds a billing profile ", func() {
-					Convey("Then her account should contain one billing profile", func() {
+				customerID, _ = givenCustomerRegistered(aa)
+
+				Convey(fmt.Sprintf("When the Customer is retrieved by [%s]", aa.emailAddress), func() {
+					actualCustomerView, err = ac.customerViewByEmailAddress(aa.emailAddress)
+					So(err, ShouldBeNil)
 
+					Convey("Then the Customer's account should be returned", func() {
+						expectedCustomerView = buildDefaultCustomerViewForAcceptanceTest(customerID, aa)
+						So(actualCustomerView, ShouldResemble, expectedCustomerView)
 					})
 				})
-			})
-		})
-	})
-}
-
-func TestCustomerAcceptanceScenarios_ForRemovingBillingProfiles(t *testing.T) {
-	Convey("Prepare test artifacts", t, func() {
-		aa := acceptanceTestArtifacts{
-			emailAddress: "karen@jackson.net",
-			givenName:    "Karen",
-			familyName:   "Jackson",
-		}
 
-		Convey("\nSCENARIO: A Customer removes a billing profile from her account", func() {
-			Convey(fmt.Sprintf("Given a Customer registered as [%s %s] with [%s]", aa.givenName, aa.familyName, aa.emailAddress), func() {
-				Convey("And given she added a billing profile", func() {
-					Convey("And given she added another billing profile", func() {
-						Convey("When she removes the first billing profile from her account", func() {
-							Convey("Then her account should contain only the second billing profile", func() {
+				Convey("When a Customer is retrieved by an unknown email address", func() {
+					_, err = ac.customerViewByEmailAddress("carl@gallagher.net")
 
-							})
-						})
+					Convey("Then it should report that the Customer was not found", func() {
+						So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
 					})
 				})
-			})
-		})
 
-		Convey("\nSCENARIO: A Customer tries to remove the only billing profile from her account", func() {
-			Convey(fmt.Sprintf("Given a Customer registered as [%s %s] with [%s]", aa.givenName, aa.familyName, aa.emailAddress), func() {
-				Convey("And given she added a billing profile", func() {
-					Convey("When she tries to remove the billing profile from her account", func() {
-						Convey("Then she should receive an error", func() {
+				Convey("When a Customer is retrieved by an invalid email address", func() {
+					_, err = ac.customerViewByEmailAddress("debbie(at)gallagher.net")
 
-						})
+					Convey("Then it should report that the input is invalid", func() {
+						So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
 					})
 				})
 			})
 		})
+
+		Reset(func() {
+			err = atPurgeCustomerEventStream(customerID)
+			So(err, ShouldBeNil)
+		})
 	})
 }
 
-func TestCustomerAcceptanceScenarios_ForDeletingCustomers(t *testing.T) {
+func TestCustomerAcceptanceScenarios_ForExportingCustomerData(t *testing.T) {
 	ac := bootstrapAcceptanceTestCollaborators()
 
 	Convey("Prepare test artifacts", t, func() {
 		var err error
 		var customerID value.CustomerID
-		var confirmationHash value.ConfirmationHash
-		var actualCustomerView customer.View
+		var dataExport customer.DataExport
 
 		aa := acceptanceTestArtifacts{
-			emailAddress:    "karen@jackson.net",
-			givenName:       "Karen",
-			familyName:      "Jackson",
-			newEmailAddress: "fiona@silverman.net",
-			newGivenName:    "Karen",
-			newFamilyName:   "Silverman",
+			emailAddress: "sheila@jackson.net",
+			givenName:    "Sheila",
+			familyName:   "Jackson",
 		}
 
-		Convey("\nSCENARIO: A Customer deletes her account", func() {
+		Convey("\nSCENARIO: A Customer exports all her data", func() {
 			Convey(fmt.Sprintf("Given a Customer registered as [%s %s] with [%s]", aa.givenName, aa.familyName, aa.emailAddress), func() {
-				customerID, confirmationHash = givenCustomerRegistered(aa)
+				customerID, _ = givenCustomerRegistered(aa)
 
-				Convey("When she deletes her account", func() {
-					err = ac.deleteCustomer(customerID.String())
+				Convey("When she exports her data", func() {
+					dataExport, err = ac.exportCustomerData(customerID.String())
 					So(err, ShouldBeNil)
 
-					Convey("And when she tries to retrieve her account data", func() {
-						actualCustomerView, err = ac.customerViewByID(customerID.String())
-
-						Convey("Then she should receive an error", func() {
-							So(err, ShouldBeError)
-							So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
-							So(actualCustomerView, ShouldBeZeroValue)
-						})
-					})
-
-					Convey("And when she tries to delete her account again", func() {
-						err = ac.deleteCustomer(customerID.String())
-						So(err, ShouldBeNil)
-
-						Convey("Then her account should still be deleted", func() {
-							actualCustomerView, err = ac.customerViewByID(customerID.String())
-							So(err, ShouldBeError)
-							So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
-							So(actualCustomerView, ShouldBeZeroValue)
-						})
-					})
-
-					Convey("And when she tries to confirm her email address", func() {
-						err = ac.confirmCustomerEmailAddress(customerID.String(), confirmationHash.String())
-
-						Convey("Then she should receive an error", func() {
-							So(err, ShouldBeError)
-							So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
-						})
+					Convey("Then the export should contain her account and its history", func() {
+						So(dataExport.View, ShouldResemble, buildDefaultCustomerViewForAcceptanceTest(customerID, aa))
+						So(dataExport.EventStream, ShouldHaveLength, 1)
+						So(dataExport.ExportedAt, ShouldNotBeZeroValue)
 					})
+				})
 
-					Convey("And when she tries to change her email address", func() {
-						err = ac.changeCustomerEmailAddress(customerID.String(), aa.newEmailAddress)
-
-						Convey("Then she should receive an error", func() {
-							So(err, ShouldBeError)
-							So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
-						})
-					})
+				Convey("When she deletes her account and then exports her data", func() {
+					err = ac.deleteCustomer(customerID.String(), 0)
+					So(err, ShouldBeNil)
 
-					Convey("And when she tries to change her name", func() {
-						err = ac.changeCustomerName(customerID.String(), aa.newGivenName, aa.newFamilyName)
+					_, err = ac.exportCustomerData(customerID.String())
 
-						Convey("Then she should receive an error", func() {
-							So(err, ShouldBeError)
-							So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
-						})
+					Convey("Then it should report that the Customer was not found", func() {
+						So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
 					})
 				})
 			})
@@ -632,131 +696,130 @@ func TestCustomerAcceptanceScenarios_ForDeletingCustomers(t *testing.T) {
 	})
 }
 
-func TestCustomerAcceptanceScenarios_WhenCustomerWasNeverRegistered(t *testing.T) {
+func TestCustomerAcceptanceScenarios_ForListingCustomers(t *testing.T) {
 	ac := bootstrapAcceptanceTestCollaborators()
 
 	Convey("Prepare test artifacts", t, func() {
 		var err error
-		var actualCustomerView customer.View
+		var customerIDs []value.CustomerID
+		var firstPage customer.ListPage
+		var secondPage customer.ListPage
+
+		registeredFrom := time.Now().Add(-time.Second)
+		isConfirmed := true
+
+		Convey("\nSCENARIO: A back-office user pages through the Customer list", func() {
+			Convey("Given three Customers were registered", func() {
+				for _, givenName := range []string{"Carl", "Liam", "Frank"} {
+					customerID, _ := givenCustomerRegistered(acceptanceTestArtifacts{
+						emailAddress: fmt.Sprintf("%s@gallagher.net", strings.ToLower(givenName)),
+						givenName:    givenName,
+						familyName:   "Gallagher",
+					})
 
-		aa := acceptanceTestArtifacts{
-			newEmailAddress: "fiona@pratt.net",
-			newGivenName:    "Fiona",
-			newFamilyName:   "Pratt",
-		}
+					customerIDs = append(customerIDs, customerID)
+				}
 
-		customerID := value.GenerateCustomerID()
-		confirmationHash := value.RebuildConfirmationHash(aa.newEmailAddress)
+				Convey("When the first page with two Customers sorted by email address is listed", func() {
+					criteria := customer.ListCriteria{
+						RegisteredFrom: registeredFrom,
+						SortBy:         customer.ListSortByEmailAddress,
+						PageSize:       2,
+					}
 
-		Convey("\nSCENARIO: A hacker tries to play around with a non existing Customer account by guessing IDs", func() {
-			Convey("When he tries to retrieve data for a non existing account", func() {
-				actualCustomerView, err = ac.customerViewByID(customerID.String())
+					firstPage, err = ac.listCustomers(criteria)
+					So(err, ShouldBeNil)
 
-				Convey("Then he should receive an error", func() {
-					So(err, ShouldBeError)
-					So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
-					So(actualCustomerView, ShouldBeZeroValue)
-				})
-			})
+					Convey("Then it should contain the first two Customers and a cursor", func() {
+						So(firstPage.Entries, ShouldHaveLength, 2)
+						So(firstPage.Entries[0].EmailAddress, ShouldEqual, "carl@gallagher.net")
+						So(firstPage.Entries[1].EmailAddress, ShouldEqual, "frank@gallagher.net")
+						So(firstPage.NextCursor, ShouldNotBeEmpty)
 
-			Convey("And when he tries to confirm an email address", func() {
-				err = ac.confirmCustomerEmailAddress(customerID.String(), confirmationHash.String())
+						Convey("And when the next page is listed with the cursor", func() {
+							criteria.Cursor = firstPage.NextCursor
+							secondPage, err = ac.listCustomers(criteria)
+							So(err, ShouldBeNil)
 
-				Convey("Then he should receive an error", func() {
-					So(err, ShouldBeError)
-					So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
-				})
-			})
+							Convey("Then it should contain the remaining Customer and no cursor", func() {
+								So(secondPage.Entries, ShouldHaveLength, 1)
+								So(secondPage.Entries[0].EmailAddress, ShouldEqual, "liam@gallagher.net")
+								So(secondPage.NextCursor, ShouldBeEmpty)
+							})
+						})
 
-			Convey("And when he tries to change an email address", func() {
-				err = ac.changeCustomerEmailAddress(customerID.String(), aa.newEmailAddress)
+						Convey("And when the next page is listed with the cursor but a different sorting", func() {
+							criteria.Cursor = firstPage.NextCursor
+							criteria.SortDescending = true
+							_, err = ac.listCustomers(criteria)
 
-				Convey("Then he should receive an error", func() {
-					So(err, ShouldBeError)
-					So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
+							Convey("Then it should fail", func() {
+								So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+							})
+						})
+					})
 				})
-			})
 
-			Convey("And when he tries to change a name", func() {
-				err = ac.changeCustomerName(customerID.String(), aa.newGivenName, aa.newFamilyName)
+				Convey("When only Customers with a confirmed email address are listed", func() {
+					firstPage, err = ac.listCustomers(
+						customer.ListCriteria{RegisteredFrom: registeredFrom, IsEmailAddressConfirmed: &isConfirmed},
+					)
+					So(err, ShouldBeNil)
 
-				Convey("Then he should receive an error", func() {
-					So(err, ShouldBeError)
-					So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
+					Convey("Then the list should be empty", func() {
+						So(firstPage.Entries, ShouldBeEmpty)
+					})
 				})
 			})
+		})
 
-			Convey("And when he tries to delete an account", func() {
-				err = ac.deleteCustomer(customerID.String())
+		Reset(func() {
+			for _, customerID := range customerIDs {
+				err = atPurgeCustomerEventStream(customerID)
+				So(err, ShouldBeNil)
+			}
 
-				Convey("Then he should receive an error", func() {
-					So(err, ShouldBeError)
-					So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
-				})
-			})
+			customerIDs = nil
 		})
 	})
 }
 
-func TestCustomerAcceptanceScenarios_InvalidClientInput(t *testing.T) {
+func TestCustomerAcceptanceScenarios_ForSearchingCustomers(t *testing.T) {
 	ac := bootstrapAcceptanceTestCollaborators()
 
 	Convey("Prepare test artifacts", t, func() {
 		var err error
 		var customerID value.CustomerID
-		var confirmationHash value.ConfirmationHash
+		var entries []customer.ListEntry
 
 		aa := acceptanceTestArtifacts{
-			emailAddress: "fiona@gallagher.net",
-			givenName:    "Fiona",
-			familyName:   "Gallagher",
+			emailAddress: "mickey.milkovich@southside.net",
+			givenName:    "Mickey",
+			familyName:   "Milkovich",
 		}
 
-		Convey("\nSCENARIO: A client (web, app, ...) of the application sends an empty id", func() {
+		Convey("\nSCENARIO: A support agent searches for a Customer", func() {
 			Convey(fmt.Sprintf("Given a Customer registered as [%s %s] with [%s]", aa.givenName, aa.familyName, aa.emailAddress), func() {
-				customerID, confirmationHash = givenCustomerRegistered(aa)
-
-				Convey("When she tries to confirm her email address with an empty id", func() {
-					err = ac.confirmCustomerEmailAddress("", confirmationHash.String())
-
-					Convey("Then she should receive an error", func() {
-						So(err, ShouldBeError)
-						So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
-					})
-				})
-
-				Convey("When she tries to change her email address with an empty id", func() {
-					err = ac.changeCustomerEmailAddress("", aa.emailAddress)
-
-					Convey("Then she should receive an error", func() {
-						So(err, ShouldBeError)
-						So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
-					})
-				})
-
-				Convey("When she tries to change her name with an empty id", func() {
-					err = ac.changeCustomerName("", aa.givenName, aa.familyName)
+				customerID, _ = givenCustomerRegistered(aa)
 
-					Convey("Then she should receive an error", func() {
-						So(err, ShouldBeError)
-						So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
-					})
-				})
+				for _, searchTerm := range []string{"Milko", "mickey milk", "Milkovitch", "mickey.milkovich"} {
+					currentSearchTerm := searchTerm
 
-				Convey("When she tries to delete her account with an empty id", func() {
-					err = ac.deleteCustomer("")
+					Convey(fmt.Sprintf("When Customers are searched for [%s]", currentSearchTerm), func() {
+						entries, err = ac.searchCustomers(currentSearchTerm, 0)
+						So(err, ShouldBeNil)
 
-					Convey("Then she should receive an error", func() {
-						So(err, ShouldBeError)
-						So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+						Convey("Then the Customer should be found", func() {
+							So(entries, ShouldNotBeEmpty)
+							So(entries[0].ID, ShouldEqual, customerID.String())
+						})
 					})
-				})
+				}
 
-				Convey("When she tries to retrieve her account with an empty id", func() {
-					_, err = ac.customerViewByID("")
+				Convey("When Customers are searched for a single character", func() {
+					_, err = ac.searchCustomers("M", 0)
 
-					Convey("Then she should receive an error", func() {
-						So(err, ShouldBeError)
+					Convey("Then it should fail", func() {
 						So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
 					})
 				})
@@ -770,8 +833,850 @@ func TestCustomerAcceptanceScenarios_InvalidClientInput(t *testing.T) {
 	})
 }
 
-func givenCustomerRegistered(
-	aa acceptanceTestArtifacts,
+func TestCustomerAcceptanceScenarios_ForDetectingDuplicateCustomers(t *testing.T) {
+	ac := bootstrapAcceptanceTestCollaborators()
+
+	Convey("Prepare test artifacts", t, func() {
+		var err error
+		var customerID value.CustomerID
+		var otherCustomerID value.CustomerID
+		var candidates []customer.DuplicateCandidate
+
+		aa := acceptanceTestArtifacts{
+			emailAddress: "lip.gallagher@southside.net",
+			givenName:    "Phillip",
+			familyName:   "Gallagher",
+		}
+
+		otherAA := acceptanceTestArtifacts{
+			emailAddress: "Lip.Gallagher+college@southside.net",
+			givenName:    "Philip",
+			familyName:   "Gallagher",
+		}
+
+		reasonsFor := func(candidates []customer.DuplicateCandidate) []string {
+			var reasons []string
+
+			for _, candidate := range candidates {
+				ids := []string{candidate.Customer.ID, candidate.OtherCustomer.ID}
+				if (ids[0] == customerID.String() || ids[1] == customerID.String()) &&
+					(ids[0] == otherCustomerID.String() || ids[1] == otherCustomerID.String()) {
+
+					reasons = append(reasons, candidate.Reason)
+				}
+			}
+
+			return reasons
+		}
+
+		Convey("\nSCENARIO: The same person registered twice with a plus-tagged email address", func() {
+			Convey(fmt.Sprintf("Given a Customer registered as [%s %s] with [%s]", aa.givenName, aa.familyName, aa.emailAddress), func() {
+				customerID, _ = givenCustomerRegistered(aa)
+
+				Convey(fmt.Sprintf("And a Customer registered as [%s %s] with [%s]", otherAA.givenName, otherAA.familyName, otherAA.emailAddress), func() {
+					otherCustomerID, _ = givenCustomerRegistered(otherAA)
+
+					Convey("When duplicate Customers are detected", func() {
+						_, err = ac.detectDuplicateCustomers()
+						So(err, ShouldBeNil)
+
+						Convey("Then both Customers should be listed as candidates for both reasons", func() {
+							candidates, err = ac.listDuplicateCandidates(false)
+							So(err, ShouldBeNil)
+							So(reasonsFor(candidates), ShouldContain, customer.DuplicateReasonEmailPlusTag)
+							So(reasonsFor(candidates), ShouldContain, customer.DuplicateReasonSimilarName)
+
+							Convey("When they are marked as not duplicate", func() {
+								err = ac.markAsNotDuplicate(otherCustomerID.String(), customerID.String())
+								So(err, ShouldBeNil)
+
+								Convey("And duplicate Customers are detected again", func() {
+									_, err = ac.detectDuplicateCustomers()
+									So(err, ShouldBeNil)
+
+									Convey("Then they should not be listed as open candidates anymore", func() {
+										candidates, err = ac.listDuplicateCandidates(false)
+										So(err, ShouldBeNil)
+										So(reasonsFor(candidates), ShouldBeEmpty)
+
+										Convey("But they should be listed as reviewed candidates", func() {
+											candidates, err = ac.listDuplicateCandidates(true)
+											So(err, ShouldBeNil)
+											So(reasonsFor(candidates), ShouldHaveLength, 2)
+										})
+									})
+								})
+							})
+						})
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO: A pair of Customers which was never detected is marked as not duplicate", func() {
+			Convey("When two unknown Customers are marked as not duplicate", func() {
+				err = ac.markAsNotDuplicate(value.GenerateCustomerID().String(), value.GenerateCustomerID().String())
+
+				Convey("Then it should fail", func() {
+					So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
+				})
+			})
+		})
+
+		Reset(func() {
+			err = atPurgeCustomerEventStream(customerID)
+			So(err, ShouldBeNil)
+
+			err = atPurgeCustomerEventStream(otherCustomerID)
+			So(err, ShouldBeNil)
+		})
+	})
+}
+
+func TestCustomerAcceptanceScenarios_ForRegistrationFunnelAnalytics(t *testing.T) {
+	ac := bootstrapAcceptanceTestCollaborators()
+
+	Convey("Prepare test artifacts", t, func() {
+		var err error
+		var customerID value.CustomerID
+		var confirmationHash value.ConfirmationHash
+		var before customer.FunnelPeriod
+		var after customer.FunnelPeriod
+
+		aa := acceptanceTestArtifacts{
+			emailAddress: "carl.gallagher@southside.net",
+			givenName:    "Carl",
+			familyName:   "Gallagher",
+		}
+
+		today := func() customer.FunnelPeriod {
+			periods, err := ac.registrationFunnel(customer.FunnelCriteria{})
+			So(err, ShouldBeNil)
+			So(periods, ShouldHaveLength, 30)
+
+			return periods[len(periods)-1]
+		}
+
+		Convey("\nSCENARIO: A Customer registers, fails to confirm, confirms and gets deleted", func() {
+			before = today()
+
+			Convey(fmt.Sprintf("Given a Customer registered as [%s %s] with [%s]", aa.givenName, aa.familyName, aa.emailAddress), func() {
+				customerID, confirmationHash = givenCustomerRegistered(aa)
+
+				Convey("And the Customer failed to confirm the email address once", func() {
+					err = ac.confirmCustomerEmailAddress(customerID.String(), "invalid_confirmation_hash", 0)
+					So(errors.Is(err, shared.ErrDomainConstraintsViolation), ShouldBeTrue)
+
+					Convey("And the Customer confirmed the email address", func() {
+						err = ac.confirmCustomerEmailAddress(customerID.String(), confirmationHash.String(), 0)
+						So(err, ShouldBeNil)
+
+						Convey("And the Customer was deleted", func() {
+							err = ac.deleteCustomer(customerID.String(), 0)
+							So(err, ShouldBeNil)
+
+							Convey("When the registration funnel is retrieved", func() {
+								after = today()
+
+								Convey("Then today's period should contain all of it", func() {
+									So(after.PeriodStart, ShouldEqual, before.PeriodStart)
+									So(after.Registrations, ShouldEqual, before.Registrations+1)
+									So(after.Confirmations, ShouldEqual, before.Confirmations+1)
+									So(after.ConfirmationFailures, ShouldEqual, before.ConfirmationFailures+1)
+									So(after.Deletions, ShouldEqual, before.Deletions+1)
+									So(after.ConfirmationFailureRate, ShouldBeGreaterThan, 0)
+								})
+							})
+						})
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO: The registration funnel is retrieved for an unsupported interval", func() {
+			Convey("When the registration funnel is retrieved per hour", func() {
+				_, err = ac.registrationFunnel(customer.FunnelCriteria{Interval: "hour"})
+
+				Convey("Then it should fail", func() {
+					So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+				})
+			})
+		})
+
+		Reset(func() {
+			err = atPurgeCustomerEventStream(customerID)
+			So(err, ShouldBeNil)
+		})
+	})
+}
+
+func TestCustomerAcceptanceScenarios_ForCustomerPasswords(t *testing.T) {
+	ac := bootstrapAcceptanceTestCollaborators()
+
+	Convey("Prepare test artifacts", t, func() {
+		var err error
+		var customerID value.CustomerID
+		var verifiedCustomerID value.CustomerID
+		var expectedCustomerView customer.View
+		var actualCustomerView customer.View
+
+		aa := acceptanceTestArtifacts{
+			emailAddress: "ian@gallagher.net",
+			givenName:    "Ian",
+			familyName:   "Gallagher",
+		}
+
+		password := "Correct-Horse-1"
+		newPassword := "Battery-Staple-2"
+
+		Convey("\nSCENARIO: A Customer sets and changes his password and logs in with it", func() {
+			Convey(fmt.Sprintf("Given a Customer registered as [%s %s] with [%s]", aa.givenName, aa.familyName, aa.emailAddress), func() {
+				customerID, _ = givenCustomerRegistered(aa)
+
+				Convey("When he sets his password", func() {
+					err = ac.setCustomerPassword(customerID.String(), password, 0)
+					So(err, ShouldBeNil)
+
+					Convey("Then he should be able to log in with it", func() {
+						verifiedCustomerID, err = ac.verifyCustomerCredentials(aa.emailAddress, password)
+						So(err, ShouldBeNil)
+						So(verifiedCustomerID.Equals(customerID), ShouldBeTrue)
+
+						Convey("And his account should not expose the password", func() {
+							actualCustomerView, err = ac.customerViewByID(customerID.String())
+							So(err, ShouldBeNil)
+							expectedCustomerView = buildDefaultCustomerViewForAcceptanceTest(customerID, aa)
+							expectedCustomerView.Version = 2
+							So(actualCustomerView, ShouldResemble, expectedCustomerView)
+						})
+
+						Convey("And when he changes his password", func() {
+							err = ac.changeCustomerPassword(customerID.String(), password, newPassword, 0)
+							So(err, ShouldBeNil)
+
+							Convey("Then he should be able to log in with the new password only", func() {
+								verifiedCustomerID, err = ac.verifyCustomerCredentials(aa.emailAddress, newPassword)
+								So(err, ShouldBeNil)
+								So(verifiedCustomerID.Equals(customerID), ShouldBeTrue)
+
+								_, err = ac.verifyCustomerCredentials(aa.emailAddress, password)
+								So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+							})
+						})
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO: A Customer can't log in with wrong credentials", func() {
+			Convey(fmt.Sprintf("Given a Customer registered as [%s %s] with [%s]", aa.givenName, aa.familyName, aa.emailAddress), func() {
+				customerID, _ = givenCustomerRegistered(aa)
+
+				Convey("When he tries to log in before he has set a password", func() {
+					_, err = ac.verifyCustomerCredentials(aa.emailAddress, password)
+
+					Convey("Then he should receive an error", func() {
+						So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+					})
+				})
+
+				Convey("When he tries to log in with an unknown email address", func() {
+					_, err = ac.verifyCustomerCredentials("ian@milkovich.net", password)
+
+					Convey("Then he should receive the same error", func() {
+						So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+					})
+				})
+
+				Convey("When he tries to change his password with a wrong current password", func() {
+					err = ac.setCustomerPassword(customerID.String(), password, 0)
+					So(err, ShouldBeNil)
+
+					err = ac.changeCustomerPassword(customerID.String(), newPassword, newPassword, 0)
+
+					Convey("Then he should receive an error", func() {
+						So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+					})
+				})
+
+				Convey("When he tries to set a password violating the password policy", func() {
+					err = ac.setCustomerPassword(customerID.String(), "secret", 0)
+
+					Convey("Then he should receive an error", func() {
+						So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+					})
+				})
+			})
+		})
+
+		Reset(func() {
+			err = atPurgeCustomerEventStream(customerID)
+			So(err, ShouldBeNil)
+		})
+	})
+}
+
+func TestCustomerAcceptanceScenarios_ForCustomerAccountRecovery(t *testing.T) {
+	ac := bootstrapAcceptanceTestCollaborators()
+
+	Convey("Prepare test artifacts", t, func() {
+		var err error
+		var customerID value.CustomerID
+		var verifiedCustomerID value.CustomerID
+
+		aa := acceptanceTestArtifacts{
+			emailAddress: "fiona@gallagher.net",
+			givenName:    "Fiona",
+			familyName:   "Gallagher",
+		}
+
+		newPassword := "Battery-Staple-2"
+
+		Convey("\nSCENARIO: A Customer recovers her account and logs in with a new password", func() {
+			Convey(fmt.Sprintf("Given a Customer registered as [%s %s] with [%s]", aa.givenName, aa.familyName, aa.emailAddress), func() {
+				customerID, _ = givenCustomerRegistered(aa)
+
+				Convey("When she requests an account recovery", func() {
+					err = ac.requestAccountRecovery(aa.emailAddress, atClientAddress)
+					So(err, ShouldBeNil)
+
+					Convey("Then she should receive a recovery token", func() {
+						recoveryToken := atSentAccountRecoveryTokens[aa.emailAddress]
+						So(recoveryToken, ShouldNotBeEmpty)
+
+						Convey("And when she completes the account recovery with it", func() {
+							err = ac.completeAccountRecovery(aa.emailAddress, recoveryToken, newPassword, atClientAddress)
+							So(err, ShouldBeNil)
+
+							Convey("Then she should be able to log in with the new password", func() {
+								verifiedCustomerID, err = ac.verifyCustomerCredentials(aa.emailAddress, newPassword)
+								So(err, ShouldBeNil)
+								So(verifiedCustomerID.Equals(customerID), ShouldBeTrue)
+							})
+
+							Convey("And she should not be able to use the recovery token again", func() {
+								err = ac.completeAccountRecovery(aa.emailAddress, recoveryToken, newPassword, atClientAddress)
+								So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+							})
+						})
+					})
+				})
+
+				Convey("When she tries to complete an account recovery with a wrong token", func() {
+					err = ac.completeAccountRecovery(aa.emailAddress, "wrong-token", newPassword, atClientAddress)
+
+					Convey("Then she should receive an error", func() {
+						So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO: An account recovery does not reveal unknown email addresses", func() {
+			Convey("When an account recovery is requested for an unknown email address", func() {
+				err = ac.requestAccountRecovery("nobody@gallagher.net", atClientAddress)
+
+				Convey("Then it should succeed without sending a recovery token", func() {
+					So(err, ShouldBeNil)
+					So(atSentAccountRecoveryTokens, ShouldNotContainKey, "nobody@gallagher.net")
+
+					Convey("And completing it should fail like a wrong token", func() {
+						err = ac.completeAccountRecovery("nobody@gallagher.net", "some-token", newPassword, atClientAddress)
+						So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+					})
+				})
+			})
+		})
+
+		Reset(func() {
+			err = atPurgeCustomerEventStream(customerID)
+			So(err, ShouldBeNil)
+		})
+	})
+}
+
+func TestCustomerAcceptanceScenarios_ForCustomerMFA(t *testing.T) {
+	ac := bootstrapAcceptanceTestCollaborators()
+
+	Convey("Prepare test artifacts", t, func() {
+		var err error
+		var customerID value.CustomerID
+		var totpSecret value.TOTPSecret
+		var recoveryCodes []string
+		var expectedCustomerView customer.View
+		var actualCustomerView customer.View
+
+		aa := acceptanceTestArtifacts{
+			emailAddress: "lip@gallagher.net",
+			givenName:    "Lip",
+			familyName:   "Gallagher",
+		}
+
+		Convey("\nSCENARIO: A Customer enrolls in MFA and verifies codes", func() {
+			Convey(fmt.Sprintf("Given a Customer registered as [%s %s] with [%s]", aa.givenName, aa.familyName, aa.emailAddress), func() {
+				customerID, _ = givenCustomerRegistered(aa)
+
+				Convey("When he starts the MFA enrollment", func() {
+					totpSecret, recoveryCodes, err = ac.startMFAEnrollment(customerID.String())
+					So(err, ShouldBeNil)
+					So(totpSecret.IsEmpty(), ShouldBeFalse)
+					So(recoveryCodes, ShouldNotBeEmpty)
+
+					Convey("And he confirms it with a code from his authenticator app", func() {
+						confirmationCode := totpSecret.CodeAt(time.Now())
+						err = ac.confirmMFAEnrollment(customerID.String(), confirmationCode, 0)
+						So(err, ShouldBeNil)
+
+						Convey("Then MFA should be enabled for his account", func() {
+							actualCustomerView, err = ac.customerViewByID(customerID.String())
+							So(err, ShouldBeNil)
+							expectedCustomerView = buildDefaultCustomerViewForAcceptanceTest(customerID, aa)
+							expectedCustomerView.IsMFAEnabled = true
+							expectedCustomerView.Version = 4
+							So(actualCustomerView, ShouldResemble, expectedCustomerView)
+
+							Convey("And he should be able to verify the next code from his authenticator app only once", func() {
+								nextCode := totpSecret.CodeAt(time.Now().Add(30 * time.Second))
+
+								err = ac.verifyMFACode(customerID.String(), nextCode)
+								So(err, ShouldBeNil)
+
+								err = ac.verifyMFACode(customerID.String(), nextCode)
+								So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+							})
+
+							Convey("And he should not be able to verify the code he confirmed with again", func() {
+								err = ac.verifyMFACode(customerID.String(), confirmationCode)
+								So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+							})
+
+							Convey("And he should be able to use a recovery code only once", func() {
+								err = ac.verifyMFACode(customerID.String(), recoveryCodes[0])
+								So(err, ShouldBeNil)
+
+								err = ac.verifyMFACode(customerID.String(), recoveryCodes[0])
+								So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+							})
+
+							Convey("And he should not be able to verify a wrong code", func() {
+								err = ac.verifyMFACode(customerID.String(), "000000x")
+								So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+							})
+
+							Convey("And when he disables MFA with a recovery code", func() {
+								err = ac.disableMFA(customerID.String(), recoveryCodes[1], 0)
+								So(err, ShouldBeNil)
+
+								Convey("Then MFA should be disabled for his account", func() {
+									actualCustomerView, err = ac.customerViewByID(customerID.String())
+									So(err, ShouldBeNil)
+									So(actualCustomerView.IsMFAEnabled, ShouldBeFalse)
+								})
+							})
+						})
+					})
+
+					Convey("And he tries to confirm it with a wrong code", func() {
+						err = ac.confirmMFAEnrollment(customerID.String(), "000000x", 0)
+
+						Convey("Then he should receive an error", func() {
+							So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+						})
+					})
+				})
+
+				Convey("When he tries to verify a code without being enrolled", func() {
+					err = ac.verifyMFACode(customerID.String(), "123456")
+
+					Convey("Then he should receive an error", func() {
+						So(errors.Is(err, shared.ErrDomainConstraintsViolation), ShouldBeTrue)
+					})
+				})
+			})
+		})
+
+		Reset(func() {
+			err = atPurgeCustomerEventStream(customerID)
+			So(err, ShouldBeNil)
+		})
+	})
+}
+
+func TestCustomerAcceptanceScenarios_ForSettingCustomerAttributes(t *testing.T) {
+	ac := bootstrapAcceptanceTestCollaborators()
+
+	Convey("Prepare test artifacts", t, func() {
+		var err error
+		var customerID value.CustomerID
+		var expectedCustomerView customer.View
+		var actualCustomerView customer.View
+
+		aa := acceptanceTestArtifacts{
+			emailAddress: "veronica@fisher.net",
+			givenName:    "Veronica",
+			familyName:   "Fisher",
+		}
+
+		givenCustomAttributeWasDefined(ac, "vip_level", value.CustomAttributeTypeInteger, `^[1-5]$`)
+		givenCustomAttributeWasDefined(ac, "beta_tester", value.CustomAttributeTypeBoolean, "")
+
+		Convey("\nSCENARIO: A Customer gets custom attributes assigned", func() {
+			Convey(fmt.Sprintf("Given a Customer registered as [%s %s] with [%s]", aa.givenName, aa.familyName, aa.emailAddress), func() {
+				customerID, _ = givenCustomerRegistered(aa)
+
+				Convey("When her [vip_level] is set to [03] and [beta_tester] is set to [TRUE]", func() {
+					err = ac.setCustomerAttribute(customerID.String(), "vip_level", "03", 0)
+					So(err, ShouldBeNil)
+
+					err = ac.setCustomerAttribute(customerID.String(), "beta_tester", "TRUE", 0)
+					So(err, ShouldBeNil)
+
+					Convey("Then her account should contain both attributes with canonical values", func() {
+						actualCustomerView, err = ac.customerViewByID(customerID.String())
+						So(err, ShouldBeNil)
+						expectedCustomerView = buildDefaultCustomerViewForAcceptanceTest(customerID, aa)
+						expectedCustomerView.CustomAttributes = map[string]string{"vip_level": "3", "beta_tester": "true"}
+						expectedCustomerView.Version = 3
+						So(actualCustomerView, ShouldResemble, expectedCustomerView)
+
+						Convey("And when her [vip_level] is set to [3] again", func() {
+							err = ac.setCustomerAttribute(customerID.String(), "vip_level", "3", 0)
+							So(err, ShouldBeNil)
+
+							Convey("Then her account should be unchanged", func() {
+								actualCustomerView, err = ac.customerViewByID(customerID.String())
+								So(err, ShouldBeNil)
+								So(actualCustomerView, ShouldResemble, expectedCustomerView)
+							})
+						})
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO: A Customer can't get invalid or unknown custom attributes assigned", func() {
+			Convey(fmt.Sprintf("Given a Customer registered as [%s %s] with [%s]", aa.givenName, aa.familyName, aa.emailAddress), func() {
+				customerID, _ = givenCustomerRegistered(aa)
+
+				Convey("When her [vip_level] is set to a value not matching the definition", func() {
+					err = ac.setCustomerAttribute(customerID.String(), "vip_level", "9", 0)
+
+					Convey("Then it should fail", func() {
+						So(err, ShouldBeError)
+						So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+					})
+				})
+
+				Convey("When an attribute is set which was never defined", func() {
+					err = ac.setCustomerAttribute(customerID.String(), "shoe_size", "44", 0)
+
+					Convey("Then it should fail", func() {
+						So(err, ShouldBeError)
+						So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO: An admin can't redefine an existing custom attribute", func() {
+			Convey("When [vip_level] is defined again", func() {
+				err = ac.defineCustomAttribute("vip_level", value.CustomAttributeTypeString, "")
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldBeError)
+					So(errors.Is(err, shared.ErrDuplicate), ShouldBeTrue)
+				})
+			})
+		})
+
+		Reset(func() {
+			err = atPurgeCustomerEventStream(customerID)
+			So(err, ShouldBeNil)
+		})
+	})
+}
+
+func TestCustomerAcceptanceScenarios_ForAddingBillingProfiles(t *testing.T) {
+	Convey("Prepare test artifacts", t, func() {
+		aa := acceptanceTestArtifacts{
+			emailAddress: "karen@jackson.net",
+			givenName:    "Karen",
+			familyName:   "Jackson",
+		}
+
+		Convey("\nSCENARIO: A Customer adds a billing profile to her account", func() {
+			Convey(fmt.Sprintf("Given a Customer registered as [%s %s] with [%s]", aa.givenName, aa.familyName, aa.emailAddress), func() {
+				Convey("When she adds a billing profile ", func() {
+					Convey("Then her account should contain one billing profile", func() {
+
+					})
+				})
+			})
+		})
+	})
+}
+
+func TestCustomerAcceptanceScenarios_ForRemovingBillingProfiles(t *testing.T) {
+	Convey("Prepare test artifacts", t, func() {
+		aa := acceptanceTestArtifacts{
+			emailAddress: "karen@jackson.net",
+			givenName:    "Karen",
+			familyName:   "Jackson",
+		}
+
+		Convey("\nSCENARIO: A Customer removes a billing profile from her account", func() {
+			Convey(fmt.Sprintf("Given a Customer registered as [%s %s] with [%s]", aa.givenName, aa.familyName, aa.emailAddress), func() {
+				Convey("And given she added a billing profile", func() {
+					Convey("And given she added another billing profile", func() {
+						Convey("When she removes the first billing profile from her account", func() {
+							Convey("Then her account should contain only the second billing profile", func() {
+
+							})
+						})
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO: A Customer tries to remove the only billing profile from her account", func() {
+			Convey(fmt.Sprintf("Given a Customer registered as [%s %s] with [%s]", aa.givenName, aa.familyName, aa.emailAddress), func() {
+				Convey("And given she added a billing profile", func() {
+					Convey("When she tries to remove the billing profile from her account", func() {
+						Convey("Then she should receive an error", func() {
+
+						})
+					})
+				})
+			})
+		})
+	})
+}
+
+func TestCustomerAcceptanceScenarios_ForDeletingCustomers(t *testing.T) {
+	ac := bootstrapAcceptanceTestCollaborators()
+
+	Convey("Prepare test artifacts", t, func() {
+		var err error
+		var customerID value.CustomerID
+		var confirmationHash value.ConfirmationHash
+		var actualCustomerView customer.View
+
+		aa := acceptanceTestArtifacts{
+			emailAddress:    "karen@jackson.net",
+			givenName:       "Karen",
+			familyName:      "Jackson",
+			newEmailAddress: "fiona@silverman.net",
+			newGivenName:    "Karen",
+			newFamilyName:   "Silverman",
+		}
+
+		Convey("\nSCENARIO: A Customer deletes her account", func() {
+			Convey(fmt.Sprintf("Given a Customer registered as [%s %s] with [%s]", aa.givenName, aa.familyName, aa.emailAddress), func() {
+				customerID, confirmationHash = givenCustomerRegistered(aa)
+
+				Convey("When she deletes her account", func() {
+					err = ac.deleteCustomer(customerID.String(), 0)
+					So(err, ShouldBeNil)
+
+					Convey("And when she tries to retrieve her account data", func() {
+						actualCustomerView, err = ac.customerViewByID(customerID.String())
+
+						Convey("Then she should receive an error", func() {
+							So(err, ShouldBeError)
+							So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
+							So(actualCustomerView, ShouldBeZeroValue)
+						})
+					})
+
+					Convey("And when she tries to delete her account again", func() {
+						err = ac.deleteCustomer(customerID.String(), 0)
+						So(err, ShouldBeNil)
+
+						Convey("Then her account should still be deleted", func() {
+							actualCustomerView, err = ac.customerViewByID(customerID.String())
+							So(err, ShouldBeError)
+							So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
+							So(actualCustomerView, ShouldBeZeroValue)
+						})
+					})
+
+					Convey("And when she tries to confirm her email address", func() {
+						err = ac.confirmCustomerEmailAddress(customerID.String(), confirmationHash.String(), 0)
+
+						Convey("Then she should receive an error", func() {
+							So(err, ShouldBeError)
+							So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
+						})
+					})
+
+					Convey("And when she tries to change her email address", func() {
+						err = ac.changeCustomerEmailAddress(customerID.String(), aa.newEmailAddress, 0)
+
+						Convey("Then she should receive an error", func() {
+							So(err, ShouldBeError)
+							So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
+						})
+					})
+
+					Convey("And when she tries to change her name", func() {
+						err = ac.changeCustomerName(customerID.String(), aa.newGivenName, aa.newFamilyName, 0)
+
+						Convey("Then she should receive an error", func() {
+							So(err, ShouldBeError)
+							So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
+						})
+					})
+				})
+			})
+		})
+
+		Reset(func() {
+			err = atPurgeCustomerEventStream(customerID)
+			So(err, ShouldBeNil)
+		})
+	})
+}
+
+func TestCustomerAcceptanceScenarios_WhenCustomerWasNeverRegistered(t *testing.T) {
+	ac := bootstrapAcceptanceTestCollaborators()
+
+	Convey("Prepare test artifacts", t, func() {
+		var err error
+		var actualCustomerView customer.View
+
+		aa := acceptanceTestArtifacts{
+			newEmailAddress: "fiona@pratt.net",
+			newGivenName:    "Fiona",
+			newFamilyName:   "Pratt",
+		}
+
+		customerID := value.GenerateCustomerID()
+		confirmationHash := value.RebuildConfirmationHash(aa.newEmailAddress)
+
+		Convey("\nSCENARIO: A hacker tries to play around with a non existing Customer account by guessing IDs", func() {
+			Convey("When he tries to retrieve data for a non existing account", func() {
+				actualCustomerView, err = ac.customerViewByID(customerID.String())
+
+				Convey("Then he should receive an error", func() {
+					So(err, ShouldBeError)
+					So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
+					So(actualCustomerView, ShouldBeZeroValue)
+				})
+			})
+
+			Convey("And when he tries to confirm an email address", func() {
+				err = ac.confirmCustomerEmailAddress(customerID.String(), confirmationHash.String(), 0)
+
+				Convey("Then he should receive an error", func() {
+					So(err, ShouldBeError)
+					So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
+				})
+			})
+
+			Convey("And when he tries to change an email address", func() {
+				err = ac.changeCustomerEmailAddress(customerID.String(), aa.newEmailAddress, 0)
+
+				Convey("Then he should receive an error", func() {
+					So(err, ShouldBeError)
+					So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
+				})
+			})
+
+			Convey("And when he tries to change a name", func() {
+				err = ac.changeCustomerName(customerID.String(), aa.newGivenName, aa.newFamilyName, 0)
+
+				Convey("Then he should receive an error", func() {
+					So(err, ShouldBeError)
+					So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
+				})
+			})
+
+			Convey("And when he tries to delete an account", func() {
+				err = ac.deleteCustomer(customerID.String(), 0)
+
+				Convey("Then he should receive an error", func() {
+					So(err, ShouldBeError)
+					So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
+				})
+			})
+		})
+	})
+}
+
+func TestCustomerAcceptanceScenarios_InvalidClientInput(t *testing.T) {
+	ac := bootstrapAcceptanceTestCollaborators()
+
+	Convey("Prepare test artifacts", t, func() {
+		var err error
+		var customerID value.CustomerID
+		var confirmationHash value.ConfirmationHash
+
+		aa := acceptanceTestArtifacts{
+			emailAddress: "fiona@gallagher.net",
+			givenName:    "Fiona",
+			familyName:   "Gallagher",
+		}
+
+		Convey("\nSCENARIO: A client (web, app, ...) of the application sends an empty id", func() {
+			Convey(fmt.Sprintf("Given a Customer registered as [%s %s] with [%s]", aa.givenName, aa.familyName, aa.emailAddress), func() {
+				customerID, confirmationHash = givenCustomerRegistered(aa)
+
+				Convey("When she tries to confirm her email address with an empty id", func() {
+					err = ac.confirmCustomerEmailAddress("", confirmationHash.String(), 0)
+
+					Convey("Then she should receive an error", func() {
+						So(err, ShouldBeError)
+						So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+					})
+				})
+
+				Convey("When she tries to change her email address with an empty id", func() {
+					err = ac.changeCustomerEmailAddress("", aa.emailAddress, 0)
+
+					Convey("Then she should receive an error", func() {
+						So(err, ShouldBeError)
+						So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+					})
+				})
+
+				Convey("When she tries to change her name with an empty id", func() {
+					err = ac.changeCustomerName("", aa.givenName, aa.familyName, 0)
+
+					Convey("Then she should receive an error", func() {
+						So(err, ShouldBeError)
+						So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+					})
+				})
+
+				Convey("When she tries to delete her account with an empty id", func() {
+					err = ac.deleteCustomer("", 0)
+
+					Convey("Then she should receive an error", func() {
+						So(err, ShouldBeError)
+						So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+					})
+				})
+
+				Convey("When she tries to retrieve her account with an empty id", func() {
+					_, err = ac.customerViewByID("")
+
+					Convey("Then she should receive an error", func() {
+						So(err, ShouldBeError)
+						So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+					})
+				})
+			})
+		})
+
+		Reset(func() {
+			err = atPurgeCustomerEventStream(customerID)
+			So(err, ShouldBeNil)
+		})
+	})
+}
+
+func givenCustomerRegistered(
+	aa acceptanceTestArtifacts,
 ) (value.CustomerID, value.ConfirmationHash) {
 
 	customerID := value.GenerateCustomerID()
@@ -835,6 +1740,20 @@ func givenCustomerEmailAddressWasChanged(
 	return confirmationHash
 }
 
+func givenCustomAttributeWasDefined(
+	ac acceptanceTestCollaborators,
+	name string,
+	attributeType string,
+	validationPattern string,
+) {
+
+	err := ac.defineCustomAttribute(name, attributeType, validationPattern)
+
+	if err != nil {
+		So(errors.Is(err, shared.ErrDuplicate), ShouldBeTrue) // defined by a previous test run
+	}
+}
+
 func bootstrapAcceptanceTestCollaborators() acceptanceTestCollaborators {
 	logger := shared.NewNilLogger()
 	config := cmd.MustBuildConfigFromEnv(logger)
@@ -848,13 +1767,46 @@ func bootstrapAcceptanceTestCollaborators() acceptanceTestCollaborators {
 	atAppendToCustomerEventStream = eventStore.AppendToEventStream
 	atPurgeCustomerEventStream = eventStore.PurgeEventStream
 
+	accountRecoveryCommandHandler := application.NewAccountRecoveryCommandHandler(
+		eventStore.RetrieveCustomerIDByEmailAddress,
+		eventStore.RetrieveEventStream,
+		eventStore.AppendToEventStream,
+		func(emailAddress value.EmailAddress, recoveryToken string) error {
+			atSentAccountRecoveryTokens[emailAddress.String()] = recoveryToken
+			return nil
+		},
+		func(key string) error { return nil },
+		func(key string) error { return nil },
+		func(task func() error) { So(task(), ShouldBeNil) },
+	)
+
 	return acceptanceTestCollaborators{
 		registerCustomer:            diContainer.GetCustomerCommandHandler().RegisterCustomer,
 		confirmCustomerEmailAddress: diContainer.GetCustomerCommandHandler().ConfirmCustomerEmailAddress,
 		changeCustomerEmailAddress:  diContainer.GetCustomerCommandHandler().ChangeCustomerEmailAddress,
 		changeCustomerName:          diContainer.GetCustomerCommandHandler().ChangeCustomerName,
+		setCustomerPassword:         diContainer.GetCustomerCommandHandler().SetCustomerPassword,
+		changeCustomerPassword:      diContainer.GetCustomerCommandHandler().ChangeCustomerPassword,
+		verifyCustomerCredentials:   diContainer.GetCustomerQueryHandler().VerifyCredentials,
+		requestAccountRecovery:      accountRecoveryCommandHandler.RequestAccountRecovery,
+		completeAccountRecovery:     accountRecoveryCommandHandler.CompleteAccountRecovery,
+		startMFAEnrollment:          diContainer.GetCustomerCommandHandler().StartCustomerMFAEnrollment,
+		confirmMFAEnrollment:        diContainer.GetCustomerCommandHandler().ConfirmCustomerMFAEnrollment,
+		disableMFA:                  diContainer.GetCustomerCommandHandler().DisableCustomerMFA,
+		verifyMFACode:               diContainer.GetCustomerCommandHandler().VerifyCustomerMFACode,
+		setCustomerAttribute:        diContainer.GetCustomerCommandHandler().SetCustomerAttribute,
+		defineCustomAttribute:       diContainer.GetCustomAttributeDefinitionCommandHandler().DefineCustomAttribute,
 		deleteCustomer:              diContainer.GetCustomerCommandHandler().DeleteCustomer,
 		customerViewByID:            diContainer.GetCustomerQueryHandler().CustomerViewByID,
+		customerViewByEmailAddress:  diContainer.GetCustomerQueryHandler().CustomerViewByEmailAddress,
+		listCustomers:               diContainer.GetCustomerQueryHandler().ListCustomers,
+		searchCustomers:             diContainer.GetCustomerQueryHandler().SearchCustomers,
+		detectDuplicateCustomers:    diContainer.GetDuplicateCustomerHandler().DetectDuplicateCustomers,
+		listDuplicateCandidates:     diContainer.GetDuplicateCustomerHandler().ListDuplicateCandidates,
+		markAsNotDuplicate:          diContainer.GetDuplicateCustomerHandler().MarkAsNotDuplicate,
+		registrationFunnel:          diContainer.GetCustomerAnalyticsQueryHandler().RegistrationFunnel,
+		importCustomer:              diContainer.GetCustomerCommandHandler().ImportCustomer,
+		exportCustomerData:          diContainer.GetCustomerQueryHandler().ExportCustomerData,
 	}
 }
 
@@ -5,9 +5,12 @@ import (
 
 	"github.com/AntonStoeckl/go-iddd/service/cmd"
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/adapter/postgres"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/serialization"
 	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
 )
 
 type benchmarkTestArtifacts struct {
@@ -35,11 +38,11 @@ func BenchmarkCustomerCommand(b *testing.B) {
 	b.Run("ChangeName", func(b *testing.B) {
 		for n := 0; n < b.N; n++ {
 			if n%2 == 0 {
-				if err = commandHandler.ChangeCustomerName(ba.customerID.String(), ba.newGivenName, ba.newFamilyName); err != nil {
+				if err = commandHandler.ChangeCustomerName(ba.customerID.String(), ba.newGivenName, ba.newFamilyName, 0); err != nil {
 					b.FailNow()
 				}
 			} else {
-				if err = commandHandler.ChangeCustomerName(ba.customerID.String(), ba.givenName, ba.familyName); err != nil {
+				if err = commandHandler.ChangeCustomerName(ba.customerID.String(), ba.givenName, ba.familyName, 0); err != nil {
 					b.FailNow()
 				}
 			}
@@ -83,6 +86,172 @@ func BenchmarkCustomerQuery(b *testing.B) {
 	)
 }
 
+// BenchmarkCustomerEventStore appends the stream of 101 events which prepareForBenchmark creates,
+// once in two calls (so the 100 events after the registration are inserted with one statement)
+// and once with one call per event, like the commands do.
+func BenchmarkCustomerEventStore(b *testing.B) {
+	logger := shared.NewNilLogger()
+	config := cmd.MustBuildConfigFromEnv(logger)
+	diContainer, err := cmd.Bootstrap(config, logger)
+	if err != nil {
+		panic(err)
+	}
+
+	eventStore := diContainer.GetCustomerEventStore()
+
+	b.Run("AppendEventStream", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			b.StopTimer()
+			eventStream := buildEventStreamForBenchmark()
+			customerRegistered := eventStream[0].(domain.CustomerRegistered)
+			b.StartTimer()
+
+			if err = eventStore.StartEventStream(customerRegistered); err != nil {
+				b.FailNow()
+			}
+
+			if err = eventStore.AppendToEventStream(es.RecordedEvents(eventStream[1:]), customerRegistered.CustomerID()); err != nil {
+				b.FailNow()
+			}
+
+			b.StopTimer()
+			if err = eventStore.PurgeEventStream(customerRegistered.CustomerID()); err != nil {
+				b.FailNow()
+			}
+			b.StartTimer()
+		}
+	})
+
+	b.Run("AppendEventStreamOneCallPerEvent", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			b.StopTimer()
+			eventStream := buildEventStreamForBenchmark()
+			customerRegistered := eventStream[0].(domain.CustomerRegistered)
+			b.StartTimer()
+
+			if err = eventStore.StartEventStream(customerRegistered); err != nil {
+				b.FailNow()
+			}
+
+			for _, event := range eventStream[1:] {
+				if err = eventStore.AppendToEventStream(es.RecordedEvents{event}, customerRegistered.CustomerID()); err != nil {
+					b.FailNow()
+				}
+			}
+
+			b.StopTimer()
+			if err = eventStore.PurgeEventStream(customerRegistered.CustomerID()); err != nil {
+				b.FailNow()
+			}
+			b.StartTimer()
+		}
+	})
+}
+
+// BenchmarkCustomerEventPayloadCodecs compares the codecs for the payload column without a DB,
+// with the same stream of 101 events which prepareForBenchmark creates.
+func BenchmarkCustomerEventPayloadCodecs(b *testing.B) {
+	secretCipher, err := serialization.NewSecretCipherFromBase64("AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8=")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	jsonRegistry, err := serialization.NewCustomerEventRegistry(secretCipher)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	protobufRegistry, err := serialization.NewCustomerEventProtobufRegistry(secretCipher)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	codecs := []struct {
+		name     string
+		registry *es.EventRegistry
+	}{
+		{"JSON", jsonRegistry},
+		{"Protobuf", protobufRegistry},
+	}
+
+	eventStream := buildEventStreamForBenchmark()
+
+	for _, codec := range codecs {
+		registry := codec.registry
+		payloads := make([][]byte, len(eventStream))
+		storageSize := 0
+
+		for i, event := range eventStream {
+			if payloads[i], err = registry.Marshal(event); err != nil {
+				b.Fatal(err)
+			}
+
+			storageSize += len(payloads[i])
+		}
+
+		b.Run(codec.name+"/Marshal", func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				for _, event := range eventStream {
+					if _, err := registry.Marshal(event); err != nil {
+						b.FailNow()
+					}
+				}
+			}
+
+			b.ReportMetric(float64(storageSize)/float64(len(eventStream)), "bytes/event")
+		})
+
+		b.Run(codec.name+"/Replay", func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				for i, event := range eventStream {
+					if _, err := registry.Unmarshal(event.Meta().EventName(), payloads[i], event.Meta().StreamVersion()); err != nil {
+						b.FailNow()
+					}
+				}
+			}
+
+			b.ReportMetric(float64(storageSize)/float64(len(eventStream)), "bytes/event")
+		})
+	}
+}
+
+func buildEventStreamForBenchmark() es.EventStream {
+	ba := buildArtifactsForBenchmarkTest()
+	customerID := value.GenerateCustomerID()
+	emailAddress := value.RebuildEmailAddress(ba.emailAddress)
+	newEmailAddress := value.RebuildEmailAddress(ba.newEmailAddress)
+
+	eventStream := es.EventStream{
+		domain.BuildCustomerRegistered(
+			customerID,
+			emailAddress,
+			value.GenerateConfirmationHash(emailAddress.String()),
+			value.RebuildPersonName(ba.givenName, ba.familyName),
+			1,
+		),
+	}
+
+	for n := 0; n < 100; n++ {
+		previousEmailAddress, nextEmailAddress := emailAddress, newEmailAddress
+		if n%2 != 0 {
+			previousEmailAddress, nextEmailAddress = newEmailAddress, emailAddress
+		}
+
+		eventStream = append(
+			eventStream,
+			domain.BuildCustomerEmailAddressChanged(
+				customerID,
+				nextEmailAddress,
+				value.GenerateConfirmationHash(nextEmailAddress.String()),
+				previousEmailAddress,
+				uint(n+2),
+			),
+		)
+	}
+
+	return eventStream
+}
+
 func buildArtifactsForBenchmarkTest() benchmarkTestArtifacts {
 	var ba benchmarkTestArtifacts
 
@@ -110,11 +279,11 @@ func prepareForBenchmark(
 
 	for n := 0; n < 100; n++ {
 		if n%2 == 0 {
-			if err = commandHandler.ChangeCustomerEmailAddress(ba.customerID.String(), ba.newEmailAddress); err != nil {
+			if err = commandHandler.ChangeCustomerEmailAddress(ba.customerID.String(), ba.newEmailAddress, 0); err != nil {
 				b.FailNow()
 			}
 		} else {
-			if err = commandHandler.ChangeCustomerEmailAddress(ba.customerID.String(), ba.emailAddress); err != nil {
+			if err = commandHandler.ChangeCustomerEmailAddress(ba.customerID.String(), ba.emailAddress, 0); err != nil {
 				b.FailNow()
 			}
 		}
@@ -128,7 +297,7 @@ func cleanUpAfterBenchmark(
 	id value.CustomerID,
 ) {
 
-	if err := commandHandler.DeleteCustomer(id.String()); err != nil {
+	if err := commandHandler.DeleteCustomer(id.String(), 0); err != nil {
 		b.FailNow()
 	}
 
@@ -1,3 +1,3 @@
 package hexagon
 
-type ForChangingCustomerEmailAddresses func(customerID, emailAddress string) error
+type ForChangingCustomerEmailAddresses func(customerID, emailAddress string, expectedVersion uint) error
@@ -1,3 +1,3 @@
 package hexagon
 
-type ForChangingCustomerNames func(customerID, givenName, familyName string) error
+type ForChangingCustomerNames func(customerID, givenName, familyName string, expectedVersion uint) error
@@ -0,0 +1,3 @@
+package hexagon
+
+type ForChangingCustomerPasswords func(customerID, currentPassword, newPassword string, expectedVersion uint) error
@@ -0,0 +1,3 @@
+package hexagon
+
+type ForCompletingCustomerAccountRecoveries func(emailAddress, recoveryToken, newPassword, clientAddress string) error
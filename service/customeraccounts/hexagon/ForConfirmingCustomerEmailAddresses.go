@@ -1,3 +1,3 @@
 package hexagon
 
-type ForConfirmingCustomerEmailAddresses func(customerID, confirmationHash string) error
+type ForConfirmingCustomerEmailAddresses func(customerID, confirmationHash string, expectedVersion uint) error
@@ -0,0 +1,3 @@
+package hexagon
+
+type ForConfirmingCustomerMFAEnrollments func(customerID, code string, expectedVersion uint) error
@@ -0,0 +1,3 @@
+package hexagon
+
+type ForDefiningCustomAttributes func(name, attributeType, validationPattern string) error
@@ -1,3 +1,3 @@
 package hexagon
 
-type ForDeletingCustomers func(customerID string) error
+type ForDeletingCustomers func(customerID string, expectedVersion uint) error
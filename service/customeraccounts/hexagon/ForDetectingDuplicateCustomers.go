@@ -0,0 +1,3 @@
+package hexagon
+
+type ForDetectingDuplicateCustomers func() (uint, error)
@@ -0,0 +1,3 @@
+package hexagon
+
+type ForDisablingCustomerMFA func(customerID, code string, expectedVersion uint) error
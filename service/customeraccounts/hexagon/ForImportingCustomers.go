@@ -0,0 +1,7 @@
+package hexagon
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+)
+
+type ForImportingCustomers func(emailAddress, givenName, familyName string, isEmailAddressConfirmed bool) (value.CustomerID, error)
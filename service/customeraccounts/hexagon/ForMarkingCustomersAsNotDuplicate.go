@@ -0,0 +1,3 @@
+package hexagon
+
+type ForMarkingCustomersAsNotDuplicate func(customerID, otherCustomerID string) error
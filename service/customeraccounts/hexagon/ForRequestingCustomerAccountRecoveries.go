@@ -0,0 +1,3 @@
+package hexagon
+
+type ForRequestingCustomerAccountRecoveries func(emailAddress, clientAddress string) error
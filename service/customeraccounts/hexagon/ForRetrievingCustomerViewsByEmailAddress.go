@@ -0,0 +1,7 @@
+package hexagon
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+)
+
+type ForRetrievingCustomerViewsByEmailAddress func(emailAddress string) (customer.View, error)
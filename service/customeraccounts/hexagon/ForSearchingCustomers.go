@@ -0,0 +1,7 @@
+package hexagon
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+)
+
+type ForSearchingCustomers func(searchTerm string, maxResults uint) ([]customer.ListEntry, error)
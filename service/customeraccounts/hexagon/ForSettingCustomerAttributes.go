@@ -0,0 +1,3 @@
+package hexagon
+
+type ForSettingCustomerAttributes func(customerID, attributeName, attributeValue string, expectedVersion uint) error
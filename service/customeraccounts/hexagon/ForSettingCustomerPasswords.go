@@ -0,0 +1,3 @@
+package hexagon
+
+type ForSettingCustomerPasswords func(customerID, password string, expectedVersion uint) error
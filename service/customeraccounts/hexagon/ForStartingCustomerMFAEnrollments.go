@@ -0,0 +1,5 @@
+package hexagon
+
+import "github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+
+type ForStartingCustomerMFAEnrollments func(customerID string) (value.TOTPSecret, []string, error)
@@ -0,0 +1,5 @@
+package hexagon
+
+import "github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+
+type ForVerifyingCustomerCredentials func(emailAddress, password string) (value.CustomerID, error)
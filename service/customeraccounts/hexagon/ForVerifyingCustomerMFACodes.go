@@ -0,0 +1,3 @@
+package hexagon
+
+type ForVerifyingCustomerMFACodes func(customerID, code string) error
@@ -0,0 +1,204 @@
+package application
+
+import (
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+// AccountRecoveryCommandHandler never reveals whether a Customer exists for an email address:
+// RequestAccountRecovery succeeds silently for unknown email addresses and CompleteAccountRecovery
+// reports every failure as ErrInvalidCredentials.
+// Attempts are limited per email address and per client address, so that a client can't spray many email addresses.
+type AccountRecoveryCommandHandler struct {
+	retrieveCustomerIDByEmailAddress      ForRetrievingCustomerIDsByEmailAddress
+	retrieveCustomerEventStream           ForRetrievingCustomerEventStreams
+	appendToCustomerEventStream           ForAppendingToCustomerEventStreams
+	sendAccountRecoveryToken              ForSendingAccountRecoveryTokens
+	limitAccountRecoveryAttempts          ForLimitingAccountRecoveryAttempts
+	limitAccountRecoveryAttemptsPerClient ForLimitingAccountRecoveryAttempts
+	runInBackground                       ForRunningBackgroundTasks
+}
+
+func NewAccountRecoveryCommandHandler(
+	retrieveCustomerIDByEmailAddress ForRetrievingCustomerIDsByEmailAddress,
+	retrieveCustomerEventStream ForRetrievingCustomerEventStreams,
+	appendToCustomerEventStream ForAppendingToCustomerEventStreams,
+	sendAccountRecoveryToken ForSendingAccountRecoveryTokens,
+	limitAccountRecoveryAttempts ForLimitingAccountRecoveryAttempts,
+	limitAccountRecoveryAttemptsPerClient ForLimitingAccountRecoveryAttempts,
+	runInBackground ForRunningBackgroundTasks,
+) *AccountRecoveryCommandHandler {
+
+	return &AccountRecoveryCommandHandler{
+		retrieveCustomerIDByEmailAddress:      retrieveCustomerIDByEmailAddress,
+		retrieveCustomerEventStream:           retrieveCustomerEventStream,
+		appendToCustomerEventStream:           appendToCustomerEventStream,
+		sendAccountRecoveryToken:              sendAccountRecoveryToken,
+		limitAccountRecoveryAttempts:          limitAccountRecoveryAttempts,
+		limitAccountRecoveryAttemptsPerClient: limitAccountRecoveryAttemptsPerClient,
+		runInBackground:                       runInBackground,
+	}
+}
+
+// RequestAccountRecovery only validates and limits the request before it returns, the lookup of the Customer,
+// the recorded event and the email happen in the background for known and unknown email addresses alike,
+// so that the response time does not reveal whether a Customer exists.
+func (h *AccountRecoveryCommandHandler) RequestAccountRecovery(emailAddress, clientAddress string) error {
+	var err error
+	var emailAddressValue value.EmailAddress
+	wrapWithMsg := "accountRecoveryCommandHandler.RequestAccountRecovery"
+
+	if emailAddressValue, err = value.BuildEmailAddress(emailAddress); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	if err = h.limitAttempts("request", emailAddressValue, clientAddress); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	h.runInBackground(func() error {
+		if err := h.requestAccountRecovery(emailAddressValue); err != nil {
+			return errors.Wrap(err, wrapWithMsg)
+		}
+
+		return nil
+	})
+
+	return nil
+}
+
+func (h *AccountRecoveryCommandHandler) requestAccountRecovery(emailAddressValue value.EmailAddress) error {
+	customerIDValue, err := h.retrieveCustomerIDByEmailAddress(emailAddressValue)
+	if err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	plainToken, recoveryToken, err := value.GenerateAccountRecoveryToken(time.Now())
+	if err != nil {
+		return err
+	}
+
+	command := domain.BuildRequestCustomerAccountRecovery(customerIDValue, recoveryToken)
+
+	doRequestAccountRecovery := func() error {
+		eventStream, err := h.retrieveCustomerEventStream(command.CustomerID())
+		if err != nil {
+			return err
+		}
+
+		recordedEvents, err := customer.RequestAccountRecovery(eventStream, command)
+		if err != nil {
+			return err
+		}
+
+		if err := h.appendToCustomerEventStream(recordedEvents, command.CustomerID()); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	if err = shared.RetryOnConcurrencyConflict(doRequestAccountRecovery, maxCustomerCommandHandlerRetries); err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	return h.sendAccountRecoveryToken(emailAddressValue, plainToken)
+}
+
+func (h *AccountRecoveryCommandHandler) CompleteAccountRecovery(
+	emailAddress string,
+	recoveryToken string,
+	newPassword string,
+	clientAddress string,
+) error {
+
+	var err error
+	var emailAddressValue value.EmailAddress
+	var customerIDValue value.CustomerID
+	var plainPasswordValue value.PlainPassword
+	var passwordHashValue value.PasswordHash
+	wrapWithMsg := "accountRecoveryCommandHandler.CompleteAccountRecovery"
+
+	if emailAddressValue, err = value.BuildEmailAddress(emailAddress); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	if err = h.limitAttempts("complete", emailAddressValue, clientAddress); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	if plainPasswordValue, err = value.BuildPlainPassword(newPassword); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	// the password is hashed before the lookup, so that unknown email addresses take roughly the same time
+	if passwordHashValue, err = value.GeneratePasswordHash(plainPasswordValue); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	if customerIDValue, err = h.retrieveCustomerIDByEmailAddress(emailAddressValue); err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			err = errors.New("invalid or expired recovery token supplied")
+			return shared.MarkAndWrapError(err, shared.ErrInvalidCredentials, wrapWithMsg)
+		}
+
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	command := domain.BuildCompleteCustomerAccountRecovery(
+		customerIDValue,
+		recoveryToken,
+		passwordHashValue,
+		time.Now(),
+	)
+
+	doCompleteAccountRecovery := func() error {
+		eventStream, err := h.retrieveCustomerEventStream(command.CustomerID())
+		if err != nil {
+			return err
+		}
+
+		recordedEvents, err := customer.CompleteAccountRecovery(eventStream, command)
+		if err != nil {
+			return err
+		}
+
+		if err := h.appendToCustomerEventStream(recordedEvents, command.CustomerID()); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	if err = shared.RetryOnConcurrencyConflict(doCompleteAccountRecovery, maxCustomerCommandHandlerRetries); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	return nil
+}
+
+func (h *AccountRecoveryCommandHandler) limitAttempts(
+	action string,
+	emailAddressValue value.EmailAddress,
+	clientAddress string,
+) error {
+
+	if err := h.limitAccountRecoveryAttemptsPerClient(action + ":client:" + clientAddress); err != nil {
+		return err
+	}
+
+	return h.limitAccountRecoveryAttempts(action + ":" + emailAddressValue.String())
+}
@@ -0,0 +1,39 @@
+package application
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/cockroachdb/errors"
+)
+
+type CustomAttributeDefinitionCommandHandler struct {
+	storeCustomAttributeDefinition ForStoringCustomAttributeDefinitions
+}
+
+func NewCustomAttributeDefinitionCommandHandler(
+	storeCustomAttributeDefinition ForStoringCustomAttributeDefinitions,
+) *CustomAttributeDefinitionCommandHandler {
+
+	return &CustomAttributeDefinitionCommandHandler{
+		storeCustomAttributeDefinition: storeCustomAttributeDefinition,
+	}
+}
+
+func (h *CustomAttributeDefinitionCommandHandler) DefineCustomAttribute(
+	name string,
+	attributeType string,
+	validationPattern string,
+) error {
+
+	wrapWithMsg := "customAttributeDefinitionCommandHandler.DefineCustomAttribute"
+
+	definition, err := value.BuildCustomAttributeDefinition(name, attributeType, validationPattern)
+	if err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	if err = h.storeCustomAttributeDefinition(definition); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	return nil
+}
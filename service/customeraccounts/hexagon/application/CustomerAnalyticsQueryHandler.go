@@ -0,0 +1,33 @@
+package application
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/cockroachdb/errors"
+)
+
+type CustomerAnalyticsQueryHandler struct {
+	retrieveFunnelPeriods ForRetrievingCustomerFunnelPeriods
+}
+
+func NewCustomerAnalyticsQueryHandler(retrieveFunnelPeriods ForRetrievingCustomerFunnelPeriods) *CustomerAnalyticsQueryHandler {
+	return &CustomerAnalyticsQueryHandler{
+		retrieveFunnelPeriods: retrieveFunnelPeriods,
+	}
+}
+
+// RegistrationFunnel returns one FunnelPeriod per day or week, including the periods without any events.
+func (h *CustomerAnalyticsQueryHandler) RegistrationFunnel(criteria customer.FunnelCriteria) ([]customer.FunnelPeriod, error) {
+	wrapWithMsg := "customerAnalyticsQueryHandler.RegistrationFunnel"
+
+	validCriteria, err := customer.BuildFunnelCriteria(criteria)
+	if err != nil {
+		return nil, errors.Wrap(err, wrapWithMsg)
+	}
+
+	periods, err := h.retrieveFunnelPeriods(validCriteria)
+	if err != nil {
+		return nil, errors.Wrap(err, wrapWithMsg)
+	}
+
+	return periods, nil
+}
@@ -1,31 +1,40 @@
 package application
 
 import (
+	"time"
+
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
 	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
 	"github.com/cockroachdb/errors"
 )
 
 const maxCustomerCommandHandlerRetries = uint8(10)
 
 type CustomerCommandHandler struct {
-	retrieveCustomerEventStream ForRetrievingCustomerEventStreams
-	startCustomerEventStream    ForStartingCustomerEventStreams
-	appendToCustomerEventStream ForAppendingToCustomerEventStreams
+	retrieveCustomerEventStream       ForRetrievingCustomerEventStreams
+	startCustomerEventStream          ForStartingCustomerEventStreams
+	appendToCustomerEventStream       ForAppendingToCustomerEventStreams
+	retrieveCustomAttributeDefinition ForRetrievingCustomAttributeDefinitions
+	limitMFACodeAttempts              ForLimitingMFACodeAttempts
 }
 
 func NewCustomerCommandHandler(
 	retrieveCustomerEventStream ForRetrievingCustomerEventStreams,
 	startCustomerEventStream ForStartingCustomerEventStreams,
 	appendToCustomerEventStream ForAppendingToCustomerEventStreams,
+	retrieveCustomAttributeDefinition ForRetrievingCustomAttributeDefinitions,
+	limitMFACodeAttempts ForLimitingMFACodeAttempts,
 ) *CustomerCommandHandler {
 
 	return &CustomerCommandHandler{
-		retrieveCustomerEventStream: retrieveCustomerEventStream,
-		startCustomerEventStream:    startCustomerEventStream,
-		appendToCustomerEventStream: appendToCustomerEventStream,
+		retrieveCustomerEventStream:       retrieveCustomerEventStream,
+		startCustomerEventStream:          startCustomerEventStream,
+		appendToCustomerEventStream:       appendToCustomerEventStream,
+		retrieveCustomAttributeDefinition: retrieveCustomAttributeDefinition,
+		limitMFACodeAttempts:              limitMFACodeAttempts,
 	}
 }
 
@@ -35,18 +44,49 @@ func (h *CustomerCommandHandler) RegisterCustomer(
 	familyName string,
 ) (value.CustomerID, error) {
 
+	command, err := h.register(emailAddress, givenName, familyName, false)
+	if err != nil {
+		return value.CustomerID{}, errors.Wrap(err, "customerCommandHandler.RegisterCustomer")
+	}
+
+	return command.CustomerID(), nil
+}
+
+// ImportCustomer registers a Customer migrated from another system, whose email address can already be confirmed there.
+// The registration and the confirmation are stored together, so an import which failed can simply be repeated.
+func (h *CustomerCommandHandler) ImportCustomer(
+	emailAddress string,
+	givenName string,
+	familyName string,
+	isEmailAddressConfirmed bool,
+) (value.CustomerID, error) {
+
+	command, err := h.register(emailAddress, givenName, familyName, isEmailAddressConfirmed)
+	if err != nil {
+		return value.CustomerID{}, errors.Wrap(err, "customerCommandHandler.ImportCustomer")
+	}
+
+	return command.CustomerID(), nil
+}
+
+func (h *CustomerCommandHandler) register(
+	emailAddress string,
+	givenName string,
+	familyName string,
+	isEmailAddressConfirmed bool,
+) (domain.RegisterCustomer, error) {
+
 	var err error
 	var command domain.RegisterCustomer
-	wrapWithMsg := "customerCommandHandler.RegisterCustomer"
 
 	emailAddressValue, err := value.BuildEmailAddress(emailAddress)
 	if err != nil {
-		return value.CustomerID{}, errors.Wrap(err, wrapWithMsg)
+		return domain.RegisterCustomer{}, err
 	}
 
 	personNameValue, err := value.BuildPersonName(givenName, familyName)
 	if err != nil {
-		return value.CustomerID{}, errors.Wrap(err, wrapWithMsg)
+		return domain.RegisterCustomer{}, err
 	}
 
 	command = domain.BuildRegisterCustomer(
@@ -57,9 +97,18 @@ func (h *CustomerCommandHandler) RegisterCustomer(
 	)
 
 	doRegister := func() error {
+		var furtherEvents es.RecordedEvents
 		customerRegistered := customer.Register(command)
 
-		if err = h.startCustomerEventStream(customerRegistered); err != nil {
+		if isEmailAddressConfirmed {
+			confirmCommand := domain.BuildConfirmCustomerEmailAddress(command.CustomerID(), command.ConfirmationHash())
+
+			if furtherEvents, err = customer.ConfirmEmailAddress(es.EventStream{customerRegistered}, confirmCommand); err != nil {
+				return err
+			}
+		}
+
+		if err = h.startCustomerEventStream(customerRegistered, furtherEvents...); err != nil {
 			return err
 		}
 
@@ -67,15 +116,16 @@ func (h *CustomerCommandHandler) RegisterCustomer(
 	}
 
 	if err = shared.RetryOnConcurrencyConflict(doRegister, maxCustomerCommandHandlerRetries); err != nil {
-		return value.CustomerID{}, errors.Wrap(err, wrapWithMsg)
+		return domain.RegisterCustomer{}, err
 	}
 
-	return command.CustomerID(), nil
+	return command, nil
 }
 
 func (h *CustomerCommandHandler) ConfirmCustomerEmailAddress(
 	customerID string,
 	confirmationHash string,
+	expectedVersion uint,
 ) error {
 
 	var err error
@@ -100,6 +150,10 @@ func (h *CustomerCommandHandler) ConfirmCustomerEmailAddress(
 			return err
 		}
 
+		if err := assertExpectedVersion(eventStream, expectedVersion); err != nil {
+			return err
+		}
+
 		recordedEvents, err := customer.ConfirmEmailAddress(eventStream, command)
 		if err != nil {
 			return err
@@ -118,7 +172,7 @@ func (h *CustomerCommandHandler) ConfirmCustomerEmailAddress(
 		return nil
 	}
 
-	if err := shared.RetryOnConcurrencyConflict(doConfirmEmailAddress, maxCustomerCommandHandlerRetries); err != nil {
+	if err := retryUnlessConditional(doConfirmEmailAddress, expectedVersion); err != nil {
 		return errors.Wrap(err, wrapWithMsg)
 	}
 
@@ -128,6 +182,7 @@ func (h *CustomerCommandHandler) ConfirmCustomerEmailAddress(
 func (h *CustomerCommandHandler) ChangeCustomerEmailAddress(
 	customerID string,
 	emailAddress string,
+	expectedVersion uint,
 ) error {
 
 	var err error
@@ -152,6 +207,10 @@ func (h *CustomerCommandHandler) ChangeCustomerEmailAddress(
 			return err
 		}
 
+		if err := assertExpectedVersion(eventStream, expectedVersion); err != nil {
+			return err
+		}
+
 		recordedEvents, err := customer.ChangeEmailAddress(eventStream, command)
 		if err != nil {
 			return err
@@ -164,7 +223,7 @@ func (h *CustomerCommandHandler) ChangeCustomerEmailAddress(
 		return nil
 	}
 
-	if err := shared.RetryOnConcurrencyConflict(doChangeEmailAddress, maxCustomerCommandHandlerRetries); err != nil {
+	if err := retryUnlessConditional(doChangeEmailAddress, expectedVersion); err != nil {
 		return errors.Wrap(err, wrapWithMsg)
 	}
 
@@ -175,6 +234,7 @@ func (h *CustomerCommandHandler) ChangeCustomerName(
 	customerID string,
 	givenName string,
 	familyName string,
+	expectedVersion uint,
 ) error {
 
 	var err error
@@ -199,6 +259,10 @@ func (h *CustomerCommandHandler) ChangeCustomerName(
 			return err
 		}
 
+		if err := assertExpectedVersion(eventStream, expectedVersion); err != nil {
+			return err
+		}
+
 		recordedEvents, err := customer.ChangeName(eventStream, command)
 		if err != nil {
 			return err
@@ -211,14 +275,363 @@ func (h *CustomerCommandHandler) ChangeCustomerName(
 		return nil
 	}
 
-	if err := shared.RetryOnConcurrencyConflict(doChangeName, maxCustomerCommandHandlerRetries); err != nil {
+	if err := retryUnlessConditional(doChangeName, expectedVersion); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	return nil
+}
+
+func (h *CustomerCommandHandler) StartCustomerMFAEnrollment(customerID string) (value.TOTPSecret, []string, error) {
+	var err error
+	var command domain.StartCustomerMFAEnrollment
+	wrapWithMsg := "customerCommandHandler.StartCustomerMFAEnrollment"
+
+	customerIDValue, err := value.BuildCustomerID(customerID)
+	if err != nil {
+		return value.TOTPSecret{}, nil, errors.Wrap(err, wrapWithMsg)
+	}
+
+	totpSecretValue, err := value.GenerateTOTPSecret()
+	if err != nil {
+		return value.TOTPSecret{}, nil, errors.Wrap(err, wrapWithMsg)
+	}
+
+	plainRecoveryCodes, recoveryCodesValue, err := value.GenerateRecoveryCodes()
+	if err != nil {
+		return value.TOTPSecret{}, nil, errors.Wrap(err, wrapWithMsg)
+	}
+
+	command = domain.BuildStartCustomerMFAEnrollment(customerIDValue, totpSecretValue, recoveryCodesValue)
+
+	doStartMFAEnrollment := func() error {
+		eventStream, err := h.retrieveCustomerEventStream(command.CustomerID())
+		if err != nil {
+			return err
+		}
+
+		recordedEvents, err := customer.StartMFAEnrollment(eventStream, command)
+		if err != nil {
+			return err
+		}
+
+		if err := h.appendToCustomerEventStream(recordedEvents, command.CustomerID()); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	if err := shared.RetryOnConcurrencyConflict(doStartMFAEnrollment, maxCustomerCommandHandlerRetries); err != nil {
+		return value.TOTPSecret{}, nil, errors.Wrap(err, wrapWithMsg)
+	}
+
+	return command.TOTPSecret(), plainRecoveryCodes, nil
+}
+
+func (h *CustomerCommandHandler) ConfirmCustomerMFAEnrollment(customerID string, code string, expectedVersion uint) error {
+	var err error
+	var command domain.ConfirmCustomerMFAEnrollment
+	wrapWithMsg := "customerCommandHandler.ConfirmCustomerMFAEnrollment"
+
+	customerIDValue, err := value.BuildCustomerID(customerID)
+	if err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	// all commands which check MFA codes share the limit, so that codes can't be guessed by alternating them
+	if err = h.limitMFACodeAttempts("mfa:" + customerIDValue.String()); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	command = domain.BuildConfirmCustomerMFAEnrollment(customerIDValue, code, time.Now())
+
+	doConfirmMFAEnrollment := func() error {
+		eventStream, err := h.retrieveCustomerEventStream(command.CustomerID())
+		if err != nil {
+			return err
+		}
+
+		if err := assertExpectedVersion(eventStream, expectedVersion); err != nil {
+			return err
+		}
+
+		recordedEvents, err := customer.ConfirmMFAEnrollment(eventStream, command)
+		if err != nil {
+			return err
+		}
+
+		if err := h.appendToCustomerEventStream(recordedEvents, command.CustomerID()); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	if err := retryUnlessConditional(doConfirmMFAEnrollment, expectedVersion); err != nil {
 		return errors.Wrap(err, wrapWithMsg)
 	}
 
 	return nil
 }
 
-func (h *CustomerCommandHandler) DeleteCustomer(customerID string) error {
+func (h *CustomerCommandHandler) DisableCustomerMFA(customerID string, code string, expectedVersion uint) error {
+	var err error
+	var command domain.DisableCustomerMFA
+	wrapWithMsg := "customerCommandHandler.DisableCustomerMFA"
+
+	customerIDValue, err := value.BuildCustomerID(customerID)
+	if err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	if err = h.limitMFACodeAttempts("mfa:" + customerIDValue.String()); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	command = domain.BuildDisableCustomerMFA(customerIDValue, code, time.Now())
+
+	doDisableMFA := func() error {
+		eventStream, err := h.retrieveCustomerEventStream(command.CustomerID())
+		if err != nil {
+			return err
+		}
+
+		if err := assertExpectedVersion(eventStream, expectedVersion); err != nil {
+			return err
+		}
+
+		recordedEvents, err := customer.DisableMFA(eventStream, command)
+		if err != nil {
+			return err
+		}
+
+		if err := h.appendToCustomerEventStream(recordedEvents, command.CustomerID()); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	if err := retryUnlessConditional(doDisableMFA, expectedVersion); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	return nil
+}
+
+func (h *CustomerCommandHandler) VerifyCustomerMFACode(customerID string, code string) error {
+	var err error
+	var command domain.VerifyCustomerMFACode
+	wrapWithMsg := "customerCommandHandler.VerifyCustomerMFACode"
+
+	customerIDValue, err := value.BuildCustomerID(customerID)
+	if err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	if err = h.limitMFACodeAttempts("mfa:" + customerIDValue.String()); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	command = domain.BuildVerifyCustomerMFACode(customerIDValue, code, time.Now())
+
+	doVerifyMFACode := func() error {
+		eventStream, err := h.retrieveCustomerEventStream(command.CustomerID())
+		if err != nil {
+			return err
+		}
+
+		recordedEvents, err := customer.VerifyMFACode(eventStream, command)
+		if err != nil {
+			return err
+		}
+
+		if err := h.appendToCustomerEventStream(recordedEvents, command.CustomerID()); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	if err := shared.RetryOnConcurrencyConflict(doVerifyMFACode, maxCustomerCommandHandlerRetries); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	return nil
+}
+
+func (h *CustomerCommandHandler) SetCustomerAttribute(
+	customerID string,
+	attributeName string,
+	attributeValue string,
+	expectedVersion uint,
+) error {
+
+	var err error
+	var command domain.SetCustomerAttribute
+	wrapWithMsg := "customerCommandHandler.SetCustomerAttribute"
+
+	customerIDValue, err := value.BuildCustomerID(customerID)
+	if err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	definition, err := h.retrieveCustomAttributeDefinition(attributeName)
+	if err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			err = errors.Newf("custom attribute [%s] is not defined", attributeName)
+			return shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+		}
+
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	attribute, err := definition.BuildAttribute(attributeValue)
+	if err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	command = domain.BuildSetCustomerAttribute(customerIDValue, attribute)
+
+	doSetAttribute := func() error {
+		eventStream, err := h.retrieveCustomerEventStream(command.CustomerID())
+		if err != nil {
+			return err
+		}
+
+		if err := assertExpectedVersion(eventStream, expectedVersion); err != nil {
+			return err
+		}
+
+		recordedEvents, err := customer.SetAttribute(eventStream, command)
+		if err != nil {
+			return err
+		}
+
+		if err := h.appendToCustomerEventStream(recordedEvents, command.CustomerID()); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	if err := retryUnlessConditional(doSetAttribute, expectedVersion); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	return nil
+}
+
+func (h *CustomerCommandHandler) SetCustomerPassword(customerID string, password string, expectedVersion uint) error {
+	var err error
+	var command domain.SetCustomerPassword
+	wrapWithMsg := "customerCommandHandler.SetCustomerPassword"
+
+	customerIDValue, err := value.BuildCustomerID(customerID)
+	if err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	plainPasswordValue, err := value.BuildPlainPassword(password)
+	if err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	passwordHashValue, err := value.GeneratePasswordHash(plainPasswordValue)
+	if err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	command = domain.BuildSetCustomerPassword(customerIDValue, passwordHashValue)
+
+	doSetPassword := func() error {
+		eventStream, err := h.retrieveCustomerEventStream(command.CustomerID())
+		if err != nil {
+			return err
+		}
+
+		if err := assertExpectedVersion(eventStream, expectedVersion); err != nil {
+			return err
+		}
+
+		recordedEvents, err := customer.SetPassword(eventStream, command)
+		if err != nil {
+			return err
+		}
+
+		if err := h.appendToCustomerEventStream(recordedEvents, command.CustomerID()); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	if err := retryUnlessConditional(doSetPassword, expectedVersion); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	return nil
+}
+
+func (h *CustomerCommandHandler) ChangeCustomerPassword(
+	customerID string,
+	currentPassword string,
+	newPassword string,
+	expectedVersion uint,
+) error {
+
+	var err error
+	var command domain.ChangeCustomerPassword
+	wrapWithMsg := "customerCommandHandler.ChangeCustomerPassword"
+
+	customerIDValue, err := value.BuildCustomerID(customerID)
+	if err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	plainPasswordValue, err := value.BuildPlainPassword(newPassword)
+	if err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	passwordHashValue, err := value.GeneratePasswordHash(plainPasswordValue)
+	if err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	command = domain.BuildChangeCustomerPassword(customerIDValue, currentPassword, passwordHashValue)
+
+	doChangePassword := func() error {
+		eventStream, err := h.retrieveCustomerEventStream(command.CustomerID())
+		if err != nil {
+			return err
+		}
+
+		if err := assertExpectedVersion(eventStream, expectedVersion); err != nil {
+			return err
+		}
+
+		recordedEvents, err := customer.ChangePassword(eventStream, command)
+		if err != nil {
+			return err
+		}
+
+		if err := h.appendToCustomerEventStream(recordedEvents, command.CustomerID()); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	if err := retryUnlessConditional(doChangePassword, expectedVersion); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	return nil
+}
+
+func (h *CustomerCommandHandler) DeleteCustomer(customerID string, expectedVersion uint) error {
 	var err error
 	var command domain.DeleteCustomer
 	wrapWithMsg := "customerCommandHandler.DeleteCustomer"
@@ -236,6 +649,10 @@ func (h *CustomerCommandHandler) DeleteCustomer(customerID string) error {
 			return err
 		}
 
+		if err := assertExpectedVersion(eventStream, expectedVersion); err != nil {
+			return err
+		}
+
 		recordedEvents := customer.Delete(eventStream, command)
 
 		if err := h.appendToCustomerEventStream(recordedEvents, command.CustomerID()); err != nil {
@@ -245,9 +662,38 @@ func (h *CustomerCommandHandler) DeleteCustomer(customerID string) error {
 		return nil
 	}
 
-	if err := shared.RetryOnConcurrencyConflict(doDelete, maxCustomerCommandHandlerRetries); err != nil {
+	if err := retryUnlessConditional(doDelete, expectedVersion); err != nil {
 		return errors.Wrap(err, wrapWithMsg)
 	}
 
 	return nil
 }
+
+// assertExpectedVersion makes a command conditional on the version of the Customer which the client has read last,
+// an expectedVersion of 0 means that the command is not conditional.
+func assertExpectedVersion(eventStream es.EventStream, expectedVersion uint) error {
+	var actualVersion uint
+
+	if expectedVersion == 0 {
+		return nil
+	}
+
+	if len(eventStream) > 0 {
+		actualVersion = eventStream[len(eventStream)-1].Meta().StreamVersion()
+	}
+
+	if actualVersion != expectedVersion {
+		return es.NewWrongExpectedVersionError(es.ExactVersion(expectedVersion), actualVersion)
+	}
+
+	return nil
+}
+
+// retryUnlessConditional does not retry conditional commands, because they would fail again with the same conflict.
+func retryUnlessConditional(originalFunc func() error, expectedVersion uint) error {
+	if expectedVersion != 0 {
+		return originalFunc()
+	}
+
+	return shared.RetryOnConcurrencyConflict(originalFunc, maxCustomerCommandHandlerRetries)
+}
@@ -1,6 +1,8 @@
 package application
 
 import (
+	"time"
+
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
 	"github.com/AntonStoeckl/go-iddd/service/shared"
@@ -8,12 +10,24 @@ import (
 )
 
 type CustomerQueryHandler struct {
-	retrieveCustomerEventStream ForRetrievingCustomerEventStreams
+	retrieveCustomerEventStream      ForRetrievingCustomerEventStreams
+	retrieveCustomerIDByEmailAddress ForRetrievingCustomerIDsByEmailAddress
+	retrieveCustomerListPage         ForRetrievingCustomerListPages
+	searchCustomerListEntries        ForSearchingCustomerListEntries
 }
 
-func NewCustomerQueryHandler(retrieveCustomerEventStream ForRetrievingCustomerEventStreams) *CustomerQueryHandler {
+func NewCustomerQueryHandler(
+	retrieveCustomerEventStream ForRetrievingCustomerEventStreams,
+	retrieveCustomerIDByEmailAddress ForRetrievingCustomerIDsByEmailAddress,
+	retrieveCustomerListPage ForRetrievingCustomerListPages,
+	searchCustomerListEntries ForSearchingCustomerListEntries,
+) *CustomerQueryHandler {
+
 	return &CustomerQueryHandler{
-		retrieveCustomerEventStream: retrieveCustomerEventStream,
+		retrieveCustomerEventStream:      retrieveCustomerEventStream,
+		retrieveCustomerIDByEmailAddress: retrieveCustomerIDByEmailAddress,
+		retrieveCustomerListPage:         retrieveCustomerListPage,
+		searchCustomerListEntries:        searchCustomerListEntries,
 	}
 }
 
@@ -26,17 +40,145 @@ func (h *CustomerQueryHandler) CustomerViewByID(customerID string) (customer.Vie
 		return customer.View{}, errors.Wrap(err, wrapWithMsg)
 	}
 
-	eventStream, err := h.retrieveCustomerEventStream(customerIDValue)
+	customerView, err := h.customerViewFor(customerIDValue)
+	if err != nil {
+		return customer.View{}, errors.Wrap(err, wrapWithMsg)
+	}
+
+	return customerView, nil
+}
+
+// CustomerViewByEmailAddress expects the email address in the same format as it was supplied at registration.
+func (h *CustomerQueryHandler) CustomerViewByEmailAddress(emailAddress string) (customer.View, error) {
+	var err error
+	var emailAddressValue value.EmailAddress
+	var customerIDValue value.CustomerID
+	wrapWithMsg := "customerQueryHandler.CustomerViewByEmailAddress"
+
+	if emailAddressValue, err = value.BuildEmailAddress(emailAddress); err != nil {
+		return customer.View{}, errors.Wrap(err, wrapWithMsg)
+	}
+
+	if customerIDValue, err = h.retrieveCustomerIDByEmailAddress(emailAddressValue); err != nil {
+		return customer.View{}, errors.Wrap(err, wrapWithMsg)
+	}
+
+	customerView, err := h.customerViewFor(customerIDValue)
 	if err != nil {
 		return customer.View{}, errors.Wrap(err, wrapWithMsg)
 	}
 
+	return customerView, nil
+}
+
+func (h *CustomerQueryHandler) ListCustomers(criteria customer.ListCriteria) (customer.ListPage, error) {
+	wrapWithMsg := "customerQueryHandler.ListCustomers"
+
+	validCriteria, err := customer.BuildListCriteria(criteria)
+	if err != nil {
+		return customer.ListPage{}, errors.Wrap(err, wrapWithMsg)
+	}
+
+	listPage, err := h.retrieveCustomerListPage(validCriteria)
+	if err != nil {
+		return customer.ListPage{}, errors.Wrap(err, wrapWithMsg)
+	}
+
+	return listPage, nil
+}
+
+// ExportCustomerData collects the data of a Customer for data portability requests, deleted Customers are not found.
+func (h *CustomerQueryHandler) ExportCustomerData(customerID string) (customer.DataExport, error) {
+	var err error
+	var customerIDValue value.CustomerID
+	wrapWithMsg := "customerQueryHandler.ExportCustomerData"
+
+	if customerIDValue, err = value.BuildCustomerID(customerID); err != nil {
+		return customer.DataExport{}, errors.Wrap(err, wrapWithMsg)
+	}
+
+	eventStream, err := h.retrieveCustomerEventStream(customerIDValue)
+	if err != nil {
+		return customer.DataExport{}, errors.Wrap(err, wrapWithMsg)
+	}
+
 	customerView := customer.BuildViewFrom(eventStream)
 
 	if customerView.IsDeleted {
-		err := errors.New("customer not found")
+		err = errors.New("customer not found")
+		return customer.DataExport{}, shared.MarkAndWrapError(err, shared.ErrNotFound, wrapWithMsg)
+	}
+
+	dataExport := customer.DataExport{
+		View:        customerView,
+		EventStream: eventStream,
+		ExportedAt:  time.Now(),
+	}
+
+	return dataExport, nil
+}
+
+// SearchCustomers returns the best matches first, deleted Customers are never found.
+func (h *CustomerQueryHandler) SearchCustomers(searchTerm string, maxResults uint) ([]customer.ListEntry, error) {
+	wrapWithMsg := "customerQueryHandler.SearchCustomers"
+
+	criteria, err := customer.BuildSearchCriteria(searchTerm, maxResults)
+	if err != nil {
+		return nil, errors.Wrap(err, wrapWithMsg)
+	}
+
+	entries, err := h.searchCustomerListEntries(criteria)
+	if err != nil {
+		return nil, errors.Wrap(err, wrapWithMsg)
+	}
+
+	return entries, nil
+}
+
+func (h *CustomerQueryHandler) VerifyCredentials(emailAddress string, password string) (value.CustomerID, error) {
+	var err error
+	var emailAddressValue value.EmailAddress
+	wrapWithMsg := "customerQueryHandler.VerifyCredentials"
+
+	if emailAddressValue, err = value.BuildEmailAddress(emailAddress); err != nil {
+		return value.CustomerID{}, errors.Wrap(err, wrapWithMsg)
+	}
 
-		return customer.View{}, shared.MarkAndWrapError(err, shared.ErrNotFound, wrapWithMsg)
+	customerIDValue, err := h.retrieveCustomerIDByEmailAddress(emailAddressValue)
+	if err != nil {
+		if errors.Is(err, shared.ErrNotFound) {
+			// an empty hash takes as long as a real one, so that unknown email addresses can't be told apart
+			value.RebuildPasswordHash("").Matches(password)
+
+			err = errors.New("invalid credentials supplied")
+			return value.CustomerID{}, shared.MarkAndWrapError(err, shared.ErrInvalidCredentials, wrapWithMsg)
+		}
+
+		return value.CustomerID{}, errors.Wrap(err, wrapWithMsg)
+	}
+
+	eventStream, err := h.retrieveCustomerEventStream(customerIDValue)
+	if err != nil {
+		return value.CustomerID{}, errors.Wrap(err, wrapWithMsg)
+	}
+
+	if err = customer.VerifyPassword(eventStream, password); err != nil {
+		return value.CustomerID{}, errors.Wrap(err, wrapWithMsg)
+	}
+
+	return customerIDValue, nil
+}
+
+func (h *CustomerQueryHandler) customerViewFor(customerID value.CustomerID) (customer.View, error) {
+	eventStream, err := h.retrieveCustomerEventStream(customerID)
+	if err != nil {
+		return customer.View{}, err
+	}
+
+	customerView := customer.BuildViewFrom(eventStream)
+
+	if customerView.IsDeleted {
+		return customer.View{}, errors.Mark(errors.New("customer not found"), shared.ErrNotFound)
 	}
 
 	return customerView, nil
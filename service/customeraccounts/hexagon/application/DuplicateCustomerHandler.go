@@ -0,0 +1,72 @@
+package application
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+type DuplicateCustomerHandler struct {
+	detectDuplicateCandidates   ForDetectingDuplicateCustomerCandidates
+	retrieveDuplicateCandidates ForRetrievingDuplicateCustomerCandidates
+	markAsNotDuplicate          ForMarkingDuplicateCustomerCandidatesAsNotDuplicate
+}
+
+func NewDuplicateCustomerHandler(
+	detectDuplicateCandidates ForDetectingDuplicateCustomerCandidates,
+	retrieveDuplicateCandidates ForRetrievingDuplicateCustomerCandidates,
+	markAsNotDuplicate ForMarkingDuplicateCustomerCandidatesAsNotDuplicate,
+) *DuplicateCustomerHandler {
+
+	return &DuplicateCustomerHandler{
+		detectDuplicateCandidates:   detectDuplicateCandidates,
+		retrieveDuplicateCandidates: retrieveDuplicateCandidates,
+		markAsNotDuplicate:          markAsNotDuplicate,
+	}
+}
+
+// DetectDuplicateCustomers returns the number of newly found candidates, already reviewed ones are kept as they are.
+func (h *DuplicateCustomerHandler) DetectDuplicateCustomers() (uint, error) {
+	numberOfNewCandidates, err := h.detectDuplicateCandidates()
+	if err != nil {
+		return 0, errors.Wrap(err, "duplicateCustomerHandler.DetectDuplicateCustomers")
+	}
+
+	return numberOfNewCandidates, nil
+}
+
+func (h *DuplicateCustomerHandler) ListDuplicateCandidates(includeReviewed bool) ([]customer.DuplicateCandidate, error) {
+	candidates, err := h.retrieveDuplicateCandidates(includeReviewed)
+	if err != nil {
+		return nil, errors.Wrap(err, "duplicateCustomerHandler.ListDuplicateCandidates")
+	}
+
+	return candidates, nil
+}
+
+func (h *DuplicateCustomerHandler) MarkAsNotDuplicate(customerID string, otherCustomerID string) error {
+	var err error
+	var customerIDValue value.CustomerID
+	var otherCustomerIDValue value.CustomerID
+	wrapWithMsg := "duplicateCustomerHandler.MarkAsNotDuplicate"
+
+	if customerIDValue, err = value.BuildCustomerID(customerID); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	if otherCustomerIDValue, err = value.BuildCustomerID(otherCustomerID); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	if customerIDValue.Equals(otherCustomerIDValue) {
+		err = errors.New("a customer can't be a duplicate of itself")
+		return shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	if err = h.markAsNotDuplicate(customerIDValue, otherCustomerIDValue); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	return nil
+}
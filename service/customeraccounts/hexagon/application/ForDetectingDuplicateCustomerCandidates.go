@@ -0,0 +1,3 @@
+package application
+
+type ForDetectingDuplicateCustomerCandidates func() (uint, error)
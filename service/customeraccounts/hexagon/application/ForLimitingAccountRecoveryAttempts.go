@@ -0,0 +1,3 @@
+package application
+
+type ForLimitingAccountRecoveryAttempts func(key string) error
@@ -0,0 +1,3 @@
+package application
+
+type ForLimitingMFACodeAttempts func(key string) error
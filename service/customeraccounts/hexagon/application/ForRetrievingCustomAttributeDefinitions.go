@@ -0,0 +1,7 @@
+package application
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+)
+
+type ForRetrievingCustomAttributeDefinitions func(name string) (value.CustomAttributeDefinition, error)
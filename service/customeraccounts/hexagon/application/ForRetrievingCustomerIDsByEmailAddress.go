@@ -0,0 +1,7 @@
+package application
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+)
+
+type ForRetrievingCustomerIDsByEmailAddress func(emailAddress value.EmailAddress) (value.CustomerID, error)
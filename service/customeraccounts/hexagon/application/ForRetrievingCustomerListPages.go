@@ -0,0 +1,7 @@
+package application
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+)
+
+type ForRetrievingCustomerListPages func(criteria customer.ListCriteria) (customer.ListPage, error)
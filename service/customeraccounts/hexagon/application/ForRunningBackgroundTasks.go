@@ -0,0 +1,3 @@
+package application
+
+type ForRunningBackgroundTasks func(task func() error)
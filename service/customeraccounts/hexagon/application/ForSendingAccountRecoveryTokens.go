@@ -0,0 +1,7 @@
+package application
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+)
+
+type ForSendingAccountRecoveryTokens func(emailAddress value.EmailAddress, recoveryToken string) error
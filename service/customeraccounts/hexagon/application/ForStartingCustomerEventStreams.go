@@ -2,6 +2,8 @@ package application
 
 import (
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
 )
 
-type ForStartingCustomerEventStreams func(customerRegistered domain.CustomerRegistered) error
+// ForStartingCustomerEventStreams stores the furtherEvents in the same append as customerRegistered.
+type ForStartingCustomerEventStreams func(customerRegistered domain.CustomerRegistered, furtherEvents ...es.DomainEvent) error
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+)
+
+type ChangeCustomerPassword struct {
+	customerID      value.CustomerID
+	currentPassword string
+	newPasswordHash value.PasswordHash
+}
+
+func BuildChangeCustomerPassword(
+	customerID value.CustomerID,
+	currentPassword string,
+	newPasswordHash value.PasswordHash,
+) ChangeCustomerPassword {
+
+	changePassword := ChangeCustomerPassword{
+		customerID:      customerID,
+		currentPassword: currentPassword,
+		newPasswordHash: newPasswordHash,
+	}
+
+	return changePassword
+}
+
+func (command ChangeCustomerPassword) CustomerID() value.CustomerID {
+	return command.customerID
+}
+
+func (command ChangeCustomerPassword) CurrentPassword() string {
+	return command.currentPassword
+}
+
+func (command ChangeCustomerPassword) NewPasswordHash() value.PasswordHash {
+	return command.newPasswordHash
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+)
+
+type CompleteCustomerAccountRecovery struct {
+	customerID      value.CustomerID
+	recoveryToken   string
+	newPasswordHash value.PasswordHash
+	completedAt     time.Time
+}
+
+func BuildCompleteCustomerAccountRecovery(
+	customerID value.CustomerID,
+	recoveryToken string,
+	newPasswordHash value.PasswordHash,
+	completedAt time.Time,
+) CompleteCustomerAccountRecovery {
+
+	completeAccountRecovery := CompleteCustomerAccountRecovery{
+		customerID:      customerID,
+		recoveryToken:   recoveryToken,
+		newPasswordHash: newPasswordHash,
+		completedAt:     completedAt,
+	}
+
+	return completeAccountRecovery
+}
+
+func (command CompleteCustomerAccountRecovery) CustomerID() value.CustomerID {
+	return command.customerID
+}
+
+func (command CompleteCustomerAccountRecovery) RecoveryToken() string {
+	return command.recoveryToken
+}
+
+func (command CompleteCustomerAccountRecovery) NewPasswordHash() value.PasswordHash {
+	return command.newPasswordHash
+}
+
+func (command CompleteCustomerAccountRecovery) CompletedAt() time.Time {
+	return command.completedAt
+}
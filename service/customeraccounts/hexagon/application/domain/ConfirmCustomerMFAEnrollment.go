@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+)
+
+type ConfirmCustomerMFAEnrollment struct {
+	customerID value.CustomerID
+	code       string
+	verifiedAt time.Time
+}
+
+func BuildConfirmCustomerMFAEnrollment(
+	customerID value.CustomerID,
+	code string,
+	verifiedAt time.Time,
+) ConfirmCustomerMFAEnrollment {
+
+	confirmMFAEnrollment := ConfirmCustomerMFAEnrollment{
+		customerID: customerID,
+		code:       code,
+		verifiedAt: verifiedAt,
+	}
+
+	return confirmMFAEnrollment
+}
+
+func (command ConfirmCustomerMFAEnrollment) CustomerID() value.CustomerID {
+	return command.customerID
+}
+
+func (command ConfirmCustomerMFAEnrollment) Code() string {
+	return command.code
+}
+
+func (command ConfirmCustomerMFAEnrollment) VerifiedAt() time.Time {
+	return command.verifiedAt
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+)
+
+type CustomerAccountRecoveryRequested struct {
+	customerID    value.CustomerID
+	recoveryToken value.AccountRecoveryToken
+	meta          es.EventMeta
+}
+
+func BuildCustomerAccountRecoveryRequested(
+	customerID value.CustomerID,
+	recoveryToken value.AccountRecoveryToken,
+	streamVersion uint,
+) CustomerAccountRecoveryRequested {
+
+	event := CustomerAccountRecoveryRequested{
+		customerID:    customerID,
+		recoveryToken: recoveryToken,
+	}
+
+	event.meta = es.BuildEventMeta(event, streamVersion)
+
+	return event
+}
+
+func RebuildCustomerAccountRecoveryRequested(
+	customerID string,
+	recoveryTokenDigest string,
+	recoveryTokenExpiresAt time.Time,
+	meta es.EventMeta,
+) CustomerAccountRecoveryRequested {
+
+	event := CustomerAccountRecoveryRequested{
+		customerID:    value.RebuildCustomerID(customerID),
+		recoveryToken: value.RebuildAccountRecoveryToken(recoveryTokenDigest, recoveryTokenExpiresAt),
+		meta:          meta,
+	}
+
+	return event
+}
+
+func (event CustomerAccountRecoveryRequested) CustomerID() value.CustomerID {
+	return event.customerID
+}
+
+func (event CustomerAccountRecoveryRequested) RecoveryToken() value.AccountRecoveryToken {
+	return event.recoveryToken
+}
+
+func (event CustomerAccountRecoveryRequested) Meta() es.EventMeta {
+	return event.meta
+}
+
+func (event CustomerAccountRecoveryRequested) IsFailureEvent() bool {
+	return false
+}
+
+func (event CustomerAccountRecoveryRequested) FailureReason() error {
+	return nil
+}
@@ -0,0 +1,29 @@
+package domain
+
+//go:generate go run ../../../../cmd/eventgen -definitions CustomerEvents.json -serialization-dir ../../../infrastructure/serialization
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+)
+
+// CustomerEvents returns a zero value of every Customer event, so that adapters can assert at startup that they know all of them.
+func CustomerEvents() []es.DomainEvent {
+	return []es.DomainEvent{
+		CustomerRegistered{},
+		CustomerEmailAddressConfirmed{},
+		CustomerEmailAddressConfirmationFailed{},
+		CustomerEmailAddressChanged{},
+		CustomerNameChanged{},
+		CustomerAttributeSet{},
+		CustomerPasswordSet{},
+		CustomerPasswordChanged{},
+		CustomerAccountRecoveryRequested{},
+		CustomerAccountRecovered{},
+		CustomerMFAEnrollmentStarted{},
+		CustomerMFAEnrollmentConfirmed{},
+		CustomerMFACodeUsed{},
+		CustomerMFARecoveryCodeUsed{},
+		CustomerMFADisabled{},
+		CustomerDeleted{},
+	}
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+)
+
+type CustomerMFAEnrollmentStarted struct {
+	customerID    value.CustomerID
+	totpSecret    value.TOTPSecret
+	recoveryCodes value.RecoveryCodes
+	meta          es.EventMeta
+}
+
+func BuildCustomerMFAEnrollmentStarted(
+	customerID value.CustomerID,
+	totpSecret value.TOTPSecret,
+	recoveryCodes value.RecoveryCodes,
+	streamVersion uint,
+) CustomerMFAEnrollmentStarted {
+
+	event := CustomerMFAEnrollmentStarted{
+		customerID:    customerID,
+		totpSecret:    totpSecret,
+		recoveryCodes: recoveryCodes,
+	}
+
+	event.meta = es.BuildEventMeta(event, streamVersion)
+
+	return event
+}
+
+func RebuildCustomerMFAEnrollmentStarted(
+	customerID string,
+	totpSecret string,
+	recoveryCodeHashes []string,
+	meta es.EventMeta,
+) CustomerMFAEnrollmentStarted {
+
+	event := CustomerMFAEnrollmentStarted{
+		customerID:    value.RebuildCustomerID(customerID),
+		totpSecret:    value.RebuildTOTPSecret(totpSecret),
+		recoveryCodes: value.RebuildRecoveryCodes(recoveryCodeHashes),
+		meta:          meta,
+	}
+
+	return event
+}
+
+func (event CustomerMFAEnrollmentStarted) CustomerID() value.CustomerID {
+	return event.customerID
+}
+
+func (event CustomerMFAEnrollmentStarted) TOTPSecret() value.TOTPSecret {
+	return event.totpSecret
+}
+
+func (event CustomerMFAEnrollmentStarted) RecoveryCodes() value.RecoveryCodes {
+	return event.recoveryCodes
+}
+
+func (event CustomerMFAEnrollmentStarted) Meta() es.EventMeta {
+	return event.meta
+}
+
+func (event CustomerMFAEnrollmentStarted) IsFailureEvent() bool {
+	return false
+}
+
+func (event CustomerMFAEnrollmentStarted) FailureReason() error {
+	return nil
+}
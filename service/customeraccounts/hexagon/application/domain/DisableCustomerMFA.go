@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+)
+
+type DisableCustomerMFA struct {
+	customerID value.CustomerID
+	code       string
+	verifiedAt time.Time
+}
+
+func BuildDisableCustomerMFA(
+	customerID value.CustomerID,
+	code string,
+	verifiedAt time.Time,
+) DisableCustomerMFA {
+
+	disableMFA := DisableCustomerMFA{
+		customerID: customerID,
+		code:       code,
+		verifiedAt: verifiedAt,
+	}
+
+	return disableMFA
+}
+
+func (command DisableCustomerMFA) CustomerID() value.CustomerID {
+	return command.customerID
+}
+
+func (command DisableCustomerMFA) Code() string {
+	return command.code
+}
+
+func (command DisableCustomerMFA) VerifiedAt() time.Time {
+	return command.verifiedAt
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+)
+
+type RequestCustomerAccountRecovery struct {
+	customerID    value.CustomerID
+	recoveryToken value.AccountRecoveryToken
+}
+
+func BuildRequestCustomerAccountRecovery(
+	customerID value.CustomerID,
+	recoveryToken value.AccountRecoveryToken,
+) RequestCustomerAccountRecovery {
+
+	requestAccountRecovery := RequestCustomerAccountRecovery{
+		customerID:    customerID,
+		recoveryToken: recoveryToken,
+	}
+
+	return requestAccountRecovery
+}
+
+func (command RequestCustomerAccountRecovery) CustomerID() value.CustomerID {
+	return command.customerID
+}
+
+func (command RequestCustomerAccountRecovery) RecoveryToken() value.AccountRecoveryToken {
+	return command.recoveryToken
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+)
+
+type SetCustomerAttribute struct {
+	customerID value.CustomerID
+	attribute  value.CustomAttribute
+}
+
+func BuildSetCustomerAttribute(
+	customerID value.CustomerID,
+	attribute value.CustomAttribute,
+) SetCustomerAttribute {
+
+	setAttribute := SetCustomerAttribute{
+		customerID: customerID,
+		attribute:  attribute,
+	}
+
+	return setAttribute
+}
+
+func (command SetCustomerAttribute) CustomerID() value.CustomerID {
+	return command.customerID
+}
+
+func (command SetCustomerAttribute) Attribute() value.CustomAttribute {
+	return command.attribute
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+)
+
+type SetCustomerPassword struct {
+	customerID   value.CustomerID
+	passwordHash value.PasswordHash
+}
+
+func BuildSetCustomerPassword(
+	customerID value.CustomerID,
+	passwordHash value.PasswordHash,
+) SetCustomerPassword {
+
+	setPassword := SetCustomerPassword{
+		customerID:   customerID,
+		passwordHash: passwordHash,
+	}
+
+	return setPassword
+}
+
+func (command SetCustomerPassword) CustomerID() value.CustomerID {
+	return command.customerID
+}
+
+func (command SetCustomerPassword) PasswordHash() value.PasswordHash {
+	return command.passwordHash
+}
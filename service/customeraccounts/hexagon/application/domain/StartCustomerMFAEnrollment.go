@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+)
+
+type StartCustomerMFAEnrollment struct {
+	customerID    value.CustomerID
+	totpSecret    value.TOTPSecret
+	recoveryCodes value.RecoveryCodes
+}
+
+func BuildStartCustomerMFAEnrollment(
+	customerID value.CustomerID,
+	totpSecret value.TOTPSecret,
+	recoveryCodes value.RecoveryCodes,
+) StartCustomerMFAEnrollment {
+
+	startMFAEnrollment := StartCustomerMFAEnrollment{
+		customerID:    customerID,
+		totpSecret:    totpSecret,
+		recoveryCodes: recoveryCodes,
+	}
+
+	return startMFAEnrollment
+}
+
+func (command StartCustomerMFAEnrollment) CustomerID() value.CustomerID {
+	return command.customerID
+}
+
+func (command StartCustomerMFAEnrollment) TOTPSecret() value.TOTPSecret {
+	return command.totpSecret
+}
+
+func (command StartCustomerMFAEnrollment) RecoveryCodes() value.RecoveryCodes {
+	return command.recoveryCodes
+}
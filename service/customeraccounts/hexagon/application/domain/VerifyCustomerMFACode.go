@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+)
+
+type VerifyCustomerMFACode struct {
+	customerID value.CustomerID
+	code       string
+	verifiedAt time.Time
+}
+
+func BuildVerifyCustomerMFACode(
+	customerID value.CustomerID,
+	code string,
+	verifiedAt time.Time,
+) VerifyCustomerMFACode {
+
+	verifyMFACode := VerifyCustomerMFACode{
+		customerID: customerID,
+		code:       code,
+		verifiedAt: verifiedAt,
+	}
+
+	return verifyMFACode
+}
+
+func (command VerifyCustomerMFACode) CustomerID() value.CustomerID {
+	return command.customerID
+}
+
+func (command VerifyCustomerMFACode) Code() string {
+	return command.code
+}
+
+func (command VerifyCustomerMFACode) VerifiedAt() time.Time {
+	return command.verifiedAt
+}
@@ -0,0 +1,33 @@
+package customer
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+)
+
+func ChangePassword(eventStream es.EventStream, command domain.ChangeCustomerPassword) (es.RecordedEvents, error) {
+	customer := buildCurrentStateFrom(eventStream)
+
+	if err := assertNotDeleted(customer); err != nil {
+		return nil, errors.Wrap(err, "changeCustomerPassword")
+	}
+
+	if customer.passwordHash.IsEmpty() {
+		err := errors.New("password was never set, it can't be changed")
+		return nil, shared.MarkAndWrapError(err, shared.ErrDomainConstraintsViolation, "changeCustomerPassword")
+	}
+
+	if err := assertMatchingPassword(customer.passwordHash, command.CurrentPassword()); err != nil {
+		return nil, errors.Wrap(err, "changeCustomerPassword")
+	}
+
+	event := domain.BuildCustomerPasswordChanged(
+		customer.id,
+		command.NewPasswordHash(),
+		customer.currentStreamVersion+1,
+	)
+
+	return es.RecordedEvents{event}, nil
+}
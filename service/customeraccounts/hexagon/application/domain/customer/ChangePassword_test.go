@@ -0,0 +1,138 @@
+package customer_test
+
+import (
+	"testing"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestChangePassword(t *testing.T) {
+	currentPassword, _ := value.BuildPlainPassword("Correct-Horse-1")
+	currentPasswordHash, _ := value.GeneratePasswordHash(currentPassword)
+
+	Convey("Prepare test artifacts", t, func() {
+		var err error
+		var recordedEvents es.RecordedEvents
+
+		customerID := value.GenerateCustomerID()
+		emailAddress := value.RebuildEmailAddress("kevin@ball.com")
+		confirmationHash := value.GenerateConfirmationHash(emailAddress.String())
+		personName := value.RebuildPersonName("Kevin", "Ball")
+		newPasswordHash := value.RebuildPasswordHash("$2a$12$someOtherBcryptHash")
+
+		customerWasRegistered := domain.BuildCustomerRegistered(
+			customerID,
+			emailAddress,
+			confirmationHash,
+			personName,
+			1,
+		)
+
+		passwordWasSet := domain.BuildCustomerPasswordSet(customerID, currentPasswordHash, 2)
+
+		changePassword := domain.BuildChangeCustomerPassword(
+			customerID,
+			currentPassword.String(),
+			newPasswordHash,
+		)
+
+		Convey("\nSCENARIO 1: Change a Customer's password", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("and CustomerPasswordSet", func() {
+					eventStream = append(eventStream, passwordWasSet)
+
+					Convey("When ChangeCustomerPassword", func() {
+						recordedEvents, err = customer.ChangePassword(eventStream, changePassword)
+						So(err, ShouldBeNil)
+
+						Convey("Then CustomerPasswordChanged", func() {
+							So(recordedEvents, ShouldHaveLength, 1)
+							passwordChanged, ok := recordedEvents[0].(domain.CustomerPasswordChanged)
+							So(ok, ShouldBeTrue)
+							So(passwordChanged, ShouldNotBeNil)
+							So(passwordChanged.CustomerID().Equals(customerID), ShouldBeTrue)
+							So(passwordChanged.PasswordHash().Equals(newPasswordHash), ShouldBeTrue)
+							So(passwordChanged.IsFailureEvent(), ShouldBeFalse)
+							So(passwordChanged.FailureReason(), ShouldBeNil)
+							So(passwordChanged.Meta().StreamVersion(), ShouldEqual, 3)
+						})
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO 2: Try to change a Customer's password with a wrong current password", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("and CustomerPasswordSet", func() {
+					eventStream = append(eventStream, passwordWasSet)
+
+					Convey("When ChangeCustomerPassword", func() {
+						changePassword = domain.BuildChangeCustomerPassword(
+							customerID,
+							"Wrong-Horse-1",
+							newPasswordHash,
+						)
+
+						_, err = customer.ChangePassword(eventStream, changePassword)
+
+						Convey("Then it should report an error", func() {
+							So(err, ShouldBeError)
+							So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+						})
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO 3: Try to change a Customer's password when it was never set", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("When ChangeCustomerPassword", func() {
+					_, err = customer.ChangePassword(eventStream, changePassword)
+
+					Convey("Then it should report an error", func() {
+						So(err, ShouldBeError)
+						So(errors.Is(err, shared.ErrDomainConstraintsViolation), ShouldBeTrue)
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO 4: Try to change a Customer's password when the account was deleted", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("and CustomerPasswordSet", func() {
+					eventStream = append(eventStream, passwordWasSet)
+
+					Convey("Given CustomerDeleted", func() {
+						eventStream = append(
+							eventStream,
+							domain.BuildCustomerDeleted(customerID, emailAddress, 3),
+						)
+
+						Convey("When ChangeCustomerPassword", func() {
+							_, err := customer.ChangePassword(eventStream, changePassword)
+
+							Convey("Then it should report an error", func() {
+								So(err, ShouldBeError)
+								So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
+							})
+						})
+					})
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,31 @@
+package customer
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+)
+
+// CompleteAccountRecovery reports every failure as ErrInvalidCredentials, so that callers can't tell a deleted
+// customer, a missing, an expired, or an already used recovery token apart from a wrong one.
+func CompleteAccountRecovery(
+	eventStream es.EventStream,
+	command domain.CompleteCustomerAccountRecovery,
+) (es.RecordedEvents, error) {
+
+	customer := buildCurrentStateFrom(eventStream)
+
+	if customer.isDeleted || !customer.accountRecoveryToken.IsValidFor(command.RecoveryToken(), command.CompletedAt()) {
+		err := errors.New("invalid or expired recovery token supplied")
+		return nil, shared.MarkAndWrapError(err, shared.ErrInvalidCredentials, "completeCustomerAccountRecovery")
+	}
+
+	event := domain.BuildCustomerAccountRecovered(
+		customer.id,
+		command.NewPasswordHash(),
+		customer.currentStreamVersion+1,
+	)
+
+	return es.RecordedEvents{event}, nil
+}
@@ -0,0 +1,145 @@
+package customer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCompleteAccountRecovery(t *testing.T) {
+	Convey("Prepare test artifacts", t, func() {
+		var err error
+		var recordedEvents es.RecordedEvents
+
+		customerID := value.GenerateCustomerID()
+		emailAddress := value.RebuildEmailAddress("kevin@ball.com")
+		confirmationHash := value.GenerateConfirmationHash(emailAddress.String())
+		personName := value.RebuildPersonName("Kevin", "Ball")
+		newPasswordHash := value.RebuildPasswordHash("$2a$12$someOtherBcryptHash")
+		requestedAt := time.Now()
+		plainToken, recoveryToken, _ := value.GenerateAccountRecoveryToken(requestedAt)
+
+		customerWasRegistered := domain.BuildCustomerRegistered(
+			customerID,
+			emailAddress,
+			confirmationHash,
+			personName,
+			1,
+		)
+
+		recoveryWasRequested := domain.BuildCustomerAccountRecoveryRequested(customerID, recoveryToken, 2)
+
+		completeAccountRecovery := domain.BuildCompleteCustomerAccountRecovery(
+			customerID,
+			plainToken,
+			newPasswordHash,
+			requestedAt.Add(time.Minute),
+		)
+
+		Convey("\nSCENARIO 1: Complete a Customer's account recovery", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("and CustomerAccountRecoveryRequested", func() {
+					eventStream = append(eventStream, recoveryWasRequested)
+
+					Convey("When CompleteCustomerAccountRecovery", func() {
+						recordedEvents, err = customer.CompleteAccountRecovery(eventStream, completeAccountRecovery)
+						So(err, ShouldBeNil)
+
+						Convey("Then CustomerAccountRecovered", func() {
+							So(recordedEvents, ShouldHaveLength, 1)
+							accountRecovered, ok := recordedEvents[0].(domain.CustomerAccountRecovered)
+							So(ok, ShouldBeTrue)
+							So(accountRecovered, ShouldNotBeNil)
+							So(accountRecovered.CustomerID().Equals(customerID), ShouldBeTrue)
+							So(accountRecovered.PasswordHash().Equals(newPasswordHash), ShouldBeTrue)
+							So(accountRecovered.IsFailureEvent(), ShouldBeFalse)
+							So(accountRecovered.FailureReason(), ShouldBeNil)
+							So(accountRecovered.Meta().StreamVersion(), ShouldEqual, 3)
+						})
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO 2: Try to complete a Customer's account recovery with an invalid token", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("When CompleteCustomerAccountRecovery without a requested recovery", func() {
+					_, err = customer.CompleteAccountRecovery(eventStream, completeAccountRecovery)
+
+					Convey("Then it should report an error", func() {
+						So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+					})
+				})
+
+				Convey("and CustomerAccountRecoveryRequested", func() {
+					eventStream = append(eventStream, recoveryWasRequested)
+
+					Convey("When CompleteCustomerAccountRecovery with a wrong token", func() {
+						completeAccountRecovery = domain.BuildCompleteCustomerAccountRecovery(
+							customerID,
+							plainToken+"x",
+							newPasswordHash,
+							requestedAt.Add(time.Minute),
+						)
+
+						_, err = customer.CompleteAccountRecovery(eventStream, completeAccountRecovery)
+
+						Convey("Then it should report an error", func() {
+							So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+						})
+					})
+
+					Convey("When CompleteCustomerAccountRecovery after the token expired", func() {
+						completeAccountRecovery = domain.BuildCompleteCustomerAccountRecovery(
+							customerID,
+							plainToken,
+							newPasswordHash,
+							requestedAt.Add(time.Hour),
+						)
+
+						_, err = customer.CompleteAccountRecovery(eventStream, completeAccountRecovery)
+
+						Convey("Then it should report an error", func() {
+							So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+						})
+					})
+
+					Convey("and CustomerAccountRecovered", func() {
+						eventStream = append(eventStream, domain.BuildCustomerAccountRecovered(customerID, newPasswordHash, 3))
+
+						Convey("When CompleteCustomerAccountRecovery with the already used token", func() {
+							_, err = customer.CompleteAccountRecovery(eventStream, completeAccountRecovery)
+
+							Convey("Then it should report an error", func() {
+								So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+							})
+						})
+					})
+
+					Convey("and CustomerDeleted", func() {
+						eventStream = append(eventStream, domain.BuildCustomerDeleted(customerID, emailAddress, 3))
+
+						Convey("When CompleteCustomerAccountRecovery", func() {
+							_, err = customer.CompleteAccountRecovery(eventStream, completeAccountRecovery)
+
+							Convey("Then it should report an error", func() {
+								So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+							})
+						})
+					})
+				})
+			})
+		})
+	})
+}
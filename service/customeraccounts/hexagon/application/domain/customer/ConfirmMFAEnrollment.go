@@ -0,0 +1,43 @@
+package customer
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+)
+
+func ConfirmMFAEnrollment(eventStream es.EventStream, command domain.ConfirmCustomerMFAEnrollment) (es.RecordedEvents, error) {
+	customer := buildCurrentStateFrom(eventStream)
+
+	if err := assertNotDeleted(customer); err != nil {
+		return nil, errors.Wrap(err, "confirmCustomerMFAEnrollment")
+	}
+
+	if customer.isMFAEnabled {
+		return nil, nil
+	}
+
+	if !customer.isMFAEnrollmentPending {
+		err := errors.New("MFA enrollment was not started")
+		return nil, shared.MarkAndWrapError(err, shared.ErrDomainConstraintsViolation, "confirmCustomerMFAEnrollment")
+	}
+
+	timeStep, _, err := assertMatchingMFACode(customer, command.Code(), command.VerifiedAt(), false)
+	if err != nil {
+		return nil, errors.Wrap(err, "confirmCustomerMFAEnrollment")
+	}
+
+	enrollmentConfirmed := domain.BuildCustomerMFAEnrollmentConfirmed(
+		customer.id,
+		customer.currentStreamVersion+1,
+	)
+
+	codeUsed := domain.BuildCustomerMFACodeUsed(
+		customer.id,
+		timeStep,
+		customer.currentStreamVersion+2,
+	)
+
+	return es.RecordedEvents{enrollmentConfirmed, codeUsed}, nil
+}
@@ -0,0 +1,139 @@
+package customer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestConfirmMFAEnrollment(t *testing.T) {
+	Convey("Prepare test artifacts", t, func() {
+		var err error
+		var recordedEvents es.RecordedEvents
+
+		customerID := value.GenerateCustomerID()
+		emailAddress := value.RebuildEmailAddress("kevin@ball.com")
+		confirmationHash := value.GenerateConfirmationHash(emailAddress.String())
+		personName := value.RebuildPersonName("Kevin", "Ball")
+		totpSecret, _ := value.GenerateTOTPSecret()
+		plainRecoveryCodes, recoveryCodes, _ := value.GenerateRecoveryCodes()
+		now := time.Now()
+
+		customerWasRegistered := domain.BuildCustomerRegistered(
+			customerID,
+			emailAddress,
+			confirmationHash,
+			personName,
+			1,
+		)
+
+		mfaEnrollmentWasStarted := domain.BuildCustomerMFAEnrollmentStarted(customerID, totpSecret, recoveryCodes, 2)
+
+		confirmMFAEnrollment := domain.BuildConfirmCustomerMFAEnrollment(customerID, totpSecret.CodeAt(now), now)
+
+		Convey("\nSCENARIO 1: Confirm a Customer's MFA enrollment with a valid code", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("and CustomerMFAEnrollmentStarted", func() {
+					eventStream = append(eventStream, mfaEnrollmentWasStarted)
+
+					Convey("When ConfirmCustomerMFAEnrollment", func() {
+						recordedEvents, err = customer.ConfirmMFAEnrollment(eventStream, confirmMFAEnrollment)
+						So(err, ShouldBeNil)
+
+						Convey("Then CustomerMFAEnrollmentConfirmed and CustomerMFACodeUsed", func() {
+							So(recordedEvents, ShouldHaveLength, 2)
+							enrollmentConfirmed, ok := recordedEvents[0].(domain.CustomerMFAEnrollmentConfirmed)
+							So(ok, ShouldBeTrue)
+							So(enrollmentConfirmed.CustomerID().Equals(customerID), ShouldBeTrue)
+							So(enrollmentConfirmed.IsFailureEvent(), ShouldBeFalse)
+							So(enrollmentConfirmed.FailureReason(), ShouldBeNil)
+							So(enrollmentConfirmed.Meta().StreamVersion(), ShouldEqual, 3)
+							codeUsed, ok := recordedEvents[1].(domain.CustomerMFACodeUsed)
+							So(ok, ShouldBeTrue)
+							So(codeUsed.CustomerID().Equals(customerID), ShouldBeTrue)
+							So(codeUsed.Meta().StreamVersion(), ShouldEqual, 4)
+
+							Convey("And when the same code is verified afterwards", func() {
+								eventStream = append(eventStream, enrollmentConfirmed, codeUsed)
+								verifyMFACode := domain.BuildVerifyCustomerMFACode(customerID, totpSecret.CodeAt(now), now)
+								_, err = customer.VerifyMFACode(eventStream, verifyMFACode)
+
+								Convey("Then it should report an error", func() {
+									So(err, ShouldBeError)
+									So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+								})
+							})
+						})
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO 2: Try to confirm a Customer's MFA enrollment with a recovery code", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("and CustomerMFAEnrollmentStarted", func() {
+					eventStream = append(eventStream, mfaEnrollmentWasStarted)
+
+					Convey("When ConfirmCustomerMFAEnrollment", func() {
+						confirmMFAEnrollment = domain.BuildConfirmCustomerMFAEnrollment(customerID, plainRecoveryCodes[0], now)
+						_, err = customer.ConfirmMFAEnrollment(eventStream, confirmMFAEnrollment)
+
+						Convey("Then it should report an error", func() {
+							So(err, ShouldBeError)
+							So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+						})
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO 3: Try to confirm a Customer's MFA enrollment which was never started", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("When ConfirmCustomerMFAEnrollment", func() {
+					_, err = customer.ConfirmMFAEnrollment(eventStream, confirmMFAEnrollment)
+
+					Convey("Then it should report an error", func() {
+						So(err, ShouldBeError)
+						So(errors.Is(err, shared.ErrDomainConstraintsViolation), ShouldBeTrue)
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO 4: Try to confirm a Customer's MFA enrollment which was already confirmed", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("and CustomerMFAEnrollmentStarted", func() {
+					eventStream = append(eventStream, mfaEnrollmentWasStarted)
+
+					Convey("and CustomerMFAEnrollmentConfirmed", func() {
+						eventStream = append(eventStream, domain.BuildCustomerMFAEnrollmentConfirmed(customerID, 3))
+
+						Convey("When ConfirmCustomerMFAEnrollment", func() {
+							recordedEvents, err = customer.ConfirmMFAEnrollment(eventStream, confirmMFAEnrollment)
+							So(err, ShouldBeNil)
+
+							Convey("Then no event", func() {
+								So(recordedEvents, ShouldBeEmpty)
+							})
+						})
+					})
+				})
+			})
+		})
+	})
+}
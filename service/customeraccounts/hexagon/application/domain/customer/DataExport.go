@@ -0,0 +1,14 @@
+package customer
+
+import (
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+)
+
+// DataExport contains everything we hold about a Customer, it must be rendered without internal-only fields.
+type DataExport struct {
+	View        View
+	EventStream es.EventStream
+	ExportedAt  time.Time
+}
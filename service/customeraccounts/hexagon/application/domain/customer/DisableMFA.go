@@ -0,0 +1,30 @@
+package customer
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+)
+
+func DisableMFA(eventStream es.EventStream, command domain.DisableCustomerMFA) (es.RecordedEvents, error) {
+	customer := buildCurrentStateFrom(eventStream)
+
+	if err := assertNotDeleted(customer); err != nil {
+		return nil, errors.Wrap(err, "disableCustomerMFA")
+	}
+
+	if !customer.isMFAEnabled && !customer.isMFAEnrollmentPending {
+		return nil, nil
+	}
+
+	if _, _, err := assertMatchingMFACode(customer, command.Code(), command.VerifiedAt(), true); err != nil {
+		return nil, errors.Wrap(err, "disableCustomerMFA")
+	}
+
+	event := domain.BuildCustomerMFADisabled(
+		customer.id,
+		customer.currentStreamVersion+1,
+	)
+
+	return es.RecordedEvents{event}, nil
+}
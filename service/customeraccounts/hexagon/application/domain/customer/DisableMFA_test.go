@@ -0,0 +1,101 @@
+package customer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDisableMFA(t *testing.T) {
+	Convey("Prepare test artifacts", t, func() {
+		var err error
+		var recordedEvents es.RecordedEvents
+
+		customerID := value.GenerateCustomerID()
+		emailAddress := value.RebuildEmailAddress("kevin@ball.com")
+		confirmationHash := value.GenerateConfirmationHash(emailAddress.String())
+		personName := value.RebuildPersonName("Kevin", "Ball")
+		totpSecret, _ := value.GenerateTOTPSecret()
+		plainRecoveryCodes, recoveryCodes, _ := value.GenerateRecoveryCodes()
+		now := time.Now()
+
+		customerWasRegistered := domain.BuildCustomerRegistered(
+			customerID,
+			emailAddress,
+			confirmationHash,
+			personName,
+			1,
+		)
+
+		mfaEnrollmentWasStarted := domain.BuildCustomerMFAEnrollmentStarted(customerID, totpSecret, recoveryCodes, 2)
+
+		disableMFA := domain.BuildDisableCustomerMFA(customerID, plainRecoveryCodes[1], now)
+
+		Convey("\nSCENARIO 1: Disable a Customer's MFA with a recovery code", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("and CustomerMFAEnrollmentStarted and CustomerMFAEnrollmentConfirmed", func() {
+					eventStream = append(eventStream, mfaEnrollmentWasStarted, domain.BuildCustomerMFAEnrollmentConfirmed(customerID, 3))
+
+					Convey("When DisableCustomerMFA", func() {
+						recordedEvents, err = customer.DisableMFA(eventStream, disableMFA)
+						So(err, ShouldBeNil)
+
+						Convey("Then CustomerMFADisabled", func() {
+							So(recordedEvents, ShouldHaveLength, 1)
+							mfaDisabled, ok := recordedEvents[0].(domain.CustomerMFADisabled)
+							So(ok, ShouldBeTrue)
+							So(mfaDisabled.CustomerID().Equals(customerID), ShouldBeTrue)
+							So(mfaDisabled.IsFailureEvent(), ShouldBeFalse)
+							So(mfaDisabled.FailureReason(), ShouldBeNil)
+							So(mfaDisabled.Meta().StreamVersion(), ShouldEqual, 4)
+						})
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO 2: Try to disable a Customer's MFA with a wrong code", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("and CustomerMFAEnrollmentStarted and CustomerMFAEnrollmentConfirmed", func() {
+					eventStream = append(eventStream, mfaEnrollmentWasStarted, domain.BuildCustomerMFAEnrollmentConfirmed(customerID, 3))
+
+					Convey("When DisableCustomerMFA", func() {
+						disableMFA = domain.BuildDisableCustomerMFA(customerID, "12345", now)
+						_, err = customer.DisableMFA(eventStream, disableMFA)
+
+						Convey("Then it should report an error", func() {
+							So(err, ShouldBeError)
+							So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+						})
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO 3: Disable a Customer's MFA when it is not enabled", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("When DisableCustomerMFA", func() {
+					recordedEvents, err = customer.DisableMFA(eventStream, disableMFA)
+					So(err, ShouldBeNil)
+
+					Convey("Then no event", func() {
+						So(recordedEvents, ShouldBeEmpty)
+					})
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,20 @@
+package customer
+
+import (
+	"time"
+)
+
+const (
+	DuplicateReasonSimilarName  = "similarName"
+	DuplicateReasonEmailPlusTag = "emailPlusTag"
+)
+
+// DuplicateCandidate is a pair of Customers which are likely the same person.
+type DuplicateCandidate struct {
+	Customer       ListEntry
+	OtherCustomer  ListEntry
+	Reason         string
+	Similarity     float64
+	IsNotDuplicate bool
+	DetectedAt     time.Time
+}
@@ -0,0 +1,68 @@
+package customer
+
+import (
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	FunnelIntervalDay  = "day"
+	FunnelIntervalWeek = "week"
+
+	defaultFunnelDays  = 30
+	defaultFunnelWeeks = 12
+	maxFunnelPeriods   = 366
+)
+
+// FunnelCriteria selects the periods of the registration funnel, zero times are replaced by defaults.
+type FunnelCriteria struct {
+	Interval string
+	From     time.Time
+	Until    time.Time
+}
+
+// BuildFunnelCriteria applies the defaults (the last 30 days or 12 weeks) and validates the result.
+func BuildFunnelCriteria(input FunnelCriteria) (FunnelCriteria, error) {
+	wrapWithMsg := "BuildFunnelCriteria"
+	criteria := input
+	periodLength := 24 * time.Hour
+
+	switch criteria.Interval {
+	case "", FunnelIntervalDay:
+		criteria.Interval = FunnelIntervalDay
+	case FunnelIntervalWeek:
+		periodLength *= 7
+	default:
+		err := errors.Newf("interval [%s] is not supported", criteria.Interval)
+		return FunnelCriteria{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	if criteria.Until.IsZero() {
+		criteria.Until = time.Now()
+	}
+
+	if criteria.From.IsZero() {
+		defaultPeriods := defaultFunnelDays
+		if criteria.Interval == FunnelIntervalWeek {
+			defaultPeriods = defaultFunnelWeeks
+		}
+
+		criteria.From = criteria.Until.Add(-time.Duration(defaultPeriods-1) * periodLength)
+	}
+
+	criteria.From, criteria.Until = criteria.From.UTC(), criteria.Until.UTC()
+
+	if criteria.From.After(criteria.Until) {
+		err := errors.New("from must not be after until")
+		return FunnelCriteria{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	if criteria.Until.Sub(criteria.From) >= maxFunnelPeriods*periodLength {
+		err := errors.Newf("the time range must not exceed [%d] periods", maxFunnelPeriods)
+		return FunnelCriteria{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	return criteria, nil
+}
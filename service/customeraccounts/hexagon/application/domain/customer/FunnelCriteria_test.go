@@ -0,0 +1,59 @@
+package customer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBuildFunnelCriteria(t *testing.T) {
+	Convey("When FunnelCriteria are built without interval and time range", t, func() {
+		criteria, err := customer.BuildFunnelCriteria(customer.FunnelCriteria{})
+
+		Convey("Then it should apply the defaults", func() {
+			So(err, ShouldBeNil)
+			So(criteria.Interval, ShouldEqual, customer.FunnelIntervalDay)
+			So(criteria.Until, ShouldHappenWithin, time.Second, time.Now())
+			So(criteria.Until.Sub(criteria.From), ShouldEqual, 29*24*time.Hour)
+		})
+	})
+
+	Convey("When FunnelCriteria are built for weeks without time range", t, func() {
+		criteria, err := customer.BuildFunnelCriteria(customer.FunnelCriteria{Interval: customer.FunnelIntervalWeek})
+
+		Convey("Then it should default to the last 12 weeks", func() {
+			So(err, ShouldBeNil)
+			So(criteria.Until.Sub(criteria.From), ShouldEqual, 11*7*24*time.Hour)
+		})
+	})
+
+	Convey("When FunnelCriteria are built with an unsupported interval", t, func() {
+		_, err := customer.BuildFunnelCriteria(customer.FunnelCriteria{Interval: "hour"})
+
+		Convey("Then it should fail", func() {
+			So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+		})
+	})
+
+	Convey("When FunnelCriteria are built with an inverted time range", t, func() {
+		now := time.Now()
+		_, err := customer.BuildFunnelCriteria(customer.FunnelCriteria{From: now, Until: now.Add(-time.Hour)})
+
+		Convey("Then it should fail", func() {
+			So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+		})
+	})
+
+	Convey("When FunnelCriteria are built with a too long time range", t, func() {
+		now := time.Now()
+		_, err := customer.BuildFunnelCriteria(customer.FunnelCriteria{From: now.AddDate(-2, 0, 0), Until: now})
+
+		Convey("Then it should fail", func() {
+			So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+		})
+	})
+}
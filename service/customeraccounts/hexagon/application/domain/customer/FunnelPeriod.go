@@ -0,0 +1,17 @@
+package customer
+
+import (
+	"time"
+)
+
+// FunnelPeriod contains the registration funnel metrics of one day or week (UTC).
+// AverageTimeToConfirmation only considers the first confirmation of each Customer.
+type FunnelPeriod struct {
+	PeriodStart               time.Time
+	Registrations             uint
+	Confirmations             uint
+	ConfirmationFailures      uint
+	ConfirmationFailureRate   float64
+	AverageTimeToConfirmation time.Duration
+	Deletions                 uint
+}
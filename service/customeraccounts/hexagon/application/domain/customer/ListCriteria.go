@@ -0,0 +1,61 @@
+package customer
+
+import (
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	ListSortByRegisteredAt = "registeredAt"
+	ListSortByEmailAddress = "emailAddress"
+	ListSortByFamilyName   = "familyName"
+
+	defaultListPageSize = uint(20)
+	maxListPageSize     = uint(100)
+)
+
+// ListCriteria filters and sorts the Customer list, nil (tri-state) filters and zero times mean "don't filter".
+type ListCriteria struct {
+	IsEmailAddressConfirmed *bool
+	IsDeleted               *bool
+	RegisteredFrom          time.Time
+	RegisteredUntil         time.Time
+	SortBy                  string
+	SortDescending          bool
+	PageSize                uint
+	Cursor                  string
+}
+
+// BuildListCriteria applies the defaults for sorting and page size and validates the result.
+func BuildListCriteria(input ListCriteria) (ListCriteria, error) {
+	wrapWithMsg := "BuildListCriteria"
+	criteria := input
+
+	switch criteria.SortBy {
+	case "":
+		criteria.SortBy = ListSortByRegisteredAt
+	case ListSortByRegisteredAt, ListSortByEmailAddress, ListSortByFamilyName:
+	default:
+		err := errors.Newf("sorting by [%s] is not supported", criteria.SortBy)
+		return ListCriteria{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	switch {
+	case criteria.PageSize == 0:
+		criteria.PageSize = defaultListPageSize
+	case criteria.PageSize > maxListPageSize:
+		err := errors.Newf("page size must not exceed [%d]", maxListPageSize)
+		return ListCriteria{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	if !criteria.RegisteredFrom.IsZero() && !criteria.RegisteredUntil.IsZero() &&
+		criteria.RegisteredFrom.After(criteria.RegisteredUntil) {
+
+		err := errors.New("registeredFrom must not be after registeredUntil")
+		return ListCriteria{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	return criteria, nil
+}
@@ -0,0 +1,50 @@
+package customer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBuildListCriteria(t *testing.T) {
+	Convey("When ListCriteria are built without sorting and page size", t, func() {
+		criteria, err := customer.BuildListCriteria(customer.ListCriteria{})
+
+		Convey("Then it should apply the defaults", func() {
+			So(err, ShouldBeNil)
+			So(criteria.SortBy, ShouldEqual, customer.ListSortByRegisteredAt)
+			So(criteria.PageSize, ShouldEqual, 20)
+		})
+	})
+
+	Convey("When ListCriteria are built with an unsupported sorting", t, func() {
+		_, err := customer.BuildListCriteria(customer.ListCriteria{SortBy: "password"})
+
+		Convey("Then it should fail", func() {
+			So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+		})
+	})
+
+	Convey("When ListCriteria are built with a too big page size", t, func() {
+		_, err := customer.BuildListCriteria(customer.ListCriteria{PageSize: 101})
+
+		Convey("Then it should fail", func() {
+			So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+		})
+	})
+
+	Convey("When ListCriteria are built with an inverted registration date range", t, func() {
+		now := time.Now()
+		_, err := customer.BuildListCriteria(
+			customer.ListCriteria{RegisteredFrom: now, RegisteredUntil: now.Add(-time.Hour)},
+		)
+
+		Convey("Then it should fail", func() {
+			So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,22 @@
+package customer
+
+import (
+	"time"
+)
+
+type ListEntry struct {
+	ID                      string
+	EmailAddress            string
+	IsEmailAddressConfirmed bool
+	GivenName               string
+	FamilyName              string
+	IsDeleted               bool
+	RegisteredAt            time.Time
+	Version                 uint
+}
+
+// ListPage contains an empty NextCursor if there are no more entries.
+type ListPage struct {
+	Entries    []ListEntry
+	NextCursor string
+}
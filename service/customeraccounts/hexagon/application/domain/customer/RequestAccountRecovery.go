@@ -0,0 +1,28 @@
+package customer
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+)
+
+// RequestAccountRecovery replaces any previously requested (and not yet used) recovery token.
+func RequestAccountRecovery(
+	eventStream es.EventStream,
+	command domain.RequestCustomerAccountRecovery,
+) (es.RecordedEvents, error) {
+
+	customer := buildCurrentStateFrom(eventStream)
+
+	if err := assertNotDeleted(customer); err != nil {
+		return nil, errors.Wrap(err, "requestCustomerAccountRecovery")
+	}
+
+	event := domain.BuildCustomerAccountRecoveryRequested(
+		customer.id,
+		command.RecoveryToken(),
+		customer.currentStreamVersion+1,
+	)
+
+	return es.RecordedEvents{event}, nil
+}
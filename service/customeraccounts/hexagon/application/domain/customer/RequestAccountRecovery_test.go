@@ -0,0 +1,79 @@
+package customer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRequestAccountRecovery(t *testing.T) {
+	Convey("Prepare test artifacts", t, func() {
+		var err error
+		var recordedEvents es.RecordedEvents
+
+		customerID := value.GenerateCustomerID()
+		emailAddress := value.RebuildEmailAddress("kevin@ball.com")
+		confirmationHash := value.GenerateConfirmationHash(emailAddress.String())
+		personName := value.RebuildPersonName("Kevin", "Ball")
+		_, recoveryToken, _ := value.GenerateAccountRecoveryToken(time.Now())
+
+		customerWasRegistered := domain.BuildCustomerRegistered(
+			customerID,
+			emailAddress,
+			confirmationHash,
+			personName,
+			1,
+		)
+
+		requestAccountRecovery := domain.BuildRequestCustomerAccountRecovery(customerID, recoveryToken)
+
+		Convey("\nSCENARIO 1: Request a Customer's account recovery", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("When RequestCustomerAccountRecovery", func() {
+					recordedEvents, err = customer.RequestAccountRecovery(eventStream, requestAccountRecovery)
+					So(err, ShouldBeNil)
+
+					Convey("Then CustomerAccountRecoveryRequested", func() {
+						So(recordedEvents, ShouldHaveLength, 1)
+						recoveryRequested, ok := recordedEvents[0].(domain.CustomerAccountRecoveryRequested)
+						So(ok, ShouldBeTrue)
+						So(recoveryRequested, ShouldNotBeNil)
+						So(recoveryRequested.CustomerID().Equals(customerID), ShouldBeTrue)
+						So(recoveryRequested.RecoveryToken(), ShouldResemble, recoveryToken)
+						So(recoveryRequested.IsFailureEvent(), ShouldBeFalse)
+						So(recoveryRequested.FailureReason(), ShouldBeNil)
+						So(recoveryRequested.Meta().StreamVersion(), ShouldEqual, 2)
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO 2: Try to request a deleted Customer's account recovery", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("and CustomerDeleted", func() {
+					eventStream = append(eventStream, domain.BuildCustomerDeleted(customerID, emailAddress, 2))
+
+					Convey("When RequestCustomerAccountRecovery", func() {
+						_, err = customer.RequestAccountRecovery(eventStream, requestAccountRecovery)
+
+						Convey("Then it should report an error", func() {
+							So(err, ShouldBeError)
+							So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
+						})
+					})
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,71 @@
+package customer
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	minSearchTermLength     = 2
+	maxSearchTermLength     = 100
+	defaultSearchMaxResults = uint(20)
+	maxSearchMaxResults     = uint(100)
+)
+
+type SearchCriteria struct {
+	term       string
+	words      []string
+	maxResults uint
+}
+
+func BuildSearchCriteria(term string, maxResults uint) (SearchCriteria, error) {
+	wrapWithMsg := "BuildSearchCriteria"
+	term = strings.TrimSpace(term)
+
+	if length := utf8.RuneCountInString(term); length < minSearchTermLength || length > maxSearchTermLength {
+		err := errors.Newf("search term must have between %d and %d characters", minSearchTermLength, maxSearchTermLength)
+		return SearchCriteria{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(term), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	if len(words) == 0 {
+		err := errors.New("search term must contain letters or digits")
+		return SearchCriteria{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	switch {
+	case maxResults == 0:
+		maxResults = defaultSearchMaxResults
+	case maxResults > maxSearchMaxResults:
+		err := errors.Newf("max results must not exceed [%d]", maxSearchMaxResults)
+		return SearchCriteria{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	criteria := SearchCriteria{
+		term:       term,
+		words:      words,
+		maxResults: maxResults,
+	}
+
+	return criteria, nil
+}
+
+func (criteria SearchCriteria) Term() string {
+	return criteria.term
+}
+
+// Words returns the lowercased words of the term, stripped of everything but letters and digits.
+func (criteria SearchCriteria) Words() []string {
+	return criteria.words
+}
+
+func (criteria SearchCriteria) MaxResults() uint {
+	return criteria.maxResults
+}
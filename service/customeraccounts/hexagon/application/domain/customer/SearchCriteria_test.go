@@ -0,0 +1,43 @@
+package customer_test
+
+import (
+	"testing"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBuildSearchCriteria(t *testing.T) {
+	Convey("When SearchCriteria are built from a term with special characters", t, func() {
+		criteria, err := customer.BuildSearchCriteria("  O'Brien, Liam  ", 0)
+
+		Convey("Then it should split the term into lowercased words and apply the defaults", func() {
+			So(err, ShouldBeNil)
+			So(criteria.Term(), ShouldEqual, "O'Brien, Liam")
+			So(criteria.Words(), ShouldResemble, []string{"o", "brien", "liam"})
+			So(criteria.MaxResults(), ShouldEqual, 20)
+		})
+	})
+
+	for _, term := range []string{"x", " ", "*!"} {
+		currentTerm := term
+
+		Convey("When SearchCriteria are built from the invalid term ["+currentTerm+"]", t, func() {
+			_, err := customer.BuildSearchCriteria(currentTerm, 0)
+
+			Convey("Then it should fail", func() {
+				So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+			})
+		})
+	}
+
+	Convey("When SearchCriteria are built with too many max results", t, func() {
+		_, err := customer.BuildSearchCriteria("liam", 101)
+
+		Convey("Then it should fail", func() {
+			So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+		})
+	})
+}
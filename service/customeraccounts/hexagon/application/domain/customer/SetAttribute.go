@@ -0,0 +1,29 @@
+package customer
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+)
+
+func SetAttribute(eventStream es.EventStream, command domain.SetCustomerAttribute) (es.RecordedEvents, error) {
+	customer := buildCurrentStateFrom(eventStream)
+
+	if err := assertNotDeleted(customer); err != nil {
+		return nil, errors.Wrap(err, "setCustomerAttribute")
+	}
+
+	if current, ok := customer.customAttributes[command.Attribute().Name()]; ok {
+		if current.Equals(command.Attribute()) {
+			return nil, nil
+		}
+	}
+
+	event := domain.BuildCustomerAttributeSet(
+		customer.id,
+		command.Attribute(),
+		customer.currentStreamVersion+1,
+	)
+
+	return es.RecordedEvents{event}, nil
+}
@@ -0,0 +1,132 @@
+package customer_test
+
+import (
+	"testing"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSetAttribute(t *testing.T) {
+	Convey("Prepare test artifacts", t, func() {
+		var err error
+		var recordedEvents es.RecordedEvents
+
+		customerID := value.GenerateCustomerID()
+		emailAddress := value.RebuildEmailAddress("kevin@ball.com")
+		confirmationHash := value.GenerateConfirmationHash(emailAddress.String())
+		personName := value.RebuildPersonName("Kevin", "Ball")
+		attribute := value.RebuildCustomAttribute("vip_level", "3")
+
+		customerWasRegistered := domain.BuildCustomerRegistered(
+			customerID,
+			emailAddress,
+			confirmationHash,
+			personName,
+			1,
+		)
+
+		setAttribute := domain.BuildSetCustomerAttribute(
+			customerID,
+			attribute,
+		)
+
+		Convey("\nSCENARIO 1: Set a Customer's custom attribute", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("When SetCustomerAttribute", func() {
+					recordedEvents, err = customer.SetAttribute(eventStream, setAttribute)
+					So(err, ShouldBeNil)
+
+					Convey("Then CustomerAttributeSet", func() {
+						So(recordedEvents, ShouldHaveLength, 1)
+						attributeSet, ok := recordedEvents[0].(domain.CustomerAttributeSet)
+						So(ok, ShouldBeTrue)
+						So(attributeSet, ShouldNotBeNil)
+						So(attributeSet.CustomerID().Equals(customerID), ShouldBeTrue)
+						So(attributeSet.Attribute().Equals(attribute), ShouldBeTrue)
+						So(attributeSet.IsFailureEvent(), ShouldBeFalse)
+						So(attributeSet.FailureReason(), ShouldBeNil)
+						So(attributeSet.Meta().StreamVersion(), ShouldEqual, 2)
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO 2: Try to set a Customer's custom attribute to the value it already has", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("and CustomerAttributeSet", func() {
+					eventStream = append(
+						eventStream,
+						domain.BuildCustomerAttributeSet(customerID, attribute, 2),
+					)
+
+					Convey("When SetCustomerAttribute", func() {
+						recordedEvents, err = customer.SetAttribute(eventStream, setAttribute)
+						So(err, ShouldBeNil)
+
+						Convey("Then no event", func() {
+							So(recordedEvents, ShouldBeEmpty)
+						})
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO 3: Change a Customer's custom attribute to a different value", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("and CustomerAttributeSet", func() {
+					eventStream = append(
+						eventStream,
+						domain.BuildCustomerAttributeSet(customerID, value.RebuildCustomAttribute("vip_level", "1"), 2),
+					)
+
+					Convey("When SetCustomerAttribute", func() {
+						recordedEvents, err = customer.SetAttribute(eventStream, setAttribute)
+						So(err, ShouldBeNil)
+
+						Convey("Then CustomerAttributeSet", func() {
+							So(recordedEvents, ShouldHaveLength, 1)
+							attributeSet, ok := recordedEvents[0].(domain.CustomerAttributeSet)
+							So(ok, ShouldBeTrue)
+							So(attributeSet.Attribute().Equals(attribute), ShouldBeTrue)
+							So(attributeSet.Meta().StreamVersion(), ShouldEqual, 3)
+						})
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO 4: Try to set a Customer's custom attribute when the account was deleted", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("Given CustomerDeleted", func() {
+					eventStream = append(
+						eventStream,
+						domain.BuildCustomerDeleted(customerID, emailAddress, 2),
+					)
+
+					Convey("When SetCustomerAttribute", func() {
+						_, err := customer.SetAttribute(eventStream, setAttribute)
+
+						Convey("Then it should report an error", func() {
+							So(err, ShouldBeError)
+							So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
+						})
+					})
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,29 @@
+package customer
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+)
+
+func SetPassword(eventStream es.EventStream, command domain.SetCustomerPassword) (es.RecordedEvents, error) {
+	customer := buildCurrentStateFrom(eventStream)
+
+	if err := assertNotDeleted(customer); err != nil {
+		return nil, errors.Wrap(err, "setCustomerPassword")
+	}
+
+	if !customer.passwordHash.IsEmpty() {
+		err := errors.New("password was already set, it can only be changed")
+		return nil, shared.MarkAndWrapError(err, shared.ErrDomainConstraintsViolation, "setCustomerPassword")
+	}
+
+	event := domain.BuildCustomerPasswordSet(
+		customer.id,
+		command.PasswordHash(),
+		customer.currentStreamVersion+1,
+	)
+
+	return es.RecordedEvents{event}, nil
+}
@@ -0,0 +1,106 @@
+package customer_test
+
+import (
+	"testing"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSetPassword(t *testing.T) {
+	Convey("Prepare test artifacts", t, func() {
+		var err error
+		var recordedEvents es.RecordedEvents
+
+		customerID := value.GenerateCustomerID()
+		emailAddress := value.RebuildEmailAddress("kevin@ball.com")
+		confirmationHash := value.GenerateConfirmationHash(emailAddress.String())
+		personName := value.RebuildPersonName("Kevin", "Ball")
+		passwordHash := value.RebuildPasswordHash("$2a$12$someBcryptHash")
+
+		customerWasRegistered := domain.BuildCustomerRegistered(
+			customerID,
+			emailAddress,
+			confirmationHash,
+			personName,
+			1,
+		)
+
+		setPassword := domain.BuildSetCustomerPassword(
+			customerID,
+			passwordHash,
+		)
+
+		Convey("\nSCENARIO 1: Set a Customer's password", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("When SetCustomerPassword", func() {
+					recordedEvents, err = customer.SetPassword(eventStream, setPassword)
+					So(err, ShouldBeNil)
+
+					Convey("Then CustomerPasswordSet", func() {
+						So(recordedEvents, ShouldHaveLength, 1)
+						passwordSet, ok := recordedEvents[0].(domain.CustomerPasswordSet)
+						So(ok, ShouldBeTrue)
+						So(passwordSet, ShouldNotBeNil)
+						So(passwordSet.CustomerID().Equals(customerID), ShouldBeTrue)
+						So(passwordSet.PasswordHash().Equals(passwordHash), ShouldBeTrue)
+						So(passwordSet.IsFailureEvent(), ShouldBeFalse)
+						So(passwordSet.FailureReason(), ShouldBeNil)
+						So(passwordSet.Meta().StreamVersion(), ShouldEqual, 2)
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO 2: Try to set a Customer's password when it was already set", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("and CustomerPasswordSet", func() {
+					eventStream = append(
+						eventStream,
+						domain.BuildCustomerPasswordSet(customerID, passwordHash, 2),
+					)
+
+					Convey("When SetCustomerPassword", func() {
+						_, err = customer.SetPassword(eventStream, setPassword)
+
+						Convey("Then it should report an error", func() {
+							So(err, ShouldBeError)
+							So(errors.Is(err, shared.ErrDomainConstraintsViolation), ShouldBeTrue)
+						})
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO 3: Try to set a Customer's password when the account was deleted", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("Given CustomerDeleted", func() {
+					eventStream = append(
+						eventStream,
+						domain.BuildCustomerDeleted(customerID, emailAddress, 2),
+					)
+
+					Convey("When SetCustomerPassword", func() {
+						_, err := customer.SetPassword(eventStream, setPassword)
+
+						Convey("Then it should report an error", func() {
+							So(err, ShouldBeError)
+							So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
+						})
+					})
+				})
+			})
+		})
+	})
+}
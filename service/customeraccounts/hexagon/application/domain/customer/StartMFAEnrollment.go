@@ -0,0 +1,30 @@
+package customer
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+)
+
+func StartMFAEnrollment(eventStream es.EventStream, command domain.StartCustomerMFAEnrollment) (es.RecordedEvents, error) {
+	customer := buildCurrentStateFrom(eventStream)
+
+	if err := assertNotDeleted(customer); err != nil {
+		return nil, errors.Wrap(err, "startCustomerMFAEnrollment")
+	}
+
+	if customer.isMFAEnabled {
+		err := errors.New("MFA is already enabled, it must be disabled first")
+		return nil, shared.MarkAndWrapError(err, shared.ErrDomainConstraintsViolation, "startCustomerMFAEnrollment")
+	}
+
+	event := domain.BuildCustomerMFAEnrollmentStarted(
+		customer.id,
+		command.TOTPSecret(),
+		command.RecoveryCodes(),
+		customer.currentStreamVersion+1,
+	)
+
+	return es.RecordedEvents{event}, nil
+}
@@ -0,0 +1,104 @@
+package customer_test
+
+import (
+	"testing"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStartMFAEnrollment(t *testing.T) {
+	Convey("Prepare test artifacts", t, func() {
+		var err error
+		var recordedEvents es.RecordedEvents
+
+		customerID := value.GenerateCustomerID()
+		emailAddress := value.RebuildEmailAddress("kevin@ball.com")
+		confirmationHash := value.GenerateConfirmationHash(emailAddress.String())
+		personName := value.RebuildPersonName("Kevin", "Ball")
+		totpSecret, _ := value.GenerateTOTPSecret()
+		_, recoveryCodes, _ := value.GenerateRecoveryCodes()
+
+		customerWasRegistered := domain.BuildCustomerRegistered(
+			customerID,
+			emailAddress,
+			confirmationHash,
+			personName,
+			1,
+		)
+
+		mfaEnrollmentWasStarted := domain.BuildCustomerMFAEnrollmentStarted(customerID, totpSecret, recoveryCodes, 2)
+
+		startMFAEnrollment := domain.BuildStartCustomerMFAEnrollment(customerID, totpSecret, recoveryCodes)
+
+		Convey("\nSCENARIO 1: Start a Customer's MFA enrollment", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("When StartCustomerMFAEnrollment", func() {
+					recordedEvents, err = customer.StartMFAEnrollment(eventStream, startMFAEnrollment)
+					So(err, ShouldBeNil)
+
+					Convey("Then CustomerMFAEnrollmentStarted", func() {
+						So(recordedEvents, ShouldHaveLength, 1)
+						enrollmentStarted, ok := recordedEvents[0].(domain.CustomerMFAEnrollmentStarted)
+						So(ok, ShouldBeTrue)
+						So(enrollmentStarted.CustomerID().Equals(customerID), ShouldBeTrue)
+						So(enrollmentStarted.TOTPSecret().Equals(totpSecret), ShouldBeTrue)
+						So(enrollmentStarted.RecoveryCodes(), ShouldResemble, recoveryCodes)
+						So(enrollmentStarted.IsFailureEvent(), ShouldBeFalse)
+						So(enrollmentStarted.FailureReason(), ShouldBeNil)
+						So(enrollmentStarted.Meta().StreamVersion(), ShouldEqual, 2)
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO 2: Try to start a Customer's MFA enrollment when MFA is already enabled", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("and CustomerMFAEnrollmentStarted", func() {
+					eventStream = append(eventStream, mfaEnrollmentWasStarted)
+
+					Convey("and CustomerMFAEnrollmentConfirmed", func() {
+						eventStream = append(eventStream, domain.BuildCustomerMFAEnrollmentConfirmed(customerID, 3))
+
+						Convey("When StartCustomerMFAEnrollment", func() {
+							_, err = customer.StartMFAEnrollment(eventStream, startMFAEnrollment)
+
+							Convey("Then it should report an error", func() {
+								So(err, ShouldBeError)
+								So(errors.Is(err, shared.ErrDomainConstraintsViolation), ShouldBeTrue)
+							})
+						})
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO 3: Try to start a Customer's MFA enrollment when the account was deleted", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("Given CustomerDeleted", func() {
+					eventStream = append(eventStream, domain.BuildCustomerDeleted(customerID, emailAddress, 2))
+
+					Convey("When StartCustomerMFAEnrollment", func() {
+						_, err = customer.StartMFAEnrollment(eventStream, startMFAEnrollment)
+
+						Convey("Then it should report an error", func() {
+							So(err, ShouldBeError)
+							So(errors.Is(err, shared.ErrNotFound), ShouldBeTrue)
+						})
+					})
+				})
+			})
+		})
+	})
+}
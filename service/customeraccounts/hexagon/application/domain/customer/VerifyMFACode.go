@@ -0,0 +1,45 @@
+package customer
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+)
+
+// VerifyMFACode records which TOTP time step or recovery code was used, so that the same code can't be used again.
+func VerifyMFACode(eventStream es.EventStream, command domain.VerifyCustomerMFACode) (es.RecordedEvents, error) {
+	customer := buildCurrentStateFrom(eventStream)
+
+	if err := assertNotDeleted(customer); err != nil {
+		return nil, errors.Wrap(err, "verifyCustomerMFACode")
+	}
+
+	if !customer.isMFAEnabled {
+		err := errors.New("MFA is not enabled")
+		return nil, shared.MarkAndWrapError(err, shared.ErrDomainConstraintsViolation, "verifyCustomerMFACode")
+	}
+
+	timeStep, recoveryCodeHash, err := assertMatchingMFACode(customer, command.Code(), command.VerifiedAt(), true)
+	if err != nil {
+		return nil, errors.Wrap(err, "verifyCustomerMFACode")
+	}
+
+	if recoveryCodeHash == "" {
+		event := domain.BuildCustomerMFACodeUsed(
+			customer.id,
+			timeStep,
+			customer.currentStreamVersion+1,
+		)
+
+		return es.RecordedEvents{event}, nil
+	}
+
+	event := domain.BuildCustomerMFARecoveryCodeUsed(
+		customer.id,
+		recoveryCodeHash,
+		customer.currentStreamVersion+1,
+	)
+
+	return es.RecordedEvents{event}, nil
+}
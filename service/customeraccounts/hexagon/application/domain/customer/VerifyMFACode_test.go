@@ -0,0 +1,141 @@
+package customer_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestVerifyMFACode(t *testing.T) {
+	Convey("Prepare test artifacts", t, func() {
+		var err error
+		var recordedEvents es.RecordedEvents
+
+		customerID := value.GenerateCustomerID()
+		emailAddress := value.RebuildEmailAddress("kevin@ball.com")
+		confirmationHash := value.GenerateConfirmationHash(emailAddress.String())
+		personName := value.RebuildPersonName("Kevin", "Ball")
+		totpSecret, _ := value.GenerateTOTPSecret()
+		plainRecoveryCodes, recoveryCodes, _ := value.GenerateRecoveryCodes()
+		now := time.Now()
+
+		customerWasRegistered := domain.BuildCustomerRegistered(
+			customerID,
+			emailAddress,
+			confirmationHash,
+			personName,
+			1,
+		)
+
+		mfaEnrollmentWasStarted := domain.BuildCustomerMFAEnrollmentStarted(customerID, totpSecret, recoveryCodes, 2)
+
+		mfaEnrollmentWasConfirmed := domain.BuildCustomerMFAEnrollmentConfirmed(customerID, 3)
+
+		Convey("\nSCENARIO 1: Verify a Customer's TOTP code", func() {
+			Convey("Given CustomerRegistered, CustomerMFAEnrollmentStarted and CustomerMFAEnrollmentConfirmed", func() {
+				eventStream := es.EventStream{customerWasRegistered, mfaEnrollmentWasStarted, mfaEnrollmentWasConfirmed}
+
+				Convey("When VerifyCustomerMFACode with a valid TOTP code", func() {
+					verifyMFACode := domain.BuildVerifyCustomerMFACode(customerID, totpSecret.CodeAt(now), now)
+					recordedEvents, err = customer.VerifyMFACode(eventStream, verifyMFACode)
+					So(err, ShouldBeNil)
+
+					Convey("Then CustomerMFACodeUsed", func() {
+						So(recordedEvents, ShouldHaveLength, 1)
+						codeUsed, ok := recordedEvents[0].(domain.CustomerMFACodeUsed)
+						So(ok, ShouldBeTrue)
+						So(codeUsed.CustomerID().Equals(customerID), ShouldBeTrue)
+						So(codeUsed.TimeStep().String(), ShouldEqual, strconv.FormatInt(now.Unix()/30, 10))
+						So(codeUsed.Meta().StreamVersion(), ShouldEqual, 4)
+
+						Convey("And when the same TOTP code is verified again", func() {
+							eventStream = append(eventStream, codeUsed)
+							_, err = customer.VerifyMFACode(eventStream, verifyMFACode)
+
+							Convey("Then it should report an error", func() {
+								So(err, ShouldBeError)
+								So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+							})
+						})
+
+						Convey("And when the TOTP code of the previous time step is verified", func() {
+							eventStream = append(eventStream, codeUsed)
+							previousCode := domain.BuildVerifyCustomerMFACode(customerID, totpSecret.CodeAt(now.Add(-30*time.Second)), now)
+							_, err = customer.VerifyMFACode(eventStream, previousCode)
+
+							Convey("Then it should report an error", func() {
+								So(err, ShouldBeError)
+								So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+							})
+						})
+					})
+				})
+
+				Convey("When VerifyCustomerMFACode with a wrong code", func() {
+					verifyMFACode := domain.BuildVerifyCustomerMFACode(customerID, "000000-wrong", now)
+					_, err = customer.VerifyMFACode(eventStream, verifyMFACode)
+
+					Convey("Then it should report an error", func() {
+						So(err, ShouldBeError)
+						So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO 2: Verify a Customer's recovery code", func() {
+			Convey("Given CustomerRegistered, CustomerMFAEnrollmentStarted and CustomerMFAEnrollmentConfirmed", func() {
+				eventStream := es.EventStream{customerWasRegistered, mfaEnrollmentWasStarted, mfaEnrollmentWasConfirmed}
+				verifyMFACode := domain.BuildVerifyCustomerMFACode(customerID, plainRecoveryCodes[2], now)
+
+				Convey("When VerifyCustomerMFACode with an unused recovery code", func() {
+					recordedEvents, err = customer.VerifyMFACode(eventStream, verifyMFACode)
+					So(err, ShouldBeNil)
+
+					Convey("Then CustomerMFARecoveryCodeUsed", func() {
+						So(recordedEvents, ShouldHaveLength, 1)
+						recoveryCodeUsed, ok := recordedEvents[0].(domain.CustomerMFARecoveryCodeUsed)
+						So(ok, ShouldBeTrue)
+						So(recoveryCodeUsed.CustomerID().Equals(customerID), ShouldBeTrue)
+						So(recoveryCodeUsed.RecoveryCodeHash(), ShouldEqual, recoveryCodes.Hashes()[2])
+						So(recoveryCodeUsed.Meta().StreamVersion(), ShouldEqual, 4)
+
+						Convey("And when the same recovery code is verified again", func() {
+							eventStream = append(eventStream, recoveryCodeUsed)
+							_, err = customer.VerifyMFACode(eventStream, verifyMFACode)
+
+							Convey("Then it should report an error", func() {
+								So(err, ShouldBeError)
+								So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+							})
+						})
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO 3: Try to verify a code when MFA is not enabled", func() {
+			Convey("Given CustomerRegistered and CustomerMFAEnrollmentStarted", func() {
+				eventStream := es.EventStream{customerWasRegistered, mfaEnrollmentWasStarted}
+
+				Convey("When VerifyCustomerMFACode", func() {
+					verifyMFACode := domain.BuildVerifyCustomerMFACode(customerID, totpSecret.CodeAt(now), now)
+					_, err = customer.VerifyMFACode(eventStream, verifyMFACode)
+
+					Convey("Then it should report an error", func() {
+						So(err, ShouldBeError)
+						So(errors.Is(err, shared.ErrDomainConstraintsViolation), ShouldBeTrue)
+					})
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,27 @@
+package customer
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+)
+
+// VerifyPassword reports every failure as ErrInvalidCredentials, so that callers can't tell a deleted customer
+// or a customer without password apart from a wrong password. It always compares the password,
+// so all failures take as long as a wrong password.
+func VerifyPassword(eventStream es.EventStream, password string) error {
+	customer := buildCurrentStateFrom(eventStream)
+
+	passwordErr := assertMatchingPassword(customer.passwordHash, password)
+
+	if customer.isDeleted {
+		err := errors.New("invalid credentials supplied")
+		return shared.MarkAndWrapError(err, shared.ErrInvalidCredentials, "verifyCustomerPassword")
+	}
+
+	if passwordErr != nil {
+		return errors.Wrap(passwordErr, "verifyCustomerPassword")
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package customer_test
+
+import (
+	"testing"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestVerifyPassword(t *testing.T) {
+	password, _ := value.BuildPlainPassword("Correct-Horse-1")
+	passwordHash, _ := value.GeneratePasswordHash(password)
+
+	Convey("Prepare test artifacts", t, func() {
+		customerID := value.GenerateCustomerID()
+		emailAddress := value.RebuildEmailAddress("kevin@ball.com")
+		confirmationHash := value.GenerateConfirmationHash(emailAddress.String())
+		personName := value.RebuildPersonName("Kevin", "Ball")
+
+		customerWasRegistered := domain.BuildCustomerRegistered(
+			customerID,
+			emailAddress,
+			confirmationHash,
+			personName,
+			1,
+		)
+
+		passwordWasSet := domain.BuildCustomerPasswordSet(customerID, passwordHash, 2)
+
+		Convey("\nSCENARIO 1: Verify a Customer's password", func() {
+			Convey("Given CustomerRegistered and CustomerPasswordSet", func() {
+				eventStream := es.EventStream{customerWasRegistered, passwordWasSet}
+
+				Convey("When the matching password is verified", func() {
+					err := customer.VerifyPassword(eventStream, password.String())
+
+					Convey("Then it should succeed", func() {
+						So(err, ShouldBeNil)
+					})
+				})
+
+				Convey("When a wrong password is verified", func() {
+					err := customer.VerifyPassword(eventStream, "Wrong-Horse-1")
+
+					Convey("Then it should report an error", func() {
+						So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO 2: Verify the password of a Customer who never set one", func() {
+			Convey("Given CustomerRegistered", func() {
+				eventStream := es.EventStream{customerWasRegistered}
+
+				Convey("When a password is verified", func() {
+					err := customer.VerifyPassword(eventStream, password.String())
+
+					Convey("Then it should report an error", func() {
+						So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+					})
+				})
+			})
+		})
+
+		Convey("\nSCENARIO 3: Verify the password of a deleted Customer", func() {
+			Convey("Given CustomerRegistered, CustomerPasswordSet and CustomerDeleted", func() {
+				eventStream := es.EventStream{
+					customerWasRegistered,
+					passwordWasSet,
+					domain.BuildCustomerDeleted(customerID, emailAddress, 3),
+				}
+
+				Convey("When the matching password is verified", func() {
+					err := customer.VerifyPassword(eventStream, password.String())
+
+					Convey("Then it should report an error", func() {
+						So(errors.Is(err, shared.ErrInvalidCredentials), ShouldBeTrue)
+						So(errors.Is(err, shared.ErrNotFound), ShouldBeFalse)
+					})
+				})
+			})
+		})
+	})
+}
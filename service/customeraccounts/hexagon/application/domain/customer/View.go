@@ -10,6 +10,8 @@ type View struct {
 	IsEmailAddressConfirmed bool
 	GivenName               string
 	FamilyName              string
+	CustomAttributes        map[string]string
+	IsMFAEnabled            bool
 	IsDeleted               bool
 	Version                 uint
 }
@@ -23,9 +25,18 @@ func BuildViewFrom(eventStream es.EventStream) View {
 		IsEmailAddressConfirmed: customer.isEmailAddressConfirmed,
 		GivenName:               customer.personName.GivenName(),
 		FamilyName:              customer.personName.FamilyName(),
+		IsMFAEnabled:            customer.isMFAEnabled,
 		IsDeleted:               customer.isDeleted,
 		Version:                 customer.currentStreamVersion,
 	}
 
+	for name, attribute := range customer.customAttributes {
+		if customerView.CustomAttributes == nil {
+			customerView.CustomAttributes = make(map[string]string)
+		}
+
+		customerView.CustomAttributes[name] = attribute.Value()
+	}
+
 	return customerView
 }
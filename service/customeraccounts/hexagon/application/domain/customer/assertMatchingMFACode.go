@@ -0,0 +1,31 @@
+package customer
+
+import (
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+// assertMatchingMFACode accepts a TOTP code from a time step after the last used one or, if allowed, an unused recovery code.
+// The time step of a TOTP code or the digest of a recovery code is returned so that it can be marked as used.
+func assertMatchingMFACode(
+	currentState currentState,
+	code string,
+	at time.Time,
+	allowRecoveryCodes bool,
+) (value.TOTPTimeStep, string, error) {
+
+	if timeStep, ok := currentState.totpSecret.Verifies(code, at, currentState.lastUsedTOTPTimeStep); ok {
+		return timeStep, "", nil
+	}
+
+	if allowRecoveryCodes {
+		if recoveryCodeHash, ok := currentState.recoveryCodes.Match(code); ok {
+			return value.TOTPTimeStep{}, recoveryCodeHash, nil
+		}
+	}
+
+	return value.TOTPTimeStep{}, "", errors.Mark(errors.New("invalid MFA code supplied"), shared.ErrInvalidCredentials)
+}
@@ -0,0 +1,15 @@
+package customer
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+func assertMatchingPassword(current value.PasswordHash, supplied string) error {
+	if !current.Matches(supplied) {
+		return errors.Mark(errors.New("invalid credentials supplied"), shared.ErrInvalidCredentials)
+	}
+
+	return nil
+}
@@ -12,6 +12,14 @@ type currentState struct {
 	emailAddress                 value.EmailAddress
 	emailAddressConfirmationHash value.ConfirmationHash
 	isEmailAddressConfirmed      bool
+	customAttributes             map[string]value.CustomAttribute
+	passwordHash                 value.PasswordHash
+	accountRecoveryToken         value.AccountRecoveryToken
+	totpSecret                   value.TOTPSecret
+	recoveryCodes                value.RecoveryCodes
+	lastUsedTOTPTimeStep         value.TOTPTimeStep
+	isMFAEnrollmentPending       bool
+	isMFAEnabled                 bool
 	isDeleted                    bool
 	currentStreamVersion         uint
 }
@@ -34,6 +42,42 @@ func buildCurrentStateFrom(eventStream es.EventStream) currentState {
 			customer.isEmailAddressConfirmed = false
 		case domain.CustomerNameChanged:
 			customer.personName = actualEvent.PersonName()
+		case domain.CustomerAttributeSet:
+			if customer.customAttributes == nil {
+				customer.customAttributes = make(map[string]value.CustomAttribute)
+			}
+
+			customer.customAttributes[actualEvent.Attribute().Name()] = actualEvent.Attribute()
+		case domain.CustomerPasswordSet:
+			customer.passwordHash = actualEvent.PasswordHash()
+			customer.accountRecoveryToken = value.AccountRecoveryToken{}
+		case domain.CustomerPasswordChanged:
+			customer.passwordHash = actualEvent.PasswordHash()
+			customer.accountRecoveryToken = value.AccountRecoveryToken{}
+		case domain.CustomerAccountRecoveryRequested:
+			customer.accountRecoveryToken = actualEvent.RecoveryToken()
+		case domain.CustomerAccountRecovered:
+			customer.passwordHash = actualEvent.PasswordHash()
+			customer.accountRecoveryToken = value.AccountRecoveryToken{}
+		case domain.CustomerMFAEnrollmentStarted:
+			customer.totpSecret = actualEvent.TOTPSecret()
+			customer.recoveryCodes = actualEvent.RecoveryCodes()
+			customer.lastUsedTOTPTimeStep = value.TOTPTimeStep{}
+			customer.isMFAEnrollmentPending = true
+			customer.isMFAEnabled = false
+		case domain.CustomerMFAEnrollmentConfirmed:
+			customer.isMFAEnrollmentPending = false
+			customer.isMFAEnabled = true
+		case domain.CustomerMFACodeUsed:
+			customer.lastUsedTOTPTimeStep = actualEvent.TimeStep()
+		case domain.CustomerMFARecoveryCodeUsed:
+			customer.recoveryCodes = customer.recoveryCodes.Without(actualEvent.RecoveryCodeHash())
+		case domain.CustomerMFADisabled:
+			customer.totpSecret = value.TOTPSecret{}
+			customer.recoveryCodes = value.RecoveryCodes{}
+			customer.lastUsedTOTPTimeStep = value.TOTPTimeStep{}
+			customer.isMFAEnrollmentPending = false
+			customer.isMFAEnabled = false
 		case domain.CustomerDeleted:
 			customer.isDeleted = true
 		}
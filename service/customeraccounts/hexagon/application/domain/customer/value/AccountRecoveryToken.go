@@ -0,0 +1,73 @@
+package value
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+)
+
+const accountRecoveryTokenTTL = 30 * time.Minute
+
+// AccountRecoveryToken only holds a sha256 digest, the plain token is sent to the Customer exactly once.
+type AccountRecoveryToken struct {
+	digest    string
+	expiresAt time.Time
+}
+
+func GenerateAccountRecoveryToken(issuedAt time.Time) (string, AccountRecoveryToken, error) {
+	random := make([]byte, 32)
+
+	if _, err := rand.Read(random); err != nil {
+		return "", AccountRecoveryToken{}, shared.MarkAndWrapError(err, shared.ErrTechnical, "GenerateAccountRecoveryToken")
+	}
+
+	plainToken := base64.RawURLEncoding.EncodeToString(random)
+
+	token := AccountRecoveryToken{
+		digest:    hashAccountRecoveryToken(plainToken),
+		expiresAt: issuedAt.Add(accountRecoveryTokenTTL).UTC(),
+	}
+
+	return plainToken, token, nil
+}
+
+func RebuildAccountRecoveryToken(digest string, expiresAt time.Time) AccountRecoveryToken {
+	return AccountRecoveryToken{
+		digest:    digest,
+		expiresAt: expiresAt,
+	}
+}
+
+func (token AccountRecoveryToken) Digest() string {
+	return token.digest
+}
+
+func (token AccountRecoveryToken) ExpiresAt() time.Time {
+	return token.expiresAt
+}
+
+func (token AccountRecoveryToken) IsEmpty() bool {
+	return token.digest == ""
+}
+
+// IsValidFor reports whether the plain token matches and was not expired at the given time.
+func (token AccountRecoveryToken) IsValidFor(plainToken string, at time.Time) bool {
+	if token.IsEmpty() || !at.Before(token.expiresAt) {
+		return false
+	}
+
+	supplied := hashAccountRecoveryToken(plainToken)
+
+	return subtle.ConstantTimeCompare([]byte(token.digest), []byte(supplied)) == 1
+}
+
+func hashAccountRecoveryToken(plainToken string) string {
+	sha256Sum := sha256.Sum256([]byte(plainToken))
+
+	return hex.EncodeToString(sha256Sum[:])
+}
@@ -0,0 +1,35 @@
+package value
+
+type CustomAttribute struct {
+	name  string
+	value string
+}
+
+func RebuildCustomAttribute(name string, value string) CustomAttribute {
+	attribute := CustomAttribute{
+		name:  name,
+		value: value,
+	}
+
+	return attribute
+}
+
+func (attribute CustomAttribute) Name() string {
+	return attribute.name
+}
+
+func (attribute CustomAttribute) Value() string {
+	return attribute.value
+}
+
+func (attribute CustomAttribute) Equals(other CustomAttribute) bool {
+	if attribute.Name() != other.Name() {
+		return false
+	}
+
+	if attribute.Value() != other.Value() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,135 @@
+package value
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	CustomAttributeTypeString  = "string"
+	CustomAttributeTypeInteger = "integer"
+	CustomAttributeTypeBoolean = "boolean"
+)
+
+var (
+	customAttributeNameRegExp = regexp.MustCompile(`^[a-z][a-z0-9_]{0,62}$`)
+)
+
+type CustomAttributeDefinition struct {
+	name              string
+	attributeType     string
+	validationPattern string
+}
+
+func BuildCustomAttributeDefinition(
+	name string,
+	attributeType string,
+	validationPattern string,
+) (CustomAttributeDefinition, error) {
+
+	wrapWithMsg := "BuildCustomAttributeDefinition"
+
+	if matched := customAttributeNameRegExp.MatchString(name); !matched {
+		err := errors.New("input has invalid format for name")
+		err = shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+
+		return CustomAttributeDefinition{}, err
+	}
+
+	switch attributeType {
+	case CustomAttributeTypeString, CustomAttributeTypeInteger, CustomAttributeTypeBoolean:
+	default:
+		err := errors.Newf("unsupported attributeType [%s]", attributeType)
+		err = shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+
+		return CustomAttributeDefinition{}, err
+	}
+
+	if validationPattern != "" {
+		if _, err := regexp.Compile(validationPattern); err != nil {
+			err = shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+
+			return CustomAttributeDefinition{}, err
+		}
+	}
+
+	definition := CustomAttributeDefinition{
+		name:              name,
+		attributeType:     attributeType,
+		validationPattern: validationPattern,
+	}
+
+	return definition, nil
+}
+
+func RebuildCustomAttributeDefinition(
+	name string,
+	attributeType string,
+	validationPattern string,
+) CustomAttributeDefinition {
+
+	definition := CustomAttributeDefinition{
+		name:              name,
+		attributeType:     attributeType,
+		validationPattern: validationPattern,
+	}
+
+	return definition
+}
+
+func (definition CustomAttributeDefinition) Name() string {
+	return definition.name
+}
+
+func (definition CustomAttributeDefinition) AttributeType() string {
+	return definition.attributeType
+}
+
+func (definition CustomAttributeDefinition) ValidationPattern() string {
+	return definition.validationPattern
+}
+
+// BuildAttribute validates the input against this definition and returns the attribute with its value
+// in canonical form, e.g. "007" for an integer attribute becomes "7" and "TRUE" for a boolean becomes "true".
+func (definition CustomAttributeDefinition) BuildAttribute(input string) (CustomAttribute, error) {
+	var canonicalValue string
+	wrapWithMsg := "BuildAttribute"
+
+	switch definition.attributeType {
+	case CustomAttributeTypeInteger:
+		intValue, err := strconv.ParseInt(input, 10, 64)
+		if err != nil {
+			err = errors.Newf("input for [%s] is not an integer", definition.name)
+			return CustomAttribute{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+		}
+
+		canonicalValue = strconv.FormatInt(intValue, 10)
+	case CustomAttributeTypeBoolean:
+		boolValue, err := strconv.ParseBool(input)
+		if err != nil {
+			err = errors.Newf("input for [%s] is not a boolean", definition.name)
+			return CustomAttribute{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+		}
+
+		canonicalValue = strconv.FormatBool(boolValue)
+	default:
+		canonicalValue = input
+	}
+
+	if definition.validationPattern != "" {
+		if matched, _ := regexp.MatchString(definition.validationPattern, canonicalValue); !matched {
+			err := errors.Newf("input for [%s] does not match the validation pattern", definition.name)
+			return CustomAttribute{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+		}
+	}
+
+	attribute := CustomAttribute{
+		name:  definition.name,
+		value: canonicalValue,
+	}
+
+	return attribute, nil
+}
@@ -0,0 +1,99 @@
+package value_test
+
+import (
+	"testing"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBuildCustomAttributeDefinition(t *testing.T) {
+	Convey("When a CustomAttributeDefinition is built with a valid name and type", t, func() {
+		definition, err := value.BuildCustomAttributeDefinition("beta_tester", value.CustomAttributeTypeBoolean, "")
+
+		Convey("Then it should succeed", func() {
+			So(err, ShouldBeNil)
+			So(definition.Name(), ShouldEqual, "beta_tester")
+			So(definition.AttributeType(), ShouldEqual, value.CustomAttributeTypeBoolean)
+		})
+	})
+
+	Convey("When a CustomAttributeDefinition is built with an invalid name", t, func() {
+		_, err := value.BuildCustomAttributeDefinition("Beta Tester", value.CustomAttributeTypeBoolean, "")
+
+		Convey("Then it should fail", func() {
+			So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+		})
+	})
+
+	Convey("When a CustomAttributeDefinition is built with an unsupported type", t, func() {
+		_, err := value.BuildCustomAttributeDefinition("vip_level", "float", "")
+
+		Convey("Then it should fail", func() {
+			So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+		})
+	})
+
+	Convey("When a CustomAttributeDefinition is built with an invalid validation pattern", t, func() {
+		_, err := value.BuildCustomAttributeDefinition("vip_level", value.CustomAttributeTypeInteger, "[0-")
+
+		Convey("Then it should fail", func() {
+			So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+		})
+	})
+}
+
+func TestCustomAttributeDefinition_BuildAttribute(t *testing.T) {
+	Convey("Given an integer CustomAttributeDefinition with a validation pattern", t, func() {
+		definition := value.RebuildCustomAttributeDefinition("vip_level", value.CustomAttributeTypeInteger, `^[1-5]$`)
+
+		Convey("When an attribute is built from a matching input", func() {
+			attribute, err := definition.BuildAttribute("03")
+
+			Convey("Then it should contain the canonical value", func() {
+				So(err, ShouldBeNil)
+				So(attribute.Name(), ShouldEqual, "vip_level")
+				So(attribute.Value(), ShouldEqual, "3")
+			})
+		})
+
+		Convey("When an attribute is built from a non-integer input", func() {
+			_, err := definition.BuildAttribute("gold")
+
+			Convey("Then it should fail", func() {
+				So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+			})
+		})
+
+		Convey("When an attribute is built from an input not matching the validation pattern", func() {
+			_, err := definition.BuildAttribute("9")
+
+			Convey("Then it should fail", func() {
+				So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+			})
+		})
+	})
+
+	Convey("Given a boolean CustomAttributeDefinition", t, func() {
+		definition := value.RebuildCustomAttributeDefinition("beta_tester", value.CustomAttributeTypeBoolean, "")
+
+		Convey("When an attribute is built from a boolean input", func() {
+			attribute, err := definition.BuildAttribute("TRUE")
+
+			Convey("Then it should contain the canonical value", func() {
+				So(err, ShouldBeNil)
+				So(attribute.Value(), ShouldEqual, "true")
+			})
+		})
+
+		Convey("When an attribute is built from a non-boolean input", func() {
+			_, err := definition.BuildAttribute("maybe")
+
+			Convey("Then it should fail", func() {
+				So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+			})
+		})
+	})
+}
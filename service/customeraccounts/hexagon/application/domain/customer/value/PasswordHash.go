@@ -0,0 +1,53 @@
+package value
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const passwordHashCost = 12
+
+// unknownPasswordHash is compared with when there is no password hash, so that this takes as long as a real comparison.
+const unknownPasswordHash = "$2a$12$rXnfuhGV6AaYkhkzWWnrleNm4Ox78gWG9R4aA1k5OTHUJObeRr0/u"
+
+type PasswordHash struct {
+	value string
+}
+
+func GeneratePasswordHash(from PlainPassword) (PasswordHash, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(from.String()), passwordHashCost)
+	if err != nil {
+		return PasswordHash{}, shared.MarkAndWrapError(err, shared.ErrTechnical, "GeneratePasswordHash")
+	}
+
+	return PasswordHash{value: string(hash)}, nil
+}
+
+func RebuildPasswordHash(input string) PasswordHash {
+	return PasswordHash{value: input}
+}
+
+func (passwordHash PasswordHash) String() string {
+	return passwordHash.value
+}
+
+func (passwordHash PasswordHash) IsEmpty() bool {
+	return passwordHash.value == ""
+}
+
+// Matches compares in constant time, an empty PasswordHash never matches but takes as long as any other.
+func (passwordHash PasswordHash) Matches(password string) bool {
+	if passwordHash.IsEmpty() {
+		_ = bcrypt.CompareHashAndPassword([]byte(unknownPasswordHash), []byte(password))
+
+		return false
+	}
+
+	err := bcrypt.CompareHashAndPassword([]byte(passwordHash.value), []byte(password))
+
+	return err == nil
+}
+
+func (passwordHash PasswordHash) Equals(other PasswordHash) bool {
+	return passwordHash.value == other.value
+}
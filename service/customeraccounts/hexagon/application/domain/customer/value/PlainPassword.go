@@ -0,0 +1,55 @@
+package value
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	plainPasswordMinLength = 10
+	plainPasswordMaxBytes  = 72 // bcrypt ignores everything beyond 72 bytes
+)
+
+type PlainPassword struct {
+	value string
+}
+
+func BuildPlainPassword(input string) (PlainPassword, error) {
+	wrapWithMsg := "BuildPlainPassword"
+
+	if utf8.RuneCountInString(input) < plainPasswordMinLength {
+		err := errors.Newf("password must have at least %d characters", plainPasswordMinLength)
+		return PlainPassword{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	if len(input) > plainPasswordMaxBytes {
+		err := errors.Newf("password must not be longer than %d bytes", plainPasswordMaxBytes)
+		return PlainPassword{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	var hasLetter, hasNonLetter bool
+
+	for _, r := range input {
+		if unicode.IsLetter(r) {
+			hasLetter = true
+		} else {
+			hasNonLetter = true
+		}
+	}
+
+	if !hasLetter || !hasNonLetter {
+		err := errors.New("password must contain letters and at least one digit or symbol")
+		return PlainPassword{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	plainPassword := PlainPassword{value: input}
+
+	return plainPassword, nil
+}
+
+func (plainPassword PlainPassword) String() string {
+	return plainPassword.value
+}
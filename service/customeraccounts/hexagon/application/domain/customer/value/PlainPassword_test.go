@@ -0,0 +1,33 @@
+package value_test
+
+import (
+	"testing"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBuildPlainPassword(t *testing.T) {
+	Convey("When a PlainPassword is built from input matching the policy", t, func() {
+		plainPassword, err := value.BuildPlainPassword("Correct-Horse-1")
+
+		Convey("Then it should succeed", func() {
+			So(err, ShouldBeNil)
+			So(plainPassword.String(), ShouldEqual, "Correct-Horse-1")
+		})
+	})
+
+	for _, input := range []string{"Short-1", "onlylettersandnothingelse", "1234567890123", string(make([]byte, 73))} {
+		invalidInput := input
+
+		Convey("When a PlainPassword is built from input violating the policy", t, func() {
+			_, err := value.BuildPlainPassword(invalidInput)
+
+			Convey("Then it should fail", func() {
+				So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+			})
+		})
+	}
+}
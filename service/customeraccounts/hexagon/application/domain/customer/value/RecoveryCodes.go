@@ -0,0 +1,84 @@
+package value
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+)
+
+const numberOfRecoveryCodes = 10
+
+// RecoveryCodes only holds sha256 digests, the plain codes are shown to the Customer exactly once.
+type RecoveryCodes struct {
+	hashes []string
+}
+
+func GenerateRecoveryCodes() ([]string, RecoveryCodes, error) {
+	plainCodes := make([]string, 0, numberOfRecoveryCodes)
+	hashes := make([]string, 0, numberOfRecoveryCodes)
+
+	for i := 0; i < numberOfRecoveryCodes; i++ {
+		random := make([]byte, 5)
+
+		if _, err := rand.Read(random); err != nil {
+			return nil, RecoveryCodes{}, shared.MarkAndWrapError(err, shared.ErrTechnical, "GenerateRecoveryCodes")
+		}
+
+		encoded := hex.EncodeToString(random)
+		plainCode := fmt.Sprintf("%s-%s", encoded[:5], encoded[5:])
+
+		plainCodes = append(plainCodes, plainCode)
+		hashes = append(hashes, hashRecoveryCode(plainCode))
+	}
+
+	return plainCodes, RecoveryCodes{hashes: hashes}, nil
+}
+
+func RebuildRecoveryCodes(hashes []string) RecoveryCodes {
+	return RecoveryCodes{hashes: hashes}
+}
+
+func (codes RecoveryCodes) Hashes() []string {
+	return codes.hashes
+}
+
+// Match returns the digest of the matching (unused) recovery code.
+func (codes RecoveryCodes) Match(code string) (string, bool) {
+	supplied := hashRecoveryCode(code)
+
+	for _, hash := range codes.hashes {
+		if subtle.ConstantTimeCompare([]byte(hash), []byte(supplied)) == 1 {
+			return hash, true
+		}
+	}
+
+	return "", false
+}
+
+func (codes RecoveryCodes) Without(hash string) RecoveryCodes {
+	remaining := make([]string, 0, len(codes.hashes))
+
+	for _, existing := range codes.hashes {
+		if existing != hash {
+			remaining = append(remaining, existing)
+		}
+	}
+
+	return RecoveryCodes{hashes: remaining}
+}
+
+func (codes RecoveryCodes) Remaining() int {
+	return len(codes.hashes)
+}
+
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sha256Sum := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(sha256Sum[:])
+}
@@ -0,0 +1,81 @@
+package value_test
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTOTPSecret_Verifies(t *testing.T) {
+	Convey("Given the TOTPSecret from the RFC 6238 test vectors", t, func() {
+		encoded := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+		secret := value.RebuildTOTPSecret(encoded)
+
+		Convey("When codes are calculated for the RFC 6238 test times", func() {
+			Convey("Then they should match the (6 digit) test vectors", func() {
+				So(secret.CodeAt(time.Unix(59, 0)), ShouldEqual, "287082")
+				So(secret.CodeAt(time.Unix(1111111109, 0)), ShouldEqual, "081804")
+				So(secret.CodeAt(time.Unix(1234567890, 0)), ShouldEqual, "005924")
+				So(secret.CodeAt(time.Unix(2000000000, 0)), ShouldEqual, "279037")
+			})
+		})
+
+		Convey("When a code from the previous time step is verified", func() {
+			now := time.Unix(1111111109, 0)
+			step, isValid := secret.Verifies(secret.CodeAt(now.Add(-30*time.Second)), now, value.TOTPTimeStep{})
+
+			Convey("Then it should be accepted", func() {
+				So(isValid, ShouldBeTrue)
+				So(step.String(), ShouldEqual, "37037035")
+			})
+
+			Convey("And when it is verified again with its time step as the last used one", func() {
+				_, isValid = secret.Verifies(secret.CodeAt(now.Add(-30*time.Second)), now, step)
+
+				Convey("Then it should be rejected", func() {
+					So(isValid, ShouldBeFalse)
+				})
+			})
+		})
+
+		Convey("When a code from two time steps ago is verified", func() {
+			now := time.Unix(1111111109, 0)
+			_, isValid := secret.Verifies(secret.CodeAt(now.Add(-60*time.Second)), now, value.TOTPTimeStep{})
+
+			Convey("Then it should be rejected", func() {
+				So(isValid, ShouldBeFalse)
+			})
+		})
+	})
+}
+
+func TestRecoveryCodes_Match(t *testing.T) {
+	Convey("Given generated RecoveryCodes", t, func() {
+		plainCodes, recoveryCodes, err := value.GenerateRecoveryCodes()
+		So(err, ShouldBeNil)
+		So(plainCodes, ShouldHaveLength, 10)
+		So(recoveryCodes.Remaining(), ShouldEqual, 10)
+
+		Convey("When one of the plain codes is matched", func() {
+			hash, ok := recoveryCodes.Match(plainCodes[3])
+
+			Convey("Then it should match and can be removed", func() {
+				So(ok, ShouldBeTrue)
+				So(recoveryCodes.Without(hash).Remaining(), ShouldEqual, 9)
+				_, ok = recoveryCodes.Without(hash).Match(plainCodes[3])
+				So(ok, ShouldBeFalse)
+			})
+		})
+
+		Convey("When an unknown code is matched", func() {
+			_, ok := recoveryCodes.Match("00000-00000")
+
+			Convey("Then it should not match", func() {
+				So(ok, ShouldBeFalse)
+			})
+		})
+	})
+}
@@ -0,0 +1,24 @@
+package value
+
+import (
+	"strconv"
+)
+
+// TOTPTimeStep is the RFC 6238 time step of a TOTP code, the zero value means that no code was used yet.
+type TOTPTimeStep struct {
+	value uint64
+}
+
+func RebuildTOTPTimeStep(input string) TOTPTimeStep {
+	step, _ := strconv.ParseUint(input, 10, 64)
+
+	return TOTPTimeStep{value: step}
+}
+
+func (step TOTPTimeStep) String() string {
+	return strconv.FormatUint(step.value, 10)
+}
+
+func (step TOTPTimeStep) IsAfter(other TOTPTimeStep) bool {
+	return step.value > other.value
+}
@@ -0,0 +1,177 @@
+package eventdump
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+// ChecksumsFileName is the manifest of a backup directory, in the format of sha256sum, so it can be checked with `sha256sum -c`.
+const ChecksumsFileName = "SHA256SUMS"
+
+// ValidateStream asserts that all Records belong to the same stream and that the versions start at 1 without gaps.
+func ValidateStream(records []Record) error {
+	wrapWithMsg := "ValidateStream"
+
+	for idx, record := range records {
+		if record.StreamID != records[0].StreamID {
+			err := errors.Newf("stream [%s] contains an event of stream [%s]", records[0].StreamID, record.StreamID)
+			return shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+		}
+
+		if record.StreamVersion != uint(idx+1) {
+			err := errors.Newf("stream [%s] has version %d where version %d was expected", record.StreamID, record.StreamVersion, idx+1)
+			return shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+		}
+	}
+
+	return nil
+}
+
+// BackupDirectory writes NDJSON files into a directory and records their checksums in ChecksumsFileName on Close.
+type BackupDirectory struct {
+	path      string
+	checksums map[string]string
+}
+
+func NewBackupDirectory(path string) (*BackupDirectory, error) {
+	if err := os.MkdirAll(path, 0700); err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, "NewBackupDirectory")
+	}
+
+	return &BackupDirectory{path: path, checksums: make(map[string]string)}, nil
+}
+
+// WriteFile writes all Records to the file with the given name, replacing an existing file.
+func (d *BackupDirectory) WriteFile(name string, write func(records *RecordWriter) error) error {
+	wrapWithMsg := "backupDirectory.WriteFile"
+
+	file, err := os.OpenFile(filepath.Join(d.path, name), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	checksum := sha256.New()
+	buffered := bufio.NewWriter(io.MultiWriter(file, checksum))
+
+	err = write(NewRecordWriter(buffered))
+
+	if err == nil {
+		err = buffered.Flush()
+	}
+
+	if closeErr := file.Close(); err == nil && closeErr != nil {
+		err = shared.MarkAndWrapError(closeErr, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	d.checksums[name] = hex.EncodeToString(checksum.Sum(nil))
+
+	return nil
+}
+
+func (d *BackupDirectory) Close() error {
+	names := make([]string, 0, len(d.checksums))
+	for name := range d.checksums {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var manifest strings.Builder
+	for _, name := range names {
+		manifest.WriteString(fmt.Sprintf("%s  %s\n", d.checksums[name], name))
+	}
+
+	if err := writeFileAtomically(filepath.Join(d.path, ChecksumsFileName), manifest.String()); err != nil {
+		return errors.Wrap(err, "backupDirectory.Close")
+	}
+
+	return nil
+}
+
+// ReadBackupDirectory verifies all files listed in ChecksumsFileName and returns their names in order.
+// Files which are not listed are ignored, so a backup which was interrupted before Close can't be read.
+func ReadBackupDirectory(path string) ([]string, error) {
+	wrapWithMsg := "ReadBackupDirectory"
+
+	manifest, err := os.Open(filepath.Join(path, ChecksumsFileName))
+	if err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	defer manifest.Close()
+
+	var names []string
+	scanner := bufio.NewScanner(manifest)
+
+	for scanner.Scan() {
+		fields := strings.SplitN(scanner.Text(), "  ", 2)
+
+		if len(fields) != 2 || filepath.Base(fields[1]) != fields[1] {
+			err = errors.Newf("invalid line in %s: %q", ChecksumsFileName, scanner.Text())
+			return nil, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+		}
+
+		if err = verifyChecksum(filepath.Join(path, fields[1]), fields[0]); err != nil {
+			return nil, errors.Wrap(err, wrapWithMsg)
+		}
+
+		names = append(names, fields[1])
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	return names, nil
+}
+
+func verifyChecksum(path string, expected string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, "verifyChecksum")
+	}
+
+	defer file.Close()
+
+	checksum := sha256.New()
+
+	if _, err = io.Copy(checksum, file); err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, "verifyChecksum")
+	}
+
+	if actual := hex.EncodeToString(checksum.Sum(nil)); actual != expected {
+		err = errors.Newf("checksum of %s is %s but %s was expected", filepath.Base(path), actual, expected)
+		return shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, "verifyChecksum")
+	}
+
+	return nil
+}
+
+func writeFileAtomically(path string, content string) error {
+	temporaryPath := path + ".tmp"
+
+	if err := ioutil.WriteFile(temporaryPath, []byte(content), 0600); err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, "writeFileAtomically")
+	}
+
+	if err := os.Rename(temporaryPath, path); err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, "writeFileAtomically")
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package eventdump_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/adapter/eventdump"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBackupDirectory(t *testing.T) {
+	Convey("Given a backup directory with two files", t, func() {
+		path, err := ioutil.TempDir("", "eventdump")
+		So(err, ShouldBeNil)
+
+		stream := []eventdump.Record{
+			{StreamID: "customer-1", StreamVersion: 1, EventName: "CustomerRegistered", Payload: []byte(`{}`)},
+			{StreamID: "customer-1", StreamVersion: 2, EventName: "CustomerDeleted", Payload: []byte(`{}`)},
+		}
+
+		directory, err := eventdump.NewBackupDirectory(path)
+		So(err, ShouldBeNil)
+
+		for _, name := range []string{"b.ndjson", "a.ndjson"} {
+			err = directory.WriteFile(name, func(records *eventdump.RecordWriter) error {
+				return records.Write(stream...)
+			})
+			So(err, ShouldBeNil)
+		}
+
+		So(directory.Close(), ShouldBeNil)
+
+		Convey("Then only the owner should be able to read the files", func() {
+			for _, name := range []string{"a.ndjson", "b.ndjson", eventdump.ChecksumsFileName} {
+				info, err := os.Stat(filepath.Join(path, name))
+				So(err, ShouldBeNil)
+				So(info.Mode().Perm(), ShouldEqual, os.FileMode(0600))
+			}
+		})
+
+		Convey("When it is read", func() {
+			names, err := eventdump.ReadBackupDirectory(path)
+
+			Convey("Then all files should be verified and listed", func() {
+				So(err, ShouldBeNil)
+				So(names, ShouldResemble, []string{"a.ndjson", "b.ndjson"})
+			})
+		})
+
+		Convey("When a file was modified", func() {
+			So(ioutil.WriteFile(filepath.Join(path, "b.ndjson"), []byte("{}\n"), 0644), ShouldBeNil)
+			_, err := eventdump.ReadBackupDirectory(path)
+
+			Convey("Then reading it should fail", func() {
+				So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+			})
+		})
+
+		Convey("When the stream has a gap", func() {
+			stream[1].StreamVersion = 3
+
+			Convey("Then it should not be valid", func() {
+				So(errors.Is(eventdump.ValidateStream(stream), shared.ErrInputIsInvalid), ShouldBeTrue)
+			})
+		})
+
+		Reset(func() {
+			_ = os.RemoveAll(path)
+		})
+	})
+}
@@ -0,0 +1,169 @@
+package eventdump
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+const pseudonymizedEmailDomain = "example.com"
+
+var givenNames = []string{
+	"Alex", "Billie", "Charlie", "Dana", "Eden", "Frankie", "Gale", "Harper",
+	"Indigo", "Jamie", "Kai", "Logan", "Morgan", "Noel", "Oakley", "Parker",
+	"Quinn", "Riley", "Sage", "Taylor", "Umi", "Val", "Winter", "Yael",
+}
+
+var familyNames = []string{
+	"Abbott", "Baker", "Carter", "Dawson", "Ellis", "Fisher", "Graham", "Hayes",
+	"Irving", "Jennings", "Keller", "Lambert", "Mercer", "Norris", "Osborne", "Porter",
+	"Quincy", "Rhodes", "Sutton", "Turner", "Underwood", "Vaughn", "Whitaker", "Young",
+}
+
+// keepEvent tells for all known events whether they are kept with pseudonymized fields or dropped.
+// MFA events are dropped, because their secrets are encrypted with the key of the source environment.
+var keepEvent = map[string]bool{
+	"CustomerRegistered":                     true,
+	"CustomerEmailAddressConfirmed":          true,
+	"CustomerEmailAddressConfirmationFailed": true,
+	"CustomerEmailAddressChanged":            true,
+	"CustomerNameChanged":                    true,
+	"CustomerAttributeSet":                   true,
+	"CustomerPasswordSet":                    true,
+	"CustomerPasswordChanged":                true,
+	"CustomerAccountRecoveryRequested":       true,
+	"CustomerAccountRecovered":               true,
+	"CustomerMFAEnrollmentStarted":           false,
+	"CustomerMFAEnrollmentConfirmed":         false,
+	"CustomerMFACodeUsed":                    false,
+	"CustomerMFARecoveryCodeUsed":            false,
+	"CustomerMFADisabled":                    false,
+	"CustomerDeleted":                        true,
+}
+
+// Pseudonymizer replaces email addresses, names, free-text attribute values and password hashes in event payloads.
+// The same input always maps to the same pseudonym for the same key, so unique email addresses stay unique,
+// while without the key the pseudonyms can't be matched against guessed inputs.
+type Pseudonymizer struct {
+	key          []byte
+	passwordHash value.PasswordHash
+}
+
+// NewPseudonymizer expects the passwordHash which replaces all password hashes, so that developers can log in as any Customer.
+func NewPseudonymizer(key []byte, passwordHash value.PasswordHash) *Pseudonymizer {
+	return &Pseudonymizer{key: key, passwordHash: passwordHash}
+}
+
+// PseudonymizeStream expects all Records of one stream ordered by version, it renumbers the versions of the remaining Records.
+func (p *Pseudonymizer) PseudonymizeStream(records []Record) ([]Record, error) {
+	wrapWithMsg := "pseudonymizer.PseudonymizeStream"
+	pseudonymized := make([]Record, 0, len(records))
+
+	for _, record := range records {
+		keep, known := keepEvent[record.EventName]
+
+		if !known {
+			err := errors.Newf("event [%s] in stream [%s] is unknown", record.EventName, record.StreamID)
+			return nil, shared.MarkAndWrapError(err, shared.ErrUnmarshalingFailed, wrapWithMsg)
+		}
+
+		if !keep {
+			continue
+		}
+
+		payload := make(map[string]interface{})
+
+		if err := json.Unmarshal(record.Payload, &payload); err != nil {
+			return nil, shared.MarkAndWrapError(err, shared.ErrUnmarshalingFailed, wrapWithMsg)
+		}
+
+		for field, input := range payload {
+			if input, ok := input.(string); ok {
+				payload[field] = p.pseudonymizeField(field, input)
+			}
+		}
+
+		marshaled, err := json.Marshal(payload)
+		if err != nil {
+			return nil, shared.MarkAndWrapError(err, shared.ErrMarshalingFailed, wrapWithMsg)
+		}
+
+		record.Payload = marshaled
+		record.StreamVersion = uint(len(pseudonymized) + 1)
+		pseudonymized = append(pseudonymized, record)
+	}
+
+	return pseudonymized, nil
+}
+
+func (p *Pseudonymizer) pseudonymizeField(field string, input string) string {
+	switch field {
+	case "emailAddress", "previousEmailAddress":
+		return p.emailAddress(input)
+	case "personGivenName", "givenName":
+		return pick(givenNames, p.digest("givenName", input))
+	case "personFamilyName", "familyName":
+		return pick(familyNames, p.digest("familyName", input))
+	case "attributeValue":
+		return p.attributeValue(input)
+	case "passwordHash":
+		return p.passwordHash.String()
+	default:
+		return input
+	}
+}
+
+// emailAddress keeps the +tag structure, so that duplicate detection still finds the same candidates.
+func (p *Pseudonymizer) emailAddress(input string) string {
+	localPart, domain := input, ""
+
+	if at := strings.LastIndex(input, "@"); at >= 0 {
+		localPart, domain = input[:at], input[at+1:]
+	}
+
+	mailbox, tag := localPart, ""
+
+	if plus := strings.Index(localPart, "+"); plus >= 0 {
+		mailbox, tag = localPart[:plus], localPart[plus+1:]
+	}
+
+	pseudonym := "customer-" + hex.EncodeToString(p.digest("emailAddress", mailbox+"@"+domain)[:8])
+
+	if tag != "" {
+		pseudonym += "+" + hex.EncodeToString(p.digest("emailTag", tag)[:4])
+	}
+
+	return pseudonym + "@" + pseudonymizedEmailDomain
+}
+
+// attributeValue keeps integers and booleans, because they can't be personal data on their own.
+func (p *Pseudonymizer) attributeValue(input string) string {
+	if _, err := strconv.ParseInt(input, 10, 64); err == nil {
+		return input
+	}
+
+	if _, err := strconv.ParseBool(input); err == nil {
+		return input
+	}
+
+	return "pseudonym-" + hex.EncodeToString(p.digest("attributeValue", input)[:6])
+}
+
+func (p *Pseudonymizer) digest(kind string, input string) []byte {
+	mac := hmac.New(sha256.New, p.key)
+	_, _ = mac.Write([]byte(kind + "\x00" + input)) // writing to a hash never fails
+
+	return mac.Sum(nil)
+}
+
+func pick(candidates []string, digest []byte) string {
+	return candidates[binary.BigEndian.Uint64(digest[:8])%uint64(len(candidates))]
+}
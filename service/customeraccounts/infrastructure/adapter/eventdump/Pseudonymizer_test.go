@@ -0,0 +1,161 @@
+package eventdump_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/adapter/eventdump"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/serialization"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPseudonymizer_PseudonymizeStream(t *testing.T) {
+	Convey("Given the stored events of a Customer", t, func() {
+		secretCipher, _ := serialization.NewSecretCipher([]byte("0123456789abcdef0123456789abcdef"))
+		customerEventRegistry, _ := serialization.NewCustomerEventRegistry(secretCipher)
+		unmarshalCustomerEvent := customerEventRegistry.Unmarshal
+		customerID := value.GenerateCustomerID()
+		emailAddress := value.RebuildEmailAddress("fiona@gallagher.net")
+		newEmailAddress := value.RebuildEmailAddress("fiona+work@gallagher.net")
+		confirmationHash := value.GenerateConfirmationHash(emailAddress.String())
+		totpSecret, _ := value.GenerateTOTPSecret()
+		_, recoveryCodes, _ := value.GenerateRecoveryCodes()
+		devPasswordHash := value.RebuildPasswordHash("$2a$12$devPasswordHash")
+
+		stream := buildRecords(
+			secretCipher,
+			domain.BuildCustomerRegistered(customerID, emailAddress, confirmationHash, value.RebuildPersonName("Fiona", "Gallagher"), 1),
+			domain.BuildCustomerMFAEnrollmentStarted(customerID, totpSecret, recoveryCodes, 2),
+			domain.BuildCustomerPasswordSet(customerID, value.RebuildPasswordHash("$2a$12$realPasswordHash"), 3),
+			domain.BuildCustomerAttributeSet(customerID, value.RebuildCustomAttribute("nickname", "Fi"), 4),
+			domain.BuildCustomerAttributeSet(customerID, value.RebuildCustomAttribute("vip_level", "3"), 5),
+			domain.BuildCustomerEmailAddressChanged(customerID, newEmailAddress, confirmationHash, emailAddress, 6),
+		)
+
+		pseudonymizer := eventdump.NewPseudonymizer([]byte("secret"), devPasswordHash)
+
+		Convey("When the stream is pseudonymized", func() {
+			pseudonymized, err := pseudonymizer.PseudonymizeStream(stream)
+			So(err, ShouldBeNil)
+
+			Convey("Then MFA events should be dropped and the versions renumbered", func() {
+				So(pseudonymized, ShouldHaveLength, 5)
+
+				for idx, record := range pseudonymized {
+					So(record.StreamID, ShouldEqual, "customer-"+customerID.String())
+					So(record.StreamVersion, ShouldEqual, idx+1)
+					So(record.EventName, ShouldNotStartWith, "CustomerMFA")
+				}
+			})
+
+			Convey("And no personal data or password hash should be left", func() {
+				for _, record := range pseudonymized {
+					for _, personalData := range []string{"fiona", "Fiona", "Gallagher", "gallagher", `"Fi"`, "work", "realPasswordHash"} {
+						So(string(record.Payload), ShouldNotContainSubstring, personalData)
+					}
+				}
+
+				So(string(pseudonymized[1].Payload), ShouldContainSubstring, devPasswordHash.String())
+				So(string(pseudonymized[3].Payload), ShouldContainSubstring, `"attributeValue":"3"`)
+			})
+
+			Convey("And the payloads should still be valid events", func() {
+				registered, err := unmarshalCustomerEvent(pseudonymized[0].EventName, pseudonymized[0].Payload, 1)
+				So(err, ShouldBeNil)
+
+				changed, err := unmarshalCustomerEvent(pseudonymized[4].EventName, pseudonymized[4].Payload, 5)
+				So(err, ShouldBeNil)
+
+				pseudonymizedEmailAddress := registered.(domain.CustomerRegistered).EmailAddress()
+				_, err = value.BuildEmailAddress(pseudonymizedEmailAddress.String())
+				So(err, ShouldBeNil)
+				So(changed.(domain.CustomerEmailAddressChanged).PreviousEmailAddress(), ShouldResemble, pseudonymizedEmailAddress)
+				So(changed.(domain.CustomerEmailAddressChanged).EmailAddress().String(), ShouldContainSubstring, "+")
+				So(registered.(domain.CustomerRegistered).ConfirmationHash(), ShouldResemble, confirmationHash)
+			})
+
+			Convey("And pseudonymizing it again with the same key should give the same result", func() {
+				again, err := pseudonymizer.PseudonymizeStream(stream)
+				So(err, ShouldBeNil)
+				So(again, ShouldResemble, pseudonymized)
+			})
+
+			Convey("And pseudonymizing it with another key should give other pseudonyms", func() {
+				other, err := eventdump.NewPseudonymizer([]byte("other"), devPasswordHash).PseudonymizeStream(stream)
+				So(err, ShouldBeNil)
+				So(other[0].Payload, ShouldNotResemble, pseudonymized[0].Payload)
+			})
+		})
+
+		Convey("When the stream contains an unknown event", func() {
+			stream[1].EventName = "CustomerTeleported"
+			_, err := pseudonymizer.PseudonymizeStream(stream)
+
+			Convey("Then it should fail", func() {
+				So(errors.Is(err, shared.ErrUnmarshalingFailed), ShouldBeTrue)
+			})
+		})
+
+		Convey("When the stream is written and read again", func() {
+			buffer := &bytes.Buffer{}
+			So(eventdump.NewRecordWriter(buffer).Write(stream...), ShouldBeNil)
+			buffer.WriteString("\n")
+			So(eventdump.NewRecordWriter(buffer).Write(buildRecords(secretCipher, domain.BuildCustomerRegistered(
+				value.GenerateCustomerID(), emailAddress, confirmationHash, value.RebuildPersonName("Ian", "Gallagher"), 1,
+			))...), ShouldBeNil)
+
+			records := eventdump.NewRecordReader(buffer)
+
+			Convey("Then it should be read stream by stream", func() {
+				first, err := records.NextStream()
+				So(err, ShouldBeNil)
+				So(first, ShouldResemble, stream)
+
+				second, err := records.NextStream()
+				So(err, ShouldBeNil)
+				So(second, ShouldHaveLength, 1)
+
+				_, err = records.NextStream()
+				So(err, ShouldEqual, io.EOF)
+			})
+		})
+
+		Convey("When an invalid line is read", func() {
+			_, err := eventdump.NewRecordReader(strings.NewReader(`{"streamID":"customer-1"}`)).Next()
+
+			Convey("Then it should fail", func() {
+				So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+func buildRecords(secretCipher *serialization.SecretCipher, events ...es.DomainEvent) []eventdump.Record {
+	var records []eventdump.Record
+	customerEventRegistry, err := serialization.NewCustomerEventRegistry(secretCipher)
+	So(err, ShouldBeNil)
+
+	for _, event := range events {
+		payload, err := customerEventRegistry.Marshal(event)
+		So(err, ShouldBeNil)
+
+		customerID := event.(interface{ CustomerID() value.CustomerID }).CustomerID()
+
+		records = append(records, eventdump.Record{
+			StreamID:      "customer-" + customerID.String(),
+			StreamVersion: event.Meta().StreamVersion(),
+			EventName:     event.Meta().EventName(),
+			OccurredAt:    event.Meta().OccurredAt(),
+			Payload:       payload,
+		})
+	}
+
+	return records
+}
@@ -0,0 +1,130 @@
+package eventdump
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+const maxRecordSize = 1024 * 1024
+
+// Record is one row of the event store in a portable format, the payload is kept as it was stored.
+type Record struct {
+	StreamID      string          `json:"streamID"`
+	StreamVersion uint            `json:"streamVersion"`
+	EventName     string          `json:"eventName"`
+	OccurredAt    string          `json:"occurredAt"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+// RecordWriter writes one Record per line as JSON.
+type RecordWriter struct {
+	writer io.Writer
+}
+
+func NewRecordWriter(writer io.Writer) *RecordWriter {
+	return &RecordWriter{writer: writer}
+}
+
+func (w *RecordWriter) Write(records ...Record) error {
+	for _, record := range records {
+		line, err := json.Marshal(record)
+		if err != nil {
+			return shared.MarkAndWrapError(err, shared.ErrMarshalingFailed, "recordWriter.Write")
+		}
+
+		if _, err = w.writer.Write(append(line, '\n')); err != nil {
+			return shared.MarkAndWrapError(err, shared.ErrTechnical, "recordWriter.Write")
+		}
+	}
+
+	return nil
+}
+
+// RecordReader reads the output of a RecordWriter, empty lines are skipped.
+type RecordReader struct {
+	scanner *bufio.Scanner
+	line    uint64
+	next    *Record
+}
+
+func NewRecordReader(input io.Reader) *RecordReader {
+	scanner := bufio.NewScanner(input)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRecordSize)
+
+	return &RecordReader{scanner: scanner}
+}
+
+// Next returns io.EOF after the last Record, all other errors mean that the input can't be read any further.
+func (r *RecordReader) Next() (Record, error) {
+	if r.next != nil {
+		record := *r.next
+		r.next = nil
+
+		return record, nil
+	}
+
+	wrapWithMsg := "recordReader.Next"
+
+	for r.scanner.Scan() {
+		r.line++
+
+		if len(r.scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var record Record
+
+		if err := json.Unmarshal(r.scanner.Bytes(), &record); err != nil {
+			err = errors.Wrapf(err, "line %d", r.line)
+			return Record{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+		}
+
+		if record.StreamID == "" || record.EventName == "" || record.StreamVersion == 0 {
+			err := errors.Newf("line %d: streamID, streamVersion and eventName are required", r.line)
+			return Record{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+		}
+
+		return record, nil
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return Record{}, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	return Record{}, io.EOF
+}
+
+// NextStream returns all consecutive Records of the next stream, so the input must be ordered by stream.
+// It returns io.EOF after the last stream.
+func (r *RecordReader) NextStream() ([]Record, error) {
+	first, err := r.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	stream := []Record{first}
+
+	for {
+		record, err := r.Next()
+
+		if err == io.EOF {
+			return stream, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if record.StreamID != first.StreamID {
+			r.next = &record
+
+			return stream, nil
+		}
+
+		stream = append(stream, record)
+	}
+}
@@ -0,0 +1,72 @@
+package customergrpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const adminTokenScheme = "Bearer "
+
+// AdminTokenAuthentication rejects all calls which don't send the admin token as "authorization: Bearer <token>" metadata.
+// It must be installed as unary and as stream interceptor, because the reflection service is streaming.
+type AdminTokenAuthentication struct {
+	tokenDigest [sha256.Size]byte
+}
+
+func NewAdminTokenAuthentication(token string) *AdminTokenAuthentication {
+	return &AdminTokenAuthentication{tokenDigest: sha256.Sum256([]byte(token))}
+}
+
+func (auth *AdminTokenAuthentication) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+
+	if err := auth.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (auth *AdminTokenAuthentication) StreamInterceptor(
+	srv interface{},
+	stream grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+
+	if err := auth.authenticate(stream.Context()); err != nil {
+		return err
+	}
+
+	return handler(srv, stream)
+}
+
+// authenticate compares digests of the tokens, so that the comparison takes the same time for tokens of any length.
+func (auth *AdminTokenAuthentication) authenticate(ctx context.Context) error {
+	var token string
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 && strings.HasPrefix(values[0], adminTokenScheme) {
+			token = strings.TrimPrefix(values[0], adminTokenScheme)
+		}
+	}
+
+	digest := sha256.Sum256([]byte(token))
+
+	if token == "" || subtle.ConstantTimeCompare(digest[:], auth.tokenDigest[:]) != 1 {
+		return status.Error(codes.Unauthenticated, "missing or invalid admin token")
+	}
+
+	return nil
+}
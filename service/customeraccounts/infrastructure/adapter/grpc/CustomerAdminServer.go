@@ -0,0 +1,84 @@
+package customergrpc
+
+import (
+	"io"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/adapter/importing"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+type customerAdminServer struct {
+	importCustomer hexagon.ForImportingCustomers
+}
+
+func NewCustomerAdminServer(importCustomer hexagon.ForImportingCustomers) *customerAdminServer {
+	return &customerAdminServer{importCustomer: importCustomer}
+}
+
+func (server *customerAdminServer) ImportCustomers(stream CustomerAdmin_ImportCustomersServer) error {
+	wrapWithMsg := "customerAdminServer.ImportCustomers"
+
+	req, err := stream.Recv()
+	if err != nil {
+		return MapToGRPCErrors(shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg))
+	}
+
+	options := req.GetOptions()
+	if options == nil {
+		err = errors.New("the first message must contain the options")
+		return MapToGRPCErrors(shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg))
+	}
+
+	importer := importing.NewImporter(server.importCustomer, options.DryRun, uint(options.Parallelism))
+
+	reportResult := func(result importing.RowResult) error {
+		return stream.Send(
+			&ImportCustomersResult{
+				Row:        result.Row,
+				Status:     result.Status,
+				CustomerId: result.CustomerID,
+				Error:      result.Error,
+			},
+		)
+	}
+
+	if _, err = importer.Import(importCustomersRowReader{stream: stream}, nil, reportResult); err != nil {
+		return MapToGRPCErrors(err)
+	}
+
+	return nil
+}
+
+// importCustomersRowReader reads the rows of an ImportCustomers upload.
+type importCustomersRowReader struct {
+	stream CustomerAdmin_ImportCustomersServer
+}
+
+func (r importCustomersRowReader) Next() (importing.Row, error) {
+	wrapWithMsg := "importCustomersRowReader.Next"
+
+	req, err := r.stream.Recv()
+	if err == io.EOF {
+		return importing.Row{}, io.EOF
+	}
+
+	if err != nil {
+		return importing.Row{}, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	row := req.GetRow()
+	if row == nil {
+		err = errors.New("all messages after the first one must contain a row")
+		return importing.Row{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	return importing.Row{
+		Number:                  row.Row,
+		EmailAddress:            row.EmailAddress,
+		GivenName:               row.GivenName,
+		FamilyName:              row.FamilyName,
+		IsEmailAddressConfirmed: row.IsEmailAddressConfirmed,
+	}, nil
+}
@@ -2,18 +2,48 @@ package customergrpc
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
 
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/serialization"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 type customerServer struct {
-	register            hexagon.ForRegisteringCustomers
-	confirmEmailAddress hexagon.ForConfirmingCustomerEmailAddresses
-	changeEmailAddress  hexagon.ForChangingCustomerEmailAddresses
-	changeName          hexagon.ForChangingCustomerNames
-	delete              hexagon.ForDeletingCustomers
-	retrieveView        hexagon.ForRetrievingCustomerViews
+	register                   hexagon.ForRegisteringCustomers
+	confirmEmailAddress        hexagon.ForConfirmingCustomerEmailAddresses
+	changeEmailAddress         hexagon.ForChangingCustomerEmailAddresses
+	changeName                 hexagon.ForChangingCustomerNames
+	setPassword                hexagon.ForSettingCustomerPasswords
+	changePassword             hexagon.ForChangingCustomerPasswords
+	verifyCredentials          hexagon.ForVerifyingCustomerCredentials
+	requestAccountRecovery     hexagon.ForRequestingCustomerAccountRecoveries
+	completeAccountRecovery    hexagon.ForCompletingCustomerAccountRecoveries
+	startMFAEnrollment         hexagon.ForStartingCustomerMFAEnrollments
+	confirmMFAEnrollment       hexagon.ForConfirmingCustomerMFAEnrollments
+	disableMFA                 hexagon.ForDisablingCustomerMFA
+	verifyMFACode              hexagon.ForVerifyingCustomerMFACodes
+	setAttribute               hexagon.ForSettingCustomerAttributes
+	defineAttribute            hexagon.ForDefiningCustomAttributes
+	delete                     hexagon.ForDeletingCustomers
+	retrieveView               hexagon.ForRetrievingCustomerViews
+	retrieveViewByEmailAddress hexagon.ForRetrievingCustomerViewsByEmailAddress
+	listCustomers              hexagon.ForListingCustomers
+	searchCustomers            hexagon.ForSearchingCustomers
+	listDuplicateCandidates    hexagon.ForListingDuplicateCustomerCandidates
+	markAsNotDuplicate         hexagon.ForMarkingCustomersAsNotDuplicate
+	retrieveRegistrationFunnel hexagon.ForRetrievingCustomerRegistrationFunnels
+	exportCustomerData         hexagon.ForExportingCustomerData
 }
 
 func NewCustomerServer(
@@ -21,16 +51,52 @@ func NewCustomerServer(
 	confirmEmailAddress hexagon.ForConfirmingCustomerEmailAddresses,
 	changeEmailAddress hexagon.ForChangingCustomerEmailAddresses,
 	changeName hexagon.ForChangingCustomerNames,
+	setPassword hexagon.ForSettingCustomerPasswords,
+	changePassword hexagon.ForChangingCustomerPasswords,
+	verifyCredentials hexagon.ForVerifyingCustomerCredentials,
+	requestAccountRecovery hexagon.ForRequestingCustomerAccountRecoveries,
+	completeAccountRecovery hexagon.ForCompletingCustomerAccountRecoveries,
+	startMFAEnrollment hexagon.ForStartingCustomerMFAEnrollments,
+	confirmMFAEnrollment hexagon.ForConfirmingCustomerMFAEnrollments,
+	disableMFA hexagon.ForDisablingCustomerMFA,
+	verifyMFACode hexagon.ForVerifyingCustomerMFACodes,
+	setAttribute hexagon.ForSettingCustomerAttributes,
+	defineAttribute hexagon.ForDefiningCustomAttributes,
 	delete hexagon.ForDeletingCustomers,
 	retrieveView hexagon.ForRetrievingCustomerViews,
+	retrieveViewByEmailAddress hexagon.ForRetrievingCustomerViewsByEmailAddress,
+	listCustomers hexagon.ForListingCustomers,
+	searchCustomers hexagon.ForSearchingCustomers,
+	listDuplicateCandidates hexagon.ForListingDuplicateCustomerCandidates,
+	markAsNotDuplicate hexagon.ForMarkingCustomersAsNotDuplicate,
+	retrieveRegistrationFunnel hexagon.ForRetrievingCustomerRegistrationFunnels,
+	exportCustomerData hexagon.ForExportingCustomerData,
 ) *customerServer {
 	server := &customerServer{
-		register:            register,
-		confirmEmailAddress: confirmEmailAddress,
-		changeEmailAddress:  changeEmailAddress,
-		changeName:          changeName,
-		delete:              delete,
-		retrieveView:        retrieveView,
+		register:                   register,
+		confirmEmailAddress:        confirmEmailAddress,
+		changeEmailAddress:         changeEmailAddress,
+		changeName:                 changeName,
+		setPassword:                setPassword,
+		changePassword:             changePassword,
+		verifyCredentials:          verifyCredentials,
+		requestAccountRecovery:     requestAccountRecovery,
+		completeAccountRecovery:    completeAccountRecovery,
+		startMFAEnrollment:         startMFAEnrollment,
+		confirmMFAEnrollment:       confirmMFAEnrollment,
+		disableMFA:                 disableMFA,
+		verifyMFACode:              verifyMFACode,
+		setAttribute:               setAttribute,
+		defineAttribute:            defineAttribute,
+		delete:                     delete,
+		retrieveView:               retrieveView,
+		retrieveViewByEmailAddress: retrieveViewByEmailAddress,
+		listCustomers:              listCustomers,
+		searchCustomers:            searchCustomers,
+		listDuplicateCandidates:    listDuplicateCandidates,
+		markAsNotDuplicate:         markAsNotDuplicate,
+		retrieveRegistrationFunnel: retrieveRegistrationFunnel,
+		exportCustomerData:         exportCustomerData,
 	}
 
 	return server
@@ -54,7 +120,7 @@ func (server *customerServer) ConfirmEmailAddress(
 	req *ConfirmEmailAddressRequest,
 ) (*empty.Empty, error) {
 
-	if err := server.confirmEmailAddress(req.Id, req.ConfirmationHash); err != nil {
+	if err := server.confirmEmailAddress(req.Id, req.ConfirmationHash, uint(req.ExpectedVersion)); err != nil {
 		return nil, MapToGRPCErrors(err)
 	}
 
@@ -66,7 +132,7 @@ func (server *customerServer) ChangeEmailAddress(
 	req *ChangeEmailAddressRequest,
 ) (*empty.Empty, error) {
 
-	if err := server.changeEmailAddress(req.Id, req.EmailAddress); err != nil {
+	if err := server.changeEmailAddress(req.Id, req.EmailAddress, uint(req.ExpectedVersion)); err != nil {
 		return nil, MapToGRPCErrors(err)
 	}
 
@@ -78,7 +144,142 @@ func (server *customerServer) ChangeName(
 	req *ChangeNameRequest,
 ) (*empty.Empty, error) {
 
-	if err := server.changeName(req.Id, req.GivenName, req.FamilyName); err != nil {
+	if err := server.changeName(req.Id, req.GivenName, req.FamilyName, uint(req.ExpectedVersion)); err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (server *customerServer) SetPassword(
+	_ context.Context,
+	req *SetPasswordRequest,
+) (*empty.Empty, error) {
+
+	if err := server.setPassword(req.Id, req.Password, uint(req.ExpectedVersion)); err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (server *customerServer) ChangePassword(
+	_ context.Context,
+	req *ChangePasswordRequest,
+) (*empty.Empty, error) {
+
+	if err := server.changePassword(req.Id, req.CurrentPassword, req.NewPassword, uint(req.ExpectedVersion)); err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (server *customerServer) VerifyCredentials(
+	_ context.Context,
+	req *VerifyCredentialsRequest,
+) (*VerifyCredentialsResponse, error) {
+
+	customerID, err := server.verifyCredentials(req.EmailAddress, req.Password)
+	if err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	return &VerifyCredentialsResponse{Id: customerID.String()}, nil
+}
+
+func (server *customerServer) RequestAccountRecovery(
+	ctx context.Context,
+	req *RequestAccountRecoveryRequest,
+) (*empty.Empty, error) {
+
+	if err := server.requestAccountRecovery(req.EmailAddress, clientAddressFrom(ctx)); err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (server *customerServer) CompleteAccountRecovery(
+	ctx context.Context,
+	req *CompleteAccountRecoveryRequest,
+) (*empty.Empty, error) {
+
+	err := server.completeAccountRecovery(req.EmailAddress, req.RecoveryToken, req.NewPassword, clientAddressFrom(ctx))
+	if err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (server *customerServer) StartMFAEnrollment(
+	_ context.Context,
+	req *StartMFAEnrollmentRequest,
+) (*StartMFAEnrollmentResponse, error) {
+
+	totpSecret, recoveryCodes, err := server.startMFAEnrollment(req.Id)
+	if err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	return &StartMFAEnrollmentResponse{TotpSecret: totpSecret.String(), RecoveryCodes: recoveryCodes}, nil
+}
+
+func (server *customerServer) ConfirmMFAEnrollment(
+	_ context.Context,
+	req *ConfirmMFAEnrollmentRequest,
+) (*empty.Empty, error) {
+
+	if err := server.confirmMFAEnrollment(req.Id, req.Code, uint(req.ExpectedVersion)); err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (server *customerServer) DisableMFA(
+	_ context.Context,
+	req *DisableMFARequest,
+) (*empty.Empty, error) {
+
+	if err := server.disableMFA(req.Id, req.Code, uint(req.ExpectedVersion)); err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (server *customerServer) VerifyMFACode(
+	_ context.Context,
+	req *VerifyMFACodeRequest,
+) (*empty.Empty, error) {
+
+	if err := server.verifyMFACode(req.Id, req.Code); err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (server *customerServer) SetAttribute(
+	_ context.Context,
+	req *SetAttributeRequest,
+) (*empty.Empty, error) {
+
+	if err := server.setAttribute(req.Id, req.Name, req.Value, uint(req.ExpectedVersion)); err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (server *customerServer) DefineAttribute(
+	_ context.Context,
+	req *DefineAttributeRequest,
+) (*empty.Empty, error) {
+
+	if err := server.defineAttribute(req.Name, req.Type, req.ValidationPattern); err != nil {
 		return nil, MapToGRPCErrors(err)
 	}
 
@@ -90,7 +291,7 @@ func (server *customerServer) Delete(
 	req *DeleteRequest,
 ) (*empty.Empty, error) {
 
-	if err := server.delete(req.Id); err != nil {
+	if err := server.delete(req.Id, uint(req.ExpectedVersion)); err != nil {
 		return nil, MapToGRPCErrors(err)
 	}
 
@@ -107,13 +308,295 @@ func (server *customerServer) RetrieveView(
 		return nil, MapToGRPCErrors(err)
 	}
 
-	response := &RetrieveViewResponse{
+	return buildRetrieveViewResponse(view), nil
+}
+
+// ExportCustomerData returns a JSON document or a ZIP bundle, the Content-Disposition header makes it a download via REST.
+func (server *customerServer) ExportCustomerData(
+	ctx context.Context,
+	req *ExportCustomerDataRequest,
+) (*httpbody.HttpBody, error) {
+
+	var err error
+	var data []byte
+	var contentType string
+	wrapWithMsg := "customerServer.ExportCustomerData"
+
+	switch req.Format {
+	case "", "json":
+		req.Format, contentType = "json", "application/json"
+	case "zip":
+		contentType = "application/zip"
+	default:
+		err = errors.Newf("format [%s] is not supported", req.Format)
+		return nil, MapToGRPCErrors(shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg))
+	}
+
+	dataExport, err := server.exportCustomerData(req.Id)
+	if err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	if req.Format == "zip" {
+		data, err = serialization.BundleCustomerDataExport(dataExport)
+	} else {
+		data, err = serialization.MarshalCustomerDataExport(dataExport)
+	}
+
+	if err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	contentDisposition := fmt.Sprintf(`attachment; filename="customer-%s.%s"`, dataExport.View.ID, req.Format)
+	_ = grpc.SetHeader(ctx, metadata.Pairs("content-disposition", contentDisposition)) // only fails if the header was already sent
+
+	return &httpbody.HttpBody{ContentType: contentType, Data: data}, nil
+}
+
+func (server *customerServer) RetrieveViewByEmailAddress(
+	_ context.Context,
+	req *RetrieveViewByEmailAddressRequest,
+) (*RetrieveViewResponse, error) {
+
+	view, err := server.retrieveViewByEmailAddress(req.EmailAddress)
+	if err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	return buildRetrieveViewResponse(view), nil
+}
+
+func (server *customerServer) ListCustomers(
+	_ context.Context,
+	req *ListCustomersRequest,
+) (*ListCustomersResponse, error) {
+
+	criteria, err := buildListCriteria(req)
+	if err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	listPage, err := server.listCustomers(criteria)
+	if err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	response := &ListCustomersResponse{
+		Entries:    buildCustomerListEntries(listPage.Entries),
+		NextCursor: listPage.NextCursor,
+	}
+
+	return response, nil
+}
+
+func (server *customerServer) SearchCustomers(
+	_ context.Context,
+	req *SearchCustomersRequest,
+) (*SearchCustomersResponse, error) {
+
+	entries, err := server.searchCustomers(req.Query, uint(req.MaxResults))
+	if err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	return &SearchCustomersResponse{Entries: buildCustomerListEntries(entries)}, nil
+}
+
+func (server *customerServer) ListDuplicateCandidates(
+	_ context.Context,
+	req *ListDuplicateCandidatesRequest,
+) (*ListDuplicateCandidatesResponse, error) {
+
+	candidates, err := server.listDuplicateCandidates(req.IncludeReviewed)
+	if err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	responseCandidates := make([]*DuplicateCandidate, 0, len(candidates))
+
+	for _, candidate := range candidates {
+		responseCandidates = append(
+			responseCandidates,
+			&DuplicateCandidate{
+				Customer:       buildCustomerListEntry(candidate.Customer),
+				OtherCustomer:  buildCustomerListEntry(candidate.OtherCustomer),
+				Reason:         candidate.Reason,
+				Similarity:     candidate.Similarity,
+				IsNotDuplicate: candidate.IsNotDuplicate,
+				DetectedAt:     candidate.DetectedAt.Format(time.RFC3339Nano),
+			},
+		)
+	}
+
+	return &ListDuplicateCandidatesResponse{Candidates: responseCandidates}, nil
+}
+
+func (server *customerServer) MarkAsNotDuplicate(
+	_ context.Context,
+	req *MarkAsNotDuplicateRequest,
+) (*empty.Empty, error) {
+
+	if err := server.markAsNotDuplicate(req.CustomerId, req.OtherCustomerId); err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (server *customerServer) RetrieveRegistrationFunnel(
+	_ context.Context,
+	req *RetrieveRegistrationFunnelRequest,
+) (*RetrieveRegistrationFunnelResponse, error) {
+
+	var err error
+	wrapWithMsg := "customerServer.RetrieveRegistrationFunnel"
+	criteria := customer.FunnelCriteria{Interval: req.Interval}
+
+	if req.From != "" {
+		if criteria.From, err = time.Parse(time.RFC3339, req.From); err != nil {
+			return nil, MapToGRPCErrors(shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg))
+		}
+	}
+
+	if req.Until != "" {
+		if criteria.Until, err = time.Parse(time.RFC3339, req.Until); err != nil {
+			return nil, MapToGRPCErrors(shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg))
+		}
+	}
+
+	periods, err := server.retrieveRegistrationFunnel(criteria)
+	if err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	responsePeriods := make([]*RegistrationFunnelPeriod, 0, len(periods))
+
+	for _, period := range periods {
+		responsePeriods = append(
+			responsePeriods,
+			&RegistrationFunnelPeriod{
+				PeriodStart:                  period.PeriodStart.Format(time.RFC3339),
+				Registrations:                uint64(period.Registrations),
+				Confirmations:                uint64(period.Confirmations),
+				ConfirmationFailures:         uint64(period.ConfirmationFailures),
+				ConfirmationFailureRate:      period.ConfirmationFailureRate,
+				AverageSecondsToConfirmation: period.AverageTimeToConfirmation.Seconds(),
+				Deletions:                    uint64(period.Deletions),
+			},
+		)
+	}
+
+	return &RetrieveRegistrationFunnelResponse{Periods: responsePeriods}, nil
+}
+
+// clientAddressFrom trusts the last X-Forwarded-For hop only if the request came from a loopback address,
+// which is where the REST gateway runs - any other client could send arbitrary X-Forwarded-For metadata.
+func clientAddressFrom(ctx context.Context) string {
+	var peerHost string
+
+	if p, ok := peer.FromContext(ctx); ok {
+		peerHost = p.Addr.String()
+
+		if host, _, err := net.SplitHostPort(peerHost); err == nil {
+			peerHost = host
+		}
+	}
+
+	if ip := net.ParseIP(peerHost); ip == nil || !ip.IsLoopback() {
+		return peerHost
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if forwardedFor := md.Get("x-forwarded-for"); len(forwardedFor) > 0 {
+			hops := strings.Split(forwardedFor[len(forwardedFor)-1], ",")
+			return strings.TrimSpace(hops[len(hops)-1])
+		}
+	}
+
+	return peerHost
+}
+
+func buildCustomerListEntries(entries []customer.ListEntry) []*CustomerListEntry {
+	responseEntries := make([]*CustomerListEntry, 0, len(entries))
+
+	for _, entry := range entries {
+		responseEntries = append(responseEntries, buildCustomerListEntry(entry))
+	}
+
+	return responseEntries
+}
+
+func buildCustomerListEntry(entry customer.ListEntry) *CustomerListEntry {
+	return &CustomerListEntry{
+		Id:                      entry.ID,
+		EmailAddress:            entry.EmailAddress,
+		IsEmailAddressConfirmed: entry.IsEmailAddressConfirmed,
+		GivenName:               entry.GivenName,
+		FamilyName:              entry.FamilyName,
+		IsDeleted:               entry.IsDeleted,
+		RegisteredAt:            entry.RegisteredAt.Format(time.RFC3339Nano),
+		Version:                 uint64(entry.Version),
+	}
+}
+
+func buildListCriteria(req *ListCustomersRequest) (customer.ListCriteria, error) {
+	var err error
+	wrapWithMsg := "buildListCriteria"
+	isTrue, isFalse := true, false
+
+	criteria := customer.ListCriteria{
+		SortBy:         req.SortBy,
+		SortDescending: req.SortDescending,
+		PageSize:       uint(req.PageSize),
+		Cursor:         req.Cursor,
+	}
+
+	switch req.ConfirmationStatus {
+	case "":
+	case "confirmed":
+		criteria.IsEmailAddressConfirmed = &isTrue
+	case "unconfirmed":
+		criteria.IsEmailAddressConfirmed = &isFalse
+	default:
+		err = errors.Newf("confirmationStatus [%s] is not supported", req.ConfirmationStatus)
+		return customer.ListCriteria{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	switch req.State {
+	case "":
+	case "active":
+		criteria.IsDeleted = &isFalse
+	case "deleted":
+		criteria.IsDeleted = &isTrue
+	default:
+		err = errors.Newf("state [%s] is not supported", req.State)
+		return customer.ListCriteria{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	if req.RegisteredFrom != "" {
+		if criteria.RegisteredFrom, err = time.Parse(time.RFC3339, req.RegisteredFrom); err != nil {
+			return customer.ListCriteria{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+		}
+	}
+
+	if req.RegisteredUntil != "" {
+		if criteria.RegisteredUntil, err = time.Parse(time.RFC3339, req.RegisteredUntil); err != nil {
+			return customer.ListCriteria{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+		}
+	}
+
+	return criteria, nil
+}
+
+func buildRetrieveViewResponse(view customer.View) *RetrieveViewResponse {
+	return &RetrieveViewResponse{
+		Id:                      view.ID,
 		EmailAddress:            view.EmailAddress,
 		IsEmailAddressConfirmed: view.IsEmailAddressConfirmed,
 		GivenName:               view.GivenName,
 		FamilyName:              view.FamilyName,
+		CustomAttributes:        view.CustomAttributes,
+		IsMFAEnabled:            view.IsMFAEnabled,
 		Version:                 uint64(view.Version),
 	}
-
-	return response, nil
 }
@@ -0,0 +1,123 @@
+package customergrpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+)
+
+type ForQueryingEvents func(customerID string, query es.EventQuery) (es.EventPage, error)
+type ForCountingEventsPerDay func(customerID string, filter es.EventFilter) ([]es.EventCount, error)
+
+type eventStoreAdminServer struct {
+	queryEvents       ForQueryingEvents
+	countEventsPerDay ForCountingEventsPerDay
+}
+
+func NewEventStoreAdminServer(
+	queryEvents ForQueryingEvents,
+	countEventsPerDay ForCountingEventsPerDay,
+) *eventStoreAdminServer {
+
+	return &eventStoreAdminServer{
+		queryEvents:       queryEvents,
+		countEventsPerDay: countEventsPerDay,
+	}
+}
+
+func (server *eventStoreAdminServer) QueryEvents(
+	_ context.Context,
+	req *QueryEventsRequest,
+) (*QueryEventsResponse, error) {
+
+	filter, err := buildEventFilter(req.EventName, req.OccurredFrom, req.OccurredUntil)
+	if err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	query := es.EventQuery{
+		EventFilter:   filter,
+		AfterPosition: uint(req.AfterPosition),
+		PageSize:      uint(req.PageSize),
+	}
+
+	page, err := server.queryEvents(req.CustomerId, query)
+	if err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	responseEvents := make([]*StoredEventMetadata, 0, len(page.Events))
+
+	for _, event := range page.Events {
+		responseEvents = append(
+			responseEvents,
+			&StoredEventMetadata{
+				Position:      uint64(event.Position),
+				StreamId:      event.StreamID.String(),
+				StreamVersion: uint64(event.StreamVersion),
+				EventName:     event.EventName,
+				OccurredAt:    event.OccurredAt.UTC().Format(time.RFC3339Nano),
+			},
+		)
+	}
+
+	response := &QueryEventsResponse{
+		Events:            responseEvents,
+		NextAfterPosition: uint64(page.NextAfterPosition),
+	}
+
+	return response, nil
+}
+
+func (server *eventStoreAdminServer) CountEventsPerDay(
+	_ context.Context,
+	req *CountEventsPerDayRequest,
+) (*CountEventsPerDayResponse, error) {
+
+	filter, err := buildEventFilter(req.EventName, req.OccurredFrom, req.OccurredUntil)
+	if err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	counts, err := server.countEventsPerDay(req.CustomerId, filter)
+	if err != nil {
+		return nil, MapToGRPCErrors(err)
+	}
+
+	responseCounts := make([]*EventCount, 0, len(counts))
+
+	for _, count := range counts {
+		responseCounts = append(
+			responseCounts,
+			&EventCount{
+				Day:       count.Day.Format("2006-01-02"),
+				EventName: count.EventName,
+				Count:     uint64(count.Count),
+			},
+		)
+	}
+
+	return &CountEventsPerDayResponse{Counts: responseCounts}, nil
+}
+
+func buildEventFilter(eventName, occurredFrom, occurredUntil string) (es.EventFilter, error) {
+	var err error
+	wrapWithMsg := "buildEventFilter"
+	filter := es.EventFilter{EventName: eventName}
+
+	if occurredFrom != "" {
+		if filter.OccurredFrom, err = time.Parse(time.RFC3339, occurredFrom); err != nil {
+			return es.EventFilter{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+		}
+	}
+
+	if occurredUntil != "" {
+		if filter.OccurredUntil, err = time.Parse(time.RFC3339, occurredUntil); err != nil {
+			return es.EventFilter{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+		}
+	}
+
+	return filter, nil
+}
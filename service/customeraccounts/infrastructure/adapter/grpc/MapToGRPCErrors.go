@@ -1,12 +1,20 @@
 package customergrpc
 
 import (
+	"strconv"
+
 	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
 	"github.com/cockroachdb/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// VersionViolationType is the type of the PreconditionFailure detail which reports the actual version of a Customer
+// to clients of commands with an expectedVersion.
+const VersionViolationType = "VERSION"
+
 func MapToGRPCErrors(appErr error) error {
 	var code codes.Code
 
@@ -18,6 +26,11 @@ func MapToGRPCErrors(appErr error) error {
 	case errors.Is(appErr, shared.ErrDuplicate):
 		code = codes.AlreadyExists
 
+	case errors.Is(appErr, shared.ErrInvalidCredentials):
+		code = codes.Unauthenticated
+	case errors.Is(appErr, shared.ErrRateLimitExceeded):
+		code = codes.ResourceExhausted
+
 	case errors.Is(appErr, shared.ErrDomainConstraintsViolation):
 		code = codes.FailedPrecondition
 
@@ -30,5 +43,21 @@ func MapToGRPCErrors(appErr error) error {
 		code = codes.Internal
 	}
 
-	return status.Errorf(code, "%s", errors.Cause(appErr))
+	st := status.Newf(code, "%s", errors.Cause(appErr))
+
+	var wrongExpectedVersion *es.WrongExpectedVersionError
+
+	if errors.As(appErr, &wrongExpectedVersion) {
+		violation := &errdetails.PreconditionFailure_Violation{
+			Type:        VersionViolationType,
+			Subject:     "customer",
+			Description: strconv.FormatUint(uint64(wrongExpectedVersion.ActualVersion), 10),
+		}
+
+		if stWithDetails, err := st.WithDetails(&errdetails.PreconditionFailure{Violations: []*errdetails.PreconditionFailure_Violation{violation}}); err == nil {
+			st = stWithDetails
+		}
+	}
+
+	return st.Err()
 }
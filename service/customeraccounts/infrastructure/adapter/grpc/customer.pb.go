@@ -9,6 +9,7 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	empty "github.com/golang/protobuf/ptypes/empty"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -123,6 +124,7 @@ func (m *RegisterResponse) GetId() string {
 type ConfirmEmailAddressRequest struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	ConfirmationHash     string   `protobuf:"bytes,2,opt,name=confirmationHash,proto3" json:"confirmationHash,omitempty"`
+	ExpectedVersion      uint64   `protobuf:"varint,3,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -167,9 +169,17 @@ func (m *ConfirmEmailAddressRequest) GetConfirmationHash() string {
 	return ""
 }
 
+func (m *ConfirmEmailAddressRequest) GetExpectedVersion() uint64 {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return 0
+}
+
 type ChangeEmailAddressRequest struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	EmailAddress         string   `protobuf:"bytes,2,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	ExpectedVersion      uint64   `protobuf:"varint,3,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -214,10 +224,18 @@ func (m *ChangeEmailAddressRequest) GetEmailAddress() string {
 	return ""
 }
 
+func (m *ChangeEmailAddressRequest) GetExpectedVersion() uint64 {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return 0
+}
+
 type ChangeNameRequest struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	GivenName            string   `protobuf:"bytes,2,opt,name=givenName,proto3" json:"givenName,omitempty"`
 	FamilyName           string   `protobuf:"bytes,3,opt,name=familyName,proto3" json:"familyName,omitempty"`
+	ExpectedVersion      uint64   `protobuf:"varint,4,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -269,393 +287,2461 @@ func (m *ChangeNameRequest) GetFamilyName() string {
 	return ""
 }
 
-type DeleteRequest struct {
+func (m *ChangeNameRequest) GetExpectedVersion() uint64 {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return 0
+}
+
+type SetPasswordRequest struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	ExpectedVersion      uint64   `protobuf:"varint,3,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
-func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteRequest) ProtoMessage()    {}
-func (*DeleteRequest) Descriptor() ([]byte, []int) {
+func (m *SetPasswordRequest) Reset()         { *m = SetPasswordRequest{} }
+func (m *SetPasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*SetPasswordRequest) ProtoMessage()    {}
+func (*SetPasswordRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9efa92dae3d6ec46, []int{5}
 }
 
-func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+func (m *SetPasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetPasswordRequest.Unmarshal(m, b)
 }
-func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+func (m *SetPasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetPasswordRequest.Marshal(b, m, deterministic)
 }
-func (m *DeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteRequest.Merge(m, src)
+func (m *SetPasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPasswordRequest.Merge(m, src)
 }
-func (m *DeleteRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteRequest.Size(m)
+func (m *SetPasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_SetPasswordRequest.Size(m)
 }
-func (m *DeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+func (m *SetPasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPasswordRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetPasswordRequest proto.InternalMessageInfo
 
-func (m *DeleteRequest) GetId() string {
+func (m *SetPasswordRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-type RetrieveViewRequest struct {
+func (m *SetPasswordRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *SetPasswordRequest) GetExpectedVersion() uint64 {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return 0
+}
+
+type ChangePasswordRequest struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CurrentPassword      string   `protobuf:"bytes,2,opt,name=currentPassword,proto3" json:"currentPassword,omitempty"`
+	NewPassword          string   `protobuf:"bytes,3,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	ExpectedVersion      uint64   `protobuf:"varint,4,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RetrieveViewRequest) Reset()         { *m = RetrieveViewRequest{} }
-func (m *RetrieveViewRequest) String() string { return proto.CompactTextString(m) }
-func (*RetrieveViewRequest) ProtoMessage()    {}
-func (*RetrieveViewRequest) Descriptor() ([]byte, []int) {
+func (m *ChangePasswordRequest) Reset()         { *m = ChangePasswordRequest{} }
+func (m *ChangePasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangePasswordRequest) ProtoMessage()    {}
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9efa92dae3d6ec46, []int{6}
 }
 
-func (m *RetrieveViewRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RetrieveViewRequest.Unmarshal(m, b)
+func (m *ChangePasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePasswordRequest.Unmarshal(m, b)
 }
-func (m *RetrieveViewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RetrieveViewRequest.Marshal(b, m, deterministic)
+func (m *ChangePasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePasswordRequest.Marshal(b, m, deterministic)
 }
-func (m *RetrieveViewRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RetrieveViewRequest.Merge(m, src)
+func (m *ChangePasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePasswordRequest.Merge(m, src)
 }
-func (m *RetrieveViewRequest) XXX_Size() int {
-	return xxx_messageInfo_RetrieveViewRequest.Size(m)
+func (m *ChangePasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangePasswordRequest.Size(m)
 }
-func (m *RetrieveViewRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RetrieveViewRequest.DiscardUnknown(m)
+func (m *ChangePasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePasswordRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RetrieveViewRequest proto.InternalMessageInfo
+var xxx_messageInfo_ChangePasswordRequest proto.InternalMessageInfo
 
-func (m *RetrieveViewRequest) GetId() string {
+func (m *ChangePasswordRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-type RetrieveViewResponse struct {
-	EmailAddress            string   `protobuf:"bytes,1,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
-	IsEmailAddressConfirmed bool     `protobuf:"varint,2,opt,name=isEmailAddressConfirmed,proto3" json:"isEmailAddressConfirmed,omitempty"`
-	GivenName               string   `protobuf:"bytes,3,opt,name=givenName,proto3" json:"givenName,omitempty"`
-	FamilyName              string   `protobuf:"bytes,4,opt,name=familyName,proto3" json:"familyName,omitempty"`
-	Version                 uint64   `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_unrecognized        []byte   `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+func (m *ChangePasswordRequest) GetCurrentPassword() string {
+	if m != nil {
+		return m.CurrentPassword
+	}
+	return ""
 }
 
-func (m *RetrieveViewResponse) Reset()         { *m = RetrieveViewResponse{} }
-func (m *RetrieveViewResponse) String() string { return proto.CompactTextString(m) }
-func (*RetrieveViewResponse) ProtoMessage()    {}
-func (*RetrieveViewResponse) Descriptor() ([]byte, []int) {
+func (m *ChangePasswordRequest) GetNewPassword() string {
+	if m != nil {
+		return m.NewPassword
+	}
+	return ""
+}
+
+func (m *ChangePasswordRequest) GetExpectedVersion() uint64 {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return 0
+}
+
+type VerifyCredentialsRequest struct {
+	EmailAddress         string   `protobuf:"bytes,1,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyCredentialsRequest) Reset()         { *m = VerifyCredentialsRequest{} }
+func (m *VerifyCredentialsRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyCredentialsRequest) ProtoMessage()    {}
+func (*VerifyCredentialsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9efa92dae3d6ec46, []int{7}
 }
 
-func (m *RetrieveViewResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RetrieveViewResponse.Unmarshal(m, b)
+func (m *VerifyCredentialsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyCredentialsRequest.Unmarshal(m, b)
 }
-func (m *RetrieveViewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RetrieveViewResponse.Marshal(b, m, deterministic)
+func (m *VerifyCredentialsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyCredentialsRequest.Marshal(b, m, deterministic)
 }
-func (m *RetrieveViewResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RetrieveViewResponse.Merge(m, src)
+func (m *VerifyCredentialsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyCredentialsRequest.Merge(m, src)
 }
-func (m *RetrieveViewResponse) XXX_Size() int {
-	return xxx_messageInfo_RetrieveViewResponse.Size(m)
+func (m *VerifyCredentialsRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyCredentialsRequest.Size(m)
 }
-func (m *RetrieveViewResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RetrieveViewResponse.DiscardUnknown(m)
+func (m *VerifyCredentialsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyCredentialsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RetrieveViewResponse proto.InternalMessageInfo
+var xxx_messageInfo_VerifyCredentialsRequest proto.InternalMessageInfo
 
-func (m *RetrieveViewResponse) GetEmailAddress() string {
+func (m *VerifyCredentialsRequest) GetEmailAddress() string {
 	if m != nil {
 		return m.EmailAddress
 	}
 	return ""
 }
 
-func (m *RetrieveViewResponse) GetIsEmailAddressConfirmed() bool {
+func (m *VerifyCredentialsRequest) GetPassword() string {
 	if m != nil {
-		return m.IsEmailAddressConfirmed
+		return m.Password
 	}
-	return false
+	return ""
 }
 
-func (m *RetrieveViewResponse) GetGivenName() string {
-	if m != nil {
-		return m.GivenName
-	}
-	return ""
+type VerifyCredentialsResponse struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RetrieveViewResponse) GetFamilyName() string {
-	if m != nil {
-		return m.FamilyName
-	}
-	return ""
+func (m *VerifyCredentialsResponse) Reset()         { *m = VerifyCredentialsResponse{} }
+func (m *VerifyCredentialsResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyCredentialsResponse) ProtoMessage()    {}
+func (*VerifyCredentialsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{8}
 }
 
-func (m *RetrieveViewResponse) GetVersion() uint64 {
+func (m *VerifyCredentialsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyCredentialsResponse.Unmarshal(m, b)
+}
+func (m *VerifyCredentialsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyCredentialsResponse.Marshal(b, m, deterministic)
+}
+func (m *VerifyCredentialsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyCredentialsResponse.Merge(m, src)
+}
+func (m *VerifyCredentialsResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyCredentialsResponse.Size(m)
+}
+func (m *VerifyCredentialsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyCredentialsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyCredentialsResponse proto.InternalMessageInfo
+
+func (m *VerifyCredentialsResponse) GetId() string {
 	if m != nil {
-		return m.Version
+		return m.Id
 	}
-	return 0
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*RegisterRequest)(nil), "customergrpc.RegisterRequest")
-	proto.RegisterType((*RegisterResponse)(nil), "customergrpc.RegisterResponse")
-	proto.RegisterType((*ConfirmEmailAddressRequest)(nil), "customergrpc.ConfirmEmailAddressRequest")
-	proto.RegisterType((*ChangeEmailAddressRequest)(nil), "customergrpc.ChangeEmailAddressRequest")
-	proto.RegisterType((*ChangeNameRequest)(nil), "customergrpc.ChangeNameRequest")
-	proto.RegisterType((*DeleteRequest)(nil), "customergrpc.DeleteRequest")
-	proto.RegisterType((*RetrieveViewRequest)(nil), "customergrpc.RetrieveViewRequest")
-	proto.RegisterType((*RetrieveViewResponse)(nil), "customergrpc.RetrieveViewResponse")
+type RequestAccountRecoveryRequest struct {
+	EmailAddress         string   `protobuf:"bytes,1,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("customer.proto", fileDescriptor_9efa92dae3d6ec46) }
-
-var fileDescriptor_9efa92dae3d6ec46 = []byte{
-	// 532 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x95, 0xdd, 0x0f, 0xd2, 0x51, 0x28, 0xcd, 0x06, 0xb5, 0xa9, 0x53, 0xd2, 0x76, 0x11, 0x10,
-	0x82, 0x64, 0xab, 0x70, 0x41, 0xdc, 0x50, 0xa8, 0xc4, 0x09, 0x24, 0x1f, 0x50, 0xaf, 0x9b, 0x78,
-	0xe2, 0xac, 0x14, 0x7b, 0x8d, 0xd7, 0x09, 0xaa, 0x10, 0x12, 0xe2, 0xc8, 0x95, 0xbf, 0xc2, 0xcf,
-	0xe0, 0xc6, 0x5f, 0xe0, 0x87, 0x20, 0xaf, 0x6d, 0xc5, 0x1f, 0xdd, 0xa8, 0x52, 0x8f, 0x3b, 0xf3,
-	0x34, 0xef, 0xcd, 0xec, 0x7b, 0xb0, 0x3f, 0x5d, 0xca, 0x44, 0x04, 0x18, 0xdb, 0x51, 0x2c, 0x12,
-	0x41, 0xda, 0xc5, 0xdb, 0x8f, 0xa3, 0xa9, 0xd5, 0xf7, 0x85, 0xf0, 0x17, 0xe8, 0xa8, 0xde, 0x64,
-	0x39, 0x73, 0x30, 0x88, 0x92, 0xeb, 0x0c, 0x6a, 0x9d, 0xe4, 0x4d, 0x16, 0x71, 0x87, 0x85, 0xa1,
-	0x48, 0x58, 0xc2, 0x45, 0x28, 0xb3, 0x2e, 0x95, 0xf0, 0xc0, 0x45, 0x9f, 0xcb, 0x04, 0x63, 0x17,
-	0x3f, 0x2f, 0x51, 0x26, 0x84, 0x42, 0x1b, 0x03, 0xc6, 0x17, 0x6f, 0x3d, 0x2f, 0x46, 0x29, 0x7b,
-	0xc6, 0x99, 0x31, 0xdc, 0x73, 0x2b, 0x35, 0x72, 0x02, 0x7b, 0x3e, 0x5f, 0x61, 0xf8, 0x81, 0x05,
-	0xd8, 0x33, 0x15, 0x60, 0x5d, 0x20, 0x03, 0x80, 0x19, 0x0b, 0xf8, 0xe2, 0x5a, 0xb5, 0xb7, 0x54,
-	0xbb, 0x54, 0xa1, 0x14, 0x0e, 0xd6, 0xa4, 0x32, 0x12, 0xa1, 0x44, 0xb2, 0x0f, 0x26, 0xf7, 0x72,
-	0x2e, 0x93, 0x7b, 0xf4, 0x0a, 0xac, 0xb1, 0x08, 0x67, 0x3c, 0x0e, 0x2e, 0x4b, 0xc4, 0x85, 0xc6,
-	0x1a, 0x9a, 0x8c, 0xe0, 0x60, 0x9a, 0xa1, 0xd5, 0x76, 0xef, 0x99, 0x9c, 0xe7, 0xb2, 0x1a, 0x75,
-	0xfa, 0x11, 0x8e, 0xc7, 0x73, 0x16, 0xfa, 0x78, 0x9b, 0xc1, 0xf5, 0x63, 0x98, 0xcd, 0x63, 0x50,
-	0x06, 0x9d, 0x6c, 0x60, 0xba, 0x9c, 0x6e, 0xd0, 0xdd, 0x2e, 0x76, 0x0a, 0xf7, 0xdf, 0xe1, 0x02,
-	0x13, 0xdd, 0x78, 0xfa, 0x04, 0xba, 0x2e, 0x26, 0x31, 0xc7, 0x15, 0x7e, 0xe2, 0xf8, 0x45, 0x07,
-	0xfb, 0x63, 0xc0, 0xc3, 0x2a, 0x2e, 0x3f, 0xff, 0x6d, 0x3e, 0xfd, 0x35, 0x1c, 0x71, 0x59, 0x3e,
-	0x5a, 0xfe, 0x41, 0xe8, 0xa9, 0x85, 0x5a, 0xae, 0xae, 0x5d, 0x5d, 0x7e, 0x6b, 0xf3, 0xf2, 0xdb,
-	0xf5, 0xe5, 0x49, 0x0f, 0xee, 0xad, 0x30, 0x96, 0x5c, 0x84, 0xbd, 0x9d, 0x33, 0x63, 0xb8, 0xed,
-	0x16, 0xcf, 0x97, 0xbf, 0x77, 0xa0, 0x35, 0xce, 0x93, 0x40, 0x26, 0xd0, 0x2a, 0x5c, 0x45, 0x1e,
-	0xd9, 0xe5, 0x80, 0xd8, 0x35, 0x8b, 0x5b, 0x03, 0x5d, 0x3b, 0xbb, 0x06, 0x3d, 0xfa, 0xf1, 0xf7,
-	0xdf, 0x2f, 0xb3, 0x43, 0xdb, 0xce, 0xea, 0xc2, 0x29, 0xa0, 0x6f, 0x8c, 0x11, 0xf9, 0x69, 0x40,
-	0xf7, 0x06, 0x5b, 0x92, 0x61, 0x75, 0xa0, 0xde, 0xb9, 0xd6, 0xa1, 0x9d, 0xe5, 0xd1, 0x2e, 0xc2,
-	0x6a, 0x5f, 0xa6, 0x61, 0xa5, 0x17, 0x8a, 0xf2, 0x85, 0xf5, 0xb4, 0x4c, 0xe9, 0x7c, 0xe5, 0xde,
-	0x37, 0x47, 0x7d, 0x02, 0xcb, 0xc6, 0x38, 0xb9, 0x9d, 0x53, 0x31, 0xdf, 0x0d, 0x20, 0x4d, 0x27,
-	0x93, 0x67, 0x35, 0x2d, 0x3a, 0xaf, 0x6b, 0xa5, 0x3c, 0x57, 0x52, 0x1e, 0x5b, 0x83, 0xcd, 0x52,
-	0x52, 0x09, 0x73, 0x80, 0xb5, 0xf5, 0xc9, 0xe9, 0x4d, 0xcc, 0xa5, 0x50, 0x68, 0x19, 0xcf, 0x15,
-	0x63, 0xdf, 0x3a, 0x6c, 0x32, 0x86, 0x2c, 0xc0, 0x94, 0xe9, 0x0a, 0x76, 0xb3, 0x04, 0x90, 0x7e,
-	0x95, 0xa5, 0x92, 0x0b, 0x2d, 0xc3, 0xb1, 0x62, 0xe8, 0x8e, 0x3a, 0x0d, 0x06, 0x12, 0x41, 0xbb,
-	0x1c, 0x09, 0x72, 0x5e, 0x37, 0x47, 0x23, 0x56, 0x16, 0xdd, 0x04, 0xc9, 0x3d, 0x94, 0x33, 0x92,
-	0x26, 0xe3, 0x64, 0x57, 0x89, 0x7b, 0xf5, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x01, 0xcd, 0xf1, 0x14,
-	0xd6, 0x05, 0x00, 0x00,
+func (m *RequestAccountRecoveryRequest) Reset()         { *m = RequestAccountRecoveryRequest{} }
+func (m *RequestAccountRecoveryRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestAccountRecoveryRequest) ProtoMessage()    {}
+func (*RequestAccountRecoveryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{9}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *RequestAccountRecoveryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestAccountRecoveryRequest.Unmarshal(m, b)
+}
+func (m *RequestAccountRecoveryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestAccountRecoveryRequest.Marshal(b, m, deterministic)
+}
+func (m *RequestAccountRecoveryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestAccountRecoveryRequest.Merge(m, src)
+}
+func (m *RequestAccountRecoveryRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestAccountRecoveryRequest.Size(m)
+}
+func (m *RequestAccountRecoveryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestAccountRecoveryRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_RequestAccountRecoveryRequest proto.InternalMessageInfo
 
-// CustomerClient is the client API for Customer service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type CustomerClient interface {
-	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
-	ConfirmEmailAddress(ctx context.Context, in *ConfirmEmailAddressRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	ChangeEmailAddress(ctx context.Context, in *ChangeEmailAddressRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	ChangeName(ctx context.Context, in *ChangeNameRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	RetrieveView(ctx context.Context, in *RetrieveViewRequest, opts ...grpc.CallOption) (*RetrieveViewResponse, error)
+func (m *RequestAccountRecoveryRequest) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
 }
 
-type customerClient struct {
-	cc *grpc.ClientConn
+type CompleteAccountRecoveryRequest struct {
+	EmailAddress         string   `protobuf:"bytes,1,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	RecoveryToken        string   `protobuf:"bytes,2,opt,name=recoveryToken,proto3" json:"recoveryToken,omitempty"`
+	NewPassword          string   `protobuf:"bytes,3,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewCustomerClient(cc *grpc.ClientConn) CustomerClient {
-	return &customerClient{cc}
+func (m *CompleteAccountRecoveryRequest) Reset()         { *m = CompleteAccountRecoveryRequest{} }
+func (m *CompleteAccountRecoveryRequest) String() string { return proto.CompactTextString(m) }
+func (*CompleteAccountRecoveryRequest) ProtoMessage()    {}
+func (*CompleteAccountRecoveryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{10}
 }
 
-func (c *customerClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
-	out := new(RegisterResponse)
-	err := c.cc.Invoke(ctx, "/customergrpc.Customer/Register", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *CompleteAccountRecoveryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompleteAccountRecoveryRequest.Unmarshal(m, b)
 }
-
-func (c *customerClient) ConfirmEmailAddress(ctx context.Context, in *ConfirmEmailAddressRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/customergrpc.Customer/ConfirmEmailAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *CompleteAccountRecoveryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompleteAccountRecoveryRequest.Marshal(b, m, deterministic)
 }
-
-func (c *customerClient) ChangeEmailAddress(ctx context.Context, in *ChangeEmailAddressRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/customergrpc.Customer/ChangeEmailAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *CompleteAccountRecoveryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompleteAccountRecoveryRequest.Merge(m, src)
+}
+func (m *CompleteAccountRecoveryRequest) XXX_Size() int {
+	return xxx_messageInfo_CompleteAccountRecoveryRequest.Size(m)
+}
+func (m *CompleteAccountRecoveryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompleteAccountRecoveryRequest.DiscardUnknown(m)
 }
 
-func (c *customerClient) ChangeName(ctx context.Context, in *ChangeNameRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/customergrpc.Customer/ChangeName", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CompleteAccountRecoveryRequest proto.InternalMessageInfo
+
+func (m *CompleteAccountRecoveryRequest) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *customerClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/customergrpc.Customer/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CompleteAccountRecoveryRequest) GetRecoveryToken() string {
+	if m != nil {
+		return m.RecoveryToken
 	}
-	return out, nil
+	return ""
 }
 
-func (c *customerClient) RetrieveView(ctx context.Context, in *RetrieveViewRequest, opts ...grpc.CallOption) (*RetrieveViewResponse, error) {
-	out := new(RetrieveViewResponse)
-	err := c.cc.Invoke(ctx, "/customergrpc.Customer/RetrieveView", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CompleteAccountRecoveryRequest) GetNewPassword() string {
+	if m != nil {
+		return m.NewPassword
 	}
-	return out, nil
+	return ""
 }
 
-// CustomerServer is the server API for Customer service.
-type CustomerServer interface {
-	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
-	ConfirmEmailAddress(context.Context, *ConfirmEmailAddressRequest) (*empty.Empty, error)
-	ChangeEmailAddress(context.Context, *ChangeEmailAddressRequest) (*empty.Empty, error)
-	ChangeName(context.Context, *ChangeNameRequest) (*empty.Empty, error)
-	Delete(context.Context, *DeleteRequest) (*empty.Empty, error)
-	RetrieveView(context.Context, *RetrieveViewRequest) (*RetrieveViewResponse, error)
+type StartMFAEnrollmentRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// UnimplementedCustomerServer can be embedded to have forward compatible implementations.
-type UnimplementedCustomerServer struct {
+func (m *StartMFAEnrollmentRequest) Reset()         { *m = StartMFAEnrollmentRequest{} }
+func (m *StartMFAEnrollmentRequest) String() string { return proto.CompactTextString(m) }
+func (*StartMFAEnrollmentRequest) ProtoMessage()    {}
+func (*StartMFAEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{11}
 }
 
-func (*UnimplementedCustomerServer) Register(ctx context.Context, req *RegisterRequest) (*RegisterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+func (m *StartMFAEnrollmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartMFAEnrollmentRequest.Unmarshal(m, b)
 }
-func (*UnimplementedCustomerServer) ConfirmEmailAddress(ctx context.Context, req *ConfirmEmailAddressRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConfirmEmailAddress not implemented")
-}
-func (*UnimplementedCustomerServer) ChangeEmailAddress(ctx context.Context, req *ChangeEmailAddressRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeEmailAddress not implemented")
+func (m *StartMFAEnrollmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartMFAEnrollmentRequest.Marshal(b, m, deterministic)
 }
-func (*UnimplementedCustomerServer) ChangeName(ctx context.Context, req *ChangeNameRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeName not implemented")
+func (m *StartMFAEnrollmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartMFAEnrollmentRequest.Merge(m, src)
 }
-func (*UnimplementedCustomerServer) Delete(ctx context.Context, req *DeleteRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func (m *StartMFAEnrollmentRequest) XXX_Size() int {
+	return xxx_messageInfo_StartMFAEnrollmentRequest.Size(m)
 }
-func (*UnimplementedCustomerServer) RetrieveView(ctx context.Context, req *RetrieveViewRequest) (*RetrieveViewResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RetrieveView not implemented")
+func (m *StartMFAEnrollmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartMFAEnrollmentRequest.DiscardUnknown(m)
 }
 
-func RegisterCustomerServer(s *grpc.Server, srv CustomerServer) {
-	s.RegisterService(&_Customer_serviceDesc, srv)
-}
+var xxx_messageInfo_StartMFAEnrollmentRequest proto.InternalMessageInfo
 
-func _Customer_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
+func (m *StartMFAEnrollmentRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type StartMFAEnrollmentResponse struct {
+	TotpSecret           string   `protobuf:"bytes,1,opt,name=totpSecret,proto3" json:"totpSecret,omitempty"`
+	RecoveryCodes        []string `protobuf:"bytes,2,rep,name=recoveryCodes,proto3" json:"recoveryCodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartMFAEnrollmentResponse) Reset()         { *m = StartMFAEnrollmentResponse{} }
+func (m *StartMFAEnrollmentResponse) String() string { return proto.CompactTextString(m) }
+func (*StartMFAEnrollmentResponse) ProtoMessage()    {}
+func (*StartMFAEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{12}
+}
+
+func (m *StartMFAEnrollmentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartMFAEnrollmentResponse.Unmarshal(m, b)
+}
+func (m *StartMFAEnrollmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartMFAEnrollmentResponse.Marshal(b, m, deterministic)
+}
+func (m *StartMFAEnrollmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartMFAEnrollmentResponse.Merge(m, src)
+}
+func (m *StartMFAEnrollmentResponse) XXX_Size() int {
+	return xxx_messageInfo_StartMFAEnrollmentResponse.Size(m)
+}
+func (m *StartMFAEnrollmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartMFAEnrollmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartMFAEnrollmentResponse proto.InternalMessageInfo
+
+func (m *StartMFAEnrollmentResponse) GetTotpSecret() string {
+	if m != nil {
+		return m.TotpSecret
+	}
+	return ""
+}
+
+func (m *StartMFAEnrollmentResponse) GetRecoveryCodes() []string {
+	if m != nil {
+		return m.RecoveryCodes
+	}
+	return nil
+}
+
+type ConfirmMFAEnrollmentRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	ExpectedVersion      uint64   `protobuf:"varint,3,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfirmMFAEnrollmentRequest) Reset()         { *m = ConfirmMFAEnrollmentRequest{} }
+func (m *ConfirmMFAEnrollmentRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfirmMFAEnrollmentRequest) ProtoMessage()    {}
+func (*ConfirmMFAEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{13}
+}
+
+func (m *ConfirmMFAEnrollmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmMFAEnrollmentRequest.Unmarshal(m, b)
+}
+func (m *ConfirmMFAEnrollmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmMFAEnrollmentRequest.Marshal(b, m, deterministic)
+}
+func (m *ConfirmMFAEnrollmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmMFAEnrollmentRequest.Merge(m, src)
+}
+func (m *ConfirmMFAEnrollmentRequest) XXX_Size() int {
+	return xxx_messageInfo_ConfirmMFAEnrollmentRequest.Size(m)
+}
+func (m *ConfirmMFAEnrollmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmMFAEnrollmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmMFAEnrollmentRequest proto.InternalMessageInfo
+
+func (m *ConfirmMFAEnrollmentRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ConfirmMFAEnrollmentRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *ConfirmMFAEnrollmentRequest) GetExpectedVersion() uint64 {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return 0
+}
+
+type DisableMFARequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	ExpectedVersion      uint64   `protobuf:"varint,3,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisableMFARequest) Reset()         { *m = DisableMFARequest{} }
+func (m *DisableMFARequest) String() string { return proto.CompactTextString(m) }
+func (*DisableMFARequest) ProtoMessage()    {}
+func (*DisableMFARequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{14}
+}
+
+func (m *DisableMFARequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisableMFARequest.Unmarshal(m, b)
+}
+func (m *DisableMFARequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisableMFARequest.Marshal(b, m, deterministic)
+}
+func (m *DisableMFARequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableMFARequest.Merge(m, src)
+}
+func (m *DisableMFARequest) XXX_Size() int {
+	return xxx_messageInfo_DisableMFARequest.Size(m)
+}
+func (m *DisableMFARequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableMFARequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisableMFARequest proto.InternalMessageInfo
+
+func (m *DisableMFARequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DisableMFARequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *DisableMFARequest) GetExpectedVersion() uint64 {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return 0
+}
+
+type VerifyMFACodeRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyMFACodeRequest) Reset()         { *m = VerifyMFACodeRequest{} }
+func (m *VerifyMFACodeRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyMFACodeRequest) ProtoMessage()    {}
+func (*VerifyMFACodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{15}
+}
+
+func (m *VerifyMFACodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyMFACodeRequest.Unmarshal(m, b)
+}
+func (m *VerifyMFACodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyMFACodeRequest.Marshal(b, m, deterministic)
+}
+func (m *VerifyMFACodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyMFACodeRequest.Merge(m, src)
+}
+func (m *VerifyMFACodeRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyMFACodeRequest.Size(m)
+}
+func (m *VerifyMFACodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyMFACodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyMFACodeRequest proto.InternalMessageInfo
+
+func (m *VerifyMFACodeRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *VerifyMFACodeRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type SetAttributeRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	ExpectedVersion      uint64   `protobuf:"varint,4,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetAttributeRequest) Reset()         { *m = SetAttributeRequest{} }
+func (m *SetAttributeRequest) String() string { return proto.CompactTextString(m) }
+func (*SetAttributeRequest) ProtoMessage()    {}
+func (*SetAttributeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{16}
+}
+
+func (m *SetAttributeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAttributeRequest.Unmarshal(m, b)
+}
+func (m *SetAttributeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAttributeRequest.Marshal(b, m, deterministic)
+}
+func (m *SetAttributeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAttributeRequest.Merge(m, src)
+}
+func (m *SetAttributeRequest) XXX_Size() int {
+	return xxx_messageInfo_SetAttributeRequest.Size(m)
+}
+func (m *SetAttributeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAttributeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAttributeRequest proto.InternalMessageInfo
+
+func (m *SetAttributeRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SetAttributeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SetAttributeRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *SetAttributeRequest) GetExpectedVersion() uint64 {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return 0
+}
+
+type DefineAttributeRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	ValidationPattern    string   `protobuf:"bytes,3,opt,name=validationPattern,proto3" json:"validationPattern,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DefineAttributeRequest) Reset()         { *m = DefineAttributeRequest{} }
+func (m *DefineAttributeRequest) String() string { return proto.CompactTextString(m) }
+func (*DefineAttributeRequest) ProtoMessage()    {}
+func (*DefineAttributeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{17}
+}
+
+func (m *DefineAttributeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DefineAttributeRequest.Unmarshal(m, b)
+}
+func (m *DefineAttributeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DefineAttributeRequest.Marshal(b, m, deterministic)
+}
+func (m *DefineAttributeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DefineAttributeRequest.Merge(m, src)
+}
+func (m *DefineAttributeRequest) XXX_Size() int {
+	return xxx_messageInfo_DefineAttributeRequest.Size(m)
+}
+func (m *DefineAttributeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DefineAttributeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DefineAttributeRequest proto.InternalMessageInfo
+
+func (m *DefineAttributeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DefineAttributeRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *DefineAttributeRequest) GetValidationPattern() string {
+	if m != nil {
+		return m.ValidationPattern
+	}
+	return ""
+}
+
+type DeleteRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExpectedVersion      uint64   `protobuf:"varint,2,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()    {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{18}
+}
+
+func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+}
+func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRequest.Merge(m, src)
+}
+func (m *DeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRequest.Size(m)
+}
+func (m *DeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+
+func (m *DeleteRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetExpectedVersion() uint64 {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return 0
+}
+
+type RetrieveViewRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetrieveViewRequest) Reset()         { *m = RetrieveViewRequest{} }
+func (m *RetrieveViewRequest) String() string { return proto.CompactTextString(m) }
+func (*RetrieveViewRequest) ProtoMessage()    {}
+func (*RetrieveViewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{19}
+}
+
+func (m *RetrieveViewRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetrieveViewRequest.Unmarshal(m, b)
+}
+func (m *RetrieveViewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetrieveViewRequest.Marshal(b, m, deterministic)
+}
+func (m *RetrieveViewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetrieveViewRequest.Merge(m, src)
+}
+func (m *RetrieveViewRequest) XXX_Size() int {
+	return xxx_messageInfo_RetrieveViewRequest.Size(m)
+}
+func (m *RetrieveViewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetrieveViewRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetrieveViewRequest proto.InternalMessageInfo
+
+func (m *RetrieveViewRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type RetrieveViewResponse struct {
+	EmailAddress            string            `protobuf:"bytes,1,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	IsEmailAddressConfirmed bool              `protobuf:"varint,2,opt,name=isEmailAddressConfirmed,proto3" json:"isEmailAddressConfirmed,omitempty"`
+	GivenName               string            `protobuf:"bytes,3,opt,name=givenName,proto3" json:"givenName,omitempty"`
+	FamilyName              string            `protobuf:"bytes,4,opt,name=familyName,proto3" json:"familyName,omitempty"`
+	Version                 uint64            `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	CustomAttributes        map[string]string `protobuf:"bytes,6,rep,name=customAttributes,proto3" json:"customAttributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	IsMFAEnabled            bool              `protobuf:"varint,7,opt,name=isMFAEnabled,proto3" json:"isMFAEnabled,omitempty"`
+	Id                      string            `protobuf:"bytes,8,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}          `json:"-"`
+	XXX_unrecognized        []byte            `json:"-"`
+	XXX_sizecache           int32             `json:"-"`
+}
+
+func (m *RetrieveViewResponse) Reset()         { *m = RetrieveViewResponse{} }
+func (m *RetrieveViewResponse) String() string { return proto.CompactTextString(m) }
+func (*RetrieveViewResponse) ProtoMessage()    {}
+func (*RetrieveViewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{20}
+}
+
+func (m *RetrieveViewResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetrieveViewResponse.Unmarshal(m, b)
+}
+func (m *RetrieveViewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetrieveViewResponse.Marshal(b, m, deterministic)
+}
+func (m *RetrieveViewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetrieveViewResponse.Merge(m, src)
+}
+func (m *RetrieveViewResponse) XXX_Size() int {
+	return xxx_messageInfo_RetrieveViewResponse.Size(m)
+}
+func (m *RetrieveViewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetrieveViewResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetrieveViewResponse proto.InternalMessageInfo
+
+func (m *RetrieveViewResponse) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
+}
+
+func (m *RetrieveViewResponse) GetIsEmailAddressConfirmed() bool {
+	if m != nil {
+		return m.IsEmailAddressConfirmed
+	}
+	return false
+}
+
+func (m *RetrieveViewResponse) GetGivenName() string {
+	if m != nil {
+		return m.GivenName
+	}
+	return ""
+}
+
+func (m *RetrieveViewResponse) GetFamilyName() string {
+	if m != nil {
+		return m.FamilyName
+	}
+	return ""
+}
+
+func (m *RetrieveViewResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RetrieveViewResponse) GetCustomAttributes() map[string]string {
+	if m != nil {
+		return m.CustomAttributes
+	}
+	return nil
+}
+
+func (m *RetrieveViewResponse) GetIsMFAEnabled() bool {
+	if m != nil {
+		return m.IsMFAEnabled
+	}
+	return false
+}
+
+func (m *RetrieveViewResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type RetrieveViewByEmailAddressRequest struct {
+	EmailAddress         string   `protobuf:"bytes,1,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetrieveViewByEmailAddressRequest) Reset()         { *m = RetrieveViewByEmailAddressRequest{} }
+func (m *RetrieveViewByEmailAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*RetrieveViewByEmailAddressRequest) ProtoMessage()    {}
+func (*RetrieveViewByEmailAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{21}
+}
+
+func (m *RetrieveViewByEmailAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetrieveViewByEmailAddressRequest.Unmarshal(m, b)
+}
+func (m *RetrieveViewByEmailAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetrieveViewByEmailAddressRequest.Marshal(b, m, deterministic)
+}
+func (m *RetrieveViewByEmailAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetrieveViewByEmailAddressRequest.Merge(m, src)
+}
+func (m *RetrieveViewByEmailAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_RetrieveViewByEmailAddressRequest.Size(m)
+}
+func (m *RetrieveViewByEmailAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetrieveViewByEmailAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetrieveViewByEmailAddressRequest proto.InternalMessageInfo
+
+func (m *RetrieveViewByEmailAddressRequest) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
+}
+
+type ExportCustomerDataRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Format               string   `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportCustomerDataRequest) Reset()         { *m = ExportCustomerDataRequest{} }
+func (m *ExportCustomerDataRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportCustomerDataRequest) ProtoMessage()    {}
+func (*ExportCustomerDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{22}
+}
+
+func (m *ExportCustomerDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportCustomerDataRequest.Unmarshal(m, b)
+}
+func (m *ExportCustomerDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportCustomerDataRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportCustomerDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportCustomerDataRequest.Merge(m, src)
+}
+func (m *ExportCustomerDataRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportCustomerDataRequest.Size(m)
+}
+func (m *ExportCustomerDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportCustomerDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportCustomerDataRequest proto.InternalMessageInfo
+
+func (m *ExportCustomerDataRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ExportCustomerDataRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+type ListCustomersRequest struct {
+	ConfirmationStatus   string   `protobuf:"bytes,1,opt,name=confirmationStatus,proto3" json:"confirmationStatus,omitempty"`
+	State                string   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	RegisteredFrom       string   `protobuf:"bytes,3,opt,name=registeredFrom,proto3" json:"registeredFrom,omitempty"`
+	RegisteredUntil      string   `protobuf:"bytes,4,opt,name=registeredUntil,proto3" json:"registeredUntil,omitempty"`
+	SortBy               string   `protobuf:"bytes,5,opt,name=sortBy,proto3" json:"sortBy,omitempty"`
+	SortDescending       bool     `protobuf:"varint,6,opt,name=sortDescending,proto3" json:"sortDescending,omitempty"`
+	PageSize             uint32   `protobuf:"varint,7,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	Cursor               string   `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCustomersRequest) Reset()         { *m = ListCustomersRequest{} }
+func (m *ListCustomersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCustomersRequest) ProtoMessage()    {}
+func (*ListCustomersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{23}
+}
+
+func (m *ListCustomersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCustomersRequest.Unmarshal(m, b)
+}
+func (m *ListCustomersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCustomersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCustomersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCustomersRequest.Merge(m, src)
+}
+func (m *ListCustomersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCustomersRequest.Size(m)
+}
+func (m *ListCustomersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCustomersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCustomersRequest proto.InternalMessageInfo
+
+func (m *ListCustomersRequest) GetConfirmationStatus() string {
+	if m != nil {
+		return m.ConfirmationStatus
+	}
+	return ""
+}
+
+func (m *ListCustomersRequest) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ListCustomersRequest) GetRegisteredFrom() string {
+	if m != nil {
+		return m.RegisteredFrom
+	}
+	return ""
+}
+
+func (m *ListCustomersRequest) GetRegisteredUntil() string {
+	if m != nil {
+		return m.RegisteredUntil
+	}
+	return ""
+}
+
+func (m *ListCustomersRequest) GetSortBy() string {
+	if m != nil {
+		return m.SortBy
+	}
+	return ""
+}
+
+func (m *ListCustomersRequest) GetSortDescending() bool {
+	if m != nil {
+		return m.SortDescending
+	}
+	return false
+}
+
+func (m *ListCustomersRequest) GetPageSize() uint32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListCustomersRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type ListCustomersResponse struct {
+	Entries              []*CustomerListEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextCursor           string               `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListCustomersResponse) Reset()         { *m = ListCustomersResponse{} }
+func (m *ListCustomersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCustomersResponse) ProtoMessage()    {}
+func (*ListCustomersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{24}
+}
+
+func (m *ListCustomersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCustomersResponse.Unmarshal(m, b)
+}
+func (m *ListCustomersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCustomersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCustomersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCustomersResponse.Merge(m, src)
+}
+func (m *ListCustomersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCustomersResponse.Size(m)
+}
+func (m *ListCustomersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCustomersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCustomersResponse proto.InternalMessageInfo
+
+func (m *ListCustomersResponse) GetEntries() []*CustomerListEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *ListCustomersResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type CustomerListEntry struct {
+	Id                      string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmailAddress            string   `protobuf:"bytes,2,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	IsEmailAddressConfirmed bool     `protobuf:"varint,3,opt,name=isEmailAddressConfirmed,proto3" json:"isEmailAddressConfirmed,omitempty"`
+	GivenName               string   `protobuf:"bytes,4,opt,name=givenName,proto3" json:"givenName,omitempty"`
+	FamilyName              string   `protobuf:"bytes,5,opt,name=familyName,proto3" json:"familyName,omitempty"`
+	IsDeleted               bool     `protobuf:"varint,6,opt,name=isDeleted,proto3" json:"isDeleted,omitempty"`
+	RegisteredAt            string   `protobuf:"bytes,7,opt,name=registeredAt,proto3" json:"registeredAt,omitempty"`
+	Version                 uint64   `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
+}
+
+func (m *CustomerListEntry) Reset()         { *m = CustomerListEntry{} }
+func (m *CustomerListEntry) String() string { return proto.CompactTextString(m) }
+func (*CustomerListEntry) ProtoMessage()    {}
+func (*CustomerListEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{25}
+}
+
+func (m *CustomerListEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomerListEntry.Unmarshal(m, b)
+}
+func (m *CustomerListEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomerListEntry.Marshal(b, m, deterministic)
+}
+func (m *CustomerListEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomerListEntry.Merge(m, src)
+}
+func (m *CustomerListEntry) XXX_Size() int {
+	return xxx_messageInfo_CustomerListEntry.Size(m)
+}
+func (m *CustomerListEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomerListEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomerListEntry proto.InternalMessageInfo
+
+func (m *CustomerListEntry) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CustomerListEntry) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
+}
+
+func (m *CustomerListEntry) GetIsEmailAddressConfirmed() bool {
+	if m != nil {
+		return m.IsEmailAddressConfirmed
+	}
+	return false
+}
+
+func (m *CustomerListEntry) GetGivenName() string {
+	if m != nil {
+		return m.GivenName
+	}
+	return ""
+}
+
+func (m *CustomerListEntry) GetFamilyName() string {
+	if m != nil {
+		return m.FamilyName
+	}
+	return ""
+}
+
+func (m *CustomerListEntry) GetIsDeleted() bool {
+	if m != nil {
+		return m.IsDeleted
+	}
+	return false
+}
+
+func (m *CustomerListEntry) GetRegisteredAt() string {
+	if m != nil {
+		return m.RegisteredAt
+	}
+	return ""
+}
+
+func (m *CustomerListEntry) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type SearchCustomersRequest struct {
+	Query                string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	MaxResults           uint32   `protobuf:"varint,2,opt,name=maxResults,proto3" json:"maxResults,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchCustomersRequest) Reset()         { *m = SearchCustomersRequest{} }
+func (m *SearchCustomersRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchCustomersRequest) ProtoMessage()    {}
+func (*SearchCustomersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{26}
+}
+
+func (m *SearchCustomersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchCustomersRequest.Unmarshal(m, b)
+}
+func (m *SearchCustomersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchCustomersRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchCustomersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchCustomersRequest.Merge(m, src)
+}
+func (m *SearchCustomersRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchCustomersRequest.Size(m)
+}
+func (m *SearchCustomersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchCustomersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchCustomersRequest proto.InternalMessageInfo
+
+func (m *SearchCustomersRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SearchCustomersRequest) GetMaxResults() uint32 {
+	if m != nil {
+		return m.MaxResults
+	}
+	return 0
+}
+
+type SearchCustomersResponse struct {
+	Entries              []*CustomerListEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SearchCustomersResponse) Reset()         { *m = SearchCustomersResponse{} }
+func (m *SearchCustomersResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchCustomersResponse) ProtoMessage()    {}
+func (*SearchCustomersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{27}
+}
+
+func (m *SearchCustomersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchCustomersResponse.Unmarshal(m, b)
+}
+func (m *SearchCustomersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchCustomersResponse.Marshal(b, m, deterministic)
+}
+func (m *SearchCustomersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchCustomersResponse.Merge(m, src)
+}
+func (m *SearchCustomersResponse) XXX_Size() int {
+	return xxx_messageInfo_SearchCustomersResponse.Size(m)
+}
+func (m *SearchCustomersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchCustomersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchCustomersResponse proto.InternalMessageInfo
+
+func (m *SearchCustomersResponse) GetEntries() []*CustomerListEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type ListDuplicateCandidatesRequest struct {
+	IncludeReviewed      bool     `protobuf:"varint,1,opt,name=includeReviewed,proto3" json:"includeReviewed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDuplicateCandidatesRequest) Reset()         { *m = ListDuplicateCandidatesRequest{} }
+func (m *ListDuplicateCandidatesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDuplicateCandidatesRequest) ProtoMessage()    {}
+func (*ListDuplicateCandidatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{28}
+}
+
+func (m *ListDuplicateCandidatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDuplicateCandidatesRequest.Unmarshal(m, b)
+}
+func (m *ListDuplicateCandidatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDuplicateCandidatesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDuplicateCandidatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDuplicateCandidatesRequest.Merge(m, src)
+}
+func (m *ListDuplicateCandidatesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDuplicateCandidatesRequest.Size(m)
+}
+func (m *ListDuplicateCandidatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDuplicateCandidatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDuplicateCandidatesRequest proto.InternalMessageInfo
+
+func (m *ListDuplicateCandidatesRequest) GetIncludeReviewed() bool {
+	if m != nil {
+		return m.IncludeReviewed
+	}
+	return false
+}
+
+type ListDuplicateCandidatesResponse struct {
+	Candidates           []*DuplicateCandidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ListDuplicateCandidatesResponse) Reset()         { *m = ListDuplicateCandidatesResponse{} }
+func (m *ListDuplicateCandidatesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDuplicateCandidatesResponse) ProtoMessage()    {}
+func (*ListDuplicateCandidatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{29}
+}
+
+func (m *ListDuplicateCandidatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDuplicateCandidatesResponse.Unmarshal(m, b)
+}
+func (m *ListDuplicateCandidatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDuplicateCandidatesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDuplicateCandidatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDuplicateCandidatesResponse.Merge(m, src)
+}
+func (m *ListDuplicateCandidatesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDuplicateCandidatesResponse.Size(m)
+}
+func (m *ListDuplicateCandidatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDuplicateCandidatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDuplicateCandidatesResponse proto.InternalMessageInfo
+
+func (m *ListDuplicateCandidatesResponse) GetCandidates() []*DuplicateCandidate {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+type DuplicateCandidate struct {
+	Customer             *CustomerListEntry `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	OtherCustomer        *CustomerListEntry `protobuf:"bytes,2,opt,name=otherCustomer,proto3" json:"otherCustomer,omitempty"`
+	Reason               string             `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Similarity           float64            `protobuf:"fixed64,4,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	IsNotDuplicate       bool               `protobuf:"varint,5,opt,name=isNotDuplicate,proto3" json:"isNotDuplicate,omitempty"`
+	DetectedAt           string             `protobuf:"bytes,6,opt,name=detectedAt,proto3" json:"detectedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DuplicateCandidate) Reset()         { *m = DuplicateCandidate{} }
+func (m *DuplicateCandidate) String() string { return proto.CompactTextString(m) }
+func (*DuplicateCandidate) ProtoMessage()    {}
+func (*DuplicateCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{30}
+}
+
+func (m *DuplicateCandidate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DuplicateCandidate.Unmarshal(m, b)
+}
+func (m *DuplicateCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DuplicateCandidate.Marshal(b, m, deterministic)
+}
+func (m *DuplicateCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicateCandidate.Merge(m, src)
+}
+func (m *DuplicateCandidate) XXX_Size() int {
+	return xxx_messageInfo_DuplicateCandidate.Size(m)
+}
+func (m *DuplicateCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicateCandidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DuplicateCandidate proto.InternalMessageInfo
+
+func (m *DuplicateCandidate) GetCustomer() *CustomerListEntry {
+	if m != nil {
+		return m.Customer
+	}
+	return nil
+}
+
+func (m *DuplicateCandidate) GetOtherCustomer() *CustomerListEntry {
+	if m != nil {
+		return m.OtherCustomer
+	}
+	return nil
+}
+
+func (m *DuplicateCandidate) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *DuplicateCandidate) GetSimilarity() float64 {
+	if m != nil {
+		return m.Similarity
+	}
+	return 0
+}
+
+func (m *DuplicateCandidate) GetIsNotDuplicate() bool {
+	if m != nil {
+		return m.IsNotDuplicate
+	}
+	return false
+}
+
+func (m *DuplicateCandidate) GetDetectedAt() string {
+	if m != nil {
+		return m.DetectedAt
+	}
+	return ""
+}
+
+type MarkAsNotDuplicateRequest struct {
+	CustomerId           string   `protobuf:"bytes,1,opt,name=customerId,proto3" json:"customerId,omitempty"`
+	OtherCustomerId      string   `protobuf:"bytes,2,opt,name=otherCustomerId,proto3" json:"otherCustomerId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarkAsNotDuplicateRequest) Reset()         { *m = MarkAsNotDuplicateRequest{} }
+func (m *MarkAsNotDuplicateRequest) String() string { return proto.CompactTextString(m) }
+func (*MarkAsNotDuplicateRequest) ProtoMessage()    {}
+func (*MarkAsNotDuplicateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{31}
+}
+
+func (m *MarkAsNotDuplicateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarkAsNotDuplicateRequest.Unmarshal(m, b)
+}
+func (m *MarkAsNotDuplicateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarkAsNotDuplicateRequest.Marshal(b, m, deterministic)
+}
+func (m *MarkAsNotDuplicateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkAsNotDuplicateRequest.Merge(m, src)
+}
+func (m *MarkAsNotDuplicateRequest) XXX_Size() int {
+	return xxx_messageInfo_MarkAsNotDuplicateRequest.Size(m)
+}
+func (m *MarkAsNotDuplicateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkAsNotDuplicateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkAsNotDuplicateRequest proto.InternalMessageInfo
+
+func (m *MarkAsNotDuplicateRequest) GetCustomerId() string {
+	if m != nil {
+		return m.CustomerId
+	}
+	return ""
+}
+
+func (m *MarkAsNotDuplicateRequest) GetOtherCustomerId() string {
+	if m != nil {
+		return m.OtherCustomerId
+	}
+	return ""
+}
+
+type RetrieveRegistrationFunnelRequest struct {
+	Interval             string   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	From                 string   `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Until                string   `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetrieveRegistrationFunnelRequest) Reset()         { *m = RetrieveRegistrationFunnelRequest{} }
+func (m *RetrieveRegistrationFunnelRequest) String() string { return proto.CompactTextString(m) }
+func (*RetrieveRegistrationFunnelRequest) ProtoMessage()    {}
+func (*RetrieveRegistrationFunnelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{32}
+}
+
+func (m *RetrieveRegistrationFunnelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetrieveRegistrationFunnelRequest.Unmarshal(m, b)
+}
+func (m *RetrieveRegistrationFunnelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetrieveRegistrationFunnelRequest.Marshal(b, m, deterministic)
+}
+func (m *RetrieveRegistrationFunnelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetrieveRegistrationFunnelRequest.Merge(m, src)
+}
+func (m *RetrieveRegistrationFunnelRequest) XXX_Size() int {
+	return xxx_messageInfo_RetrieveRegistrationFunnelRequest.Size(m)
+}
+func (m *RetrieveRegistrationFunnelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetrieveRegistrationFunnelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetrieveRegistrationFunnelRequest proto.InternalMessageInfo
+
+func (m *RetrieveRegistrationFunnelRequest) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
+}
+
+func (m *RetrieveRegistrationFunnelRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *RetrieveRegistrationFunnelRequest) GetUntil() string {
+	if m != nil {
+		return m.Until
+	}
+	return ""
+}
+
+type RetrieveRegistrationFunnelResponse struct {
+	Periods              []*RegistrationFunnelPeriod `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *RetrieveRegistrationFunnelResponse) Reset()         { *m = RetrieveRegistrationFunnelResponse{} }
+func (m *RetrieveRegistrationFunnelResponse) String() string { return proto.CompactTextString(m) }
+func (*RetrieveRegistrationFunnelResponse) ProtoMessage()    {}
+func (*RetrieveRegistrationFunnelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{33}
+}
+
+func (m *RetrieveRegistrationFunnelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetrieveRegistrationFunnelResponse.Unmarshal(m, b)
+}
+func (m *RetrieveRegistrationFunnelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetrieveRegistrationFunnelResponse.Marshal(b, m, deterministic)
+}
+func (m *RetrieveRegistrationFunnelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetrieveRegistrationFunnelResponse.Merge(m, src)
+}
+func (m *RetrieveRegistrationFunnelResponse) XXX_Size() int {
+	return xxx_messageInfo_RetrieveRegistrationFunnelResponse.Size(m)
+}
+func (m *RetrieveRegistrationFunnelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetrieveRegistrationFunnelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetrieveRegistrationFunnelResponse proto.InternalMessageInfo
+
+func (m *RetrieveRegistrationFunnelResponse) GetPeriods() []*RegistrationFunnelPeriod {
+	if m != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+type RegistrationFunnelPeriod struct {
+	PeriodStart                  string   `protobuf:"bytes,1,opt,name=periodStart,proto3" json:"periodStart,omitempty"`
+	Registrations                uint64   `protobuf:"varint,2,opt,name=registrations,proto3" json:"registrations,omitempty"`
+	Confirmations                uint64   `protobuf:"varint,3,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	ConfirmationFailures         uint64   `protobuf:"varint,4,opt,name=confirmationFailures,proto3" json:"confirmationFailures,omitempty"`
+	ConfirmationFailureRate      float64  `protobuf:"fixed64,5,opt,name=confirmationFailureRate,proto3" json:"confirmationFailureRate,omitempty"`
+	AverageSecondsToConfirmation float64  `protobuf:"fixed64,6,opt,name=averageSecondsToConfirmation,proto3" json:"averageSecondsToConfirmation,omitempty"`
+	Deletions                    uint64   `protobuf:"varint,7,opt,name=deletions,proto3" json:"deletions,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
+}
+
+func (m *RegistrationFunnelPeriod) Reset()         { *m = RegistrationFunnelPeriod{} }
+func (m *RegistrationFunnelPeriod) String() string { return proto.CompactTextString(m) }
+func (*RegistrationFunnelPeriod) ProtoMessage()    {}
+func (*RegistrationFunnelPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9efa92dae3d6ec46, []int{34}
+}
+
+func (m *RegistrationFunnelPeriod) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegistrationFunnelPeriod.Unmarshal(m, b)
+}
+func (m *RegistrationFunnelPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegistrationFunnelPeriod.Marshal(b, m, deterministic)
+}
+func (m *RegistrationFunnelPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistrationFunnelPeriod.Merge(m, src)
+}
+func (m *RegistrationFunnelPeriod) XXX_Size() int {
+	return xxx_messageInfo_RegistrationFunnelPeriod.Size(m)
+}
+func (m *RegistrationFunnelPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistrationFunnelPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistrationFunnelPeriod proto.InternalMessageInfo
+
+func (m *RegistrationFunnelPeriod) GetPeriodStart() string {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return ""
+}
+
+func (m *RegistrationFunnelPeriod) GetRegistrations() uint64 {
+	if m != nil {
+		return m.Registrations
+	}
+	return 0
+}
+
+func (m *RegistrationFunnelPeriod) GetConfirmations() uint64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *RegistrationFunnelPeriod) GetConfirmationFailures() uint64 {
+	if m != nil {
+		return m.ConfirmationFailures
+	}
+	return 0
+}
+
+func (m *RegistrationFunnelPeriod) GetConfirmationFailureRate() float64 {
+	if m != nil {
+		return m.ConfirmationFailureRate
+	}
+	return 0
+}
+
+func (m *RegistrationFunnelPeriod) GetAverageSecondsToConfirmation() float64 {
+	if m != nil {
+		return m.AverageSecondsToConfirmation
+	}
+	return 0
+}
+
+func (m *RegistrationFunnelPeriod) GetDeletions() uint64 {
+	if m != nil {
+		return m.Deletions
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RegisterRequest)(nil), "customergrpc.RegisterRequest")
+	proto.RegisterType((*RegisterResponse)(nil), "customergrpc.RegisterResponse")
+	proto.RegisterType((*ConfirmEmailAddressRequest)(nil), "customergrpc.ConfirmEmailAddressRequest")
+	proto.RegisterType((*ChangeEmailAddressRequest)(nil), "customergrpc.ChangeEmailAddressRequest")
+	proto.RegisterType((*ChangeNameRequest)(nil), "customergrpc.ChangeNameRequest")
+	proto.RegisterType((*SetPasswordRequest)(nil), "customergrpc.SetPasswordRequest")
+	proto.RegisterType((*ChangePasswordRequest)(nil), "customergrpc.ChangePasswordRequest")
+	proto.RegisterType((*VerifyCredentialsRequest)(nil), "customergrpc.VerifyCredentialsRequest")
+	proto.RegisterType((*VerifyCredentialsResponse)(nil), "customergrpc.VerifyCredentialsResponse")
+	proto.RegisterType((*RequestAccountRecoveryRequest)(nil), "customergrpc.RequestAccountRecoveryRequest")
+	proto.RegisterType((*CompleteAccountRecoveryRequest)(nil), "customergrpc.CompleteAccountRecoveryRequest")
+	proto.RegisterType((*StartMFAEnrollmentRequest)(nil), "customergrpc.StartMFAEnrollmentRequest")
+	proto.RegisterType((*StartMFAEnrollmentResponse)(nil), "customergrpc.StartMFAEnrollmentResponse")
+	proto.RegisterType((*ConfirmMFAEnrollmentRequest)(nil), "customergrpc.ConfirmMFAEnrollmentRequest")
+	proto.RegisterType((*DisableMFARequest)(nil), "customergrpc.DisableMFARequest")
+	proto.RegisterType((*VerifyMFACodeRequest)(nil), "customergrpc.VerifyMFACodeRequest")
+	proto.RegisterType((*SetAttributeRequest)(nil), "customergrpc.SetAttributeRequest")
+	proto.RegisterType((*DefineAttributeRequest)(nil), "customergrpc.DefineAttributeRequest")
+	proto.RegisterType((*DeleteRequest)(nil), "customergrpc.DeleteRequest")
+	proto.RegisterType((*RetrieveViewRequest)(nil), "customergrpc.RetrieveViewRequest")
+	proto.RegisterType((*RetrieveViewResponse)(nil), "customergrpc.RetrieveViewResponse")
+	proto.RegisterMapType((map[string]string)(nil), "customergrpc.RetrieveViewResponse.CustomAttributesEntry")
+	proto.RegisterType((*RetrieveViewByEmailAddressRequest)(nil), "customergrpc.RetrieveViewByEmailAddressRequest")
+	proto.RegisterType((*ExportCustomerDataRequest)(nil), "customergrpc.ExportCustomerDataRequest")
+	proto.RegisterType((*ListCustomersRequest)(nil), "customergrpc.ListCustomersRequest")
+	proto.RegisterType((*ListCustomersResponse)(nil), "customergrpc.ListCustomersResponse")
+	proto.RegisterType((*CustomerListEntry)(nil), "customergrpc.CustomerListEntry")
+	proto.RegisterType((*SearchCustomersRequest)(nil), "customergrpc.SearchCustomersRequest")
+	proto.RegisterType((*SearchCustomersResponse)(nil), "customergrpc.SearchCustomersResponse")
+	proto.RegisterType((*ListDuplicateCandidatesRequest)(nil), "customergrpc.ListDuplicateCandidatesRequest")
+	proto.RegisterType((*ListDuplicateCandidatesResponse)(nil), "customergrpc.ListDuplicateCandidatesResponse")
+	proto.RegisterType((*DuplicateCandidate)(nil), "customergrpc.DuplicateCandidate")
+	proto.RegisterType((*MarkAsNotDuplicateRequest)(nil), "customergrpc.MarkAsNotDuplicateRequest")
+	proto.RegisterType((*RetrieveRegistrationFunnelRequest)(nil), "customergrpc.RetrieveRegistrationFunnelRequest")
+	proto.RegisterType((*RetrieveRegistrationFunnelResponse)(nil), "customergrpc.RetrieveRegistrationFunnelResponse")
+	proto.RegisterType((*RegistrationFunnelPeriod)(nil), "customergrpc.RegistrationFunnelPeriod")
+}
+
+func init() { proto.RegisterFile("customer.proto", fileDescriptor_9efa92dae3d6ec46) }
+
+var fileDescriptor_9efa92dae3d6ec46 = []byte{
+	// 2104 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x73, 0x1b, 0x4b,
+	0x11, 0xaf, 0x95, 0x9d, 0x58, 0xee, 0xe7, 0x8f, 0x78, 0x22, 0xdb, 0xf2, 0xc6, 0xf1, 0xc7, 0x26,
+	0x71, 0xfc, 0x92, 0x58, 0xe2, 0x85, 0x4b, 0x08, 0x17, 0x1c, 0xd9, 0xe6, 0x85, 0x22, 0x21, 0xac,
+	0x43, 0xa0, 0xb8, 0x8d, 0x77, 0x5b, 0xf6, 0x90, 0xd5, 0xae, 0xde, 0xec, 0x48, 0x89, 0x9e, 0x49,
+	0x15, 0xf5, 0x38, 0x50, 0x7c, 0x1c, 0x28, 0xb8, 0x50, 0x45, 0x71, 0x79, 0x47, 0xa8, 0xe2, 0xc8,
+	0x3f, 0xc2, 0x85, 0x3f, 0x80, 0x3f, 0x84, 0x9a, 0xd9, 0x59, 0x69, 0x3f, 0x25, 0x39, 0xaf, 0xb8,
+	0xed, 0xf4, 0x76, 0xcf, 0xef, 0x37, 0x3d, 0xdd, 0x3d, 0x33, 0x0d, 0x4b, 0x4e, 0x2f, 0x14, 0x41,
+	0x07, 0x79, 0xa3, 0xcb, 0x03, 0x11, 0x90, 0x85, 0x78, 0x7c, 0xce, 0xbb, 0x8e, 0x79, 0xeb, 0x3c,
+	0x08, 0xce, 0x3d, 0x6c, 0xaa, 0x7f, 0x67, 0xbd, 0x76, 0x13, 0x3b, 0x5d, 0x31, 0x88, 0x54, 0xcd,
+	0x4d, 0xfd, 0x93, 0x76, 0x59, 0x93, 0xfa, 0x7e, 0x20, 0xa8, 0x60, 0x81, 0x1f, 0xea, 0xbf, 0x1b,
+	0x89, 0xbf, 0x17, 0x42, 0x74, 0xcf, 0x02, 0x57, 0x1b, 0x5a, 0x21, 0x2c, 0xdb, 0x78, 0xce, 0x42,
+	0x81, 0xdc, 0xc6, 0x2f, 0x7a, 0x18, 0x0a, 0x62, 0xc1, 0x02, 0x76, 0x28, 0xf3, 0x0e, 0x5d, 0x97,
+	0x63, 0x18, 0xd6, 0x8d, 0x1d, 0x63, 0x7f, 0xde, 0x4e, 0xc9, 0xc8, 0x26, 0xcc, 0x9f, 0xb3, 0x3e,
+	0xfa, 0x2f, 0x69, 0x07, 0xeb, 0x15, 0xa5, 0x30, 0x12, 0x90, 0x2d, 0x80, 0x36, 0xed, 0x30, 0x6f,
+	0xa0, 0x7e, 0xcf, 0xa8, 0xdf, 0x09, 0x89, 0x65, 0xc1, 0x8d, 0x11, 0x68, 0xd8, 0x0d, 0xfc, 0x10,
+	0xc9, 0x12, 0x54, 0x98, 0xab, 0xb1, 0x2a, 0xcc, 0xb5, 0xbe, 0x32, 0xc0, 0x6c, 0x05, 0x7e, 0x9b,
+	0xf1, 0xce, 0x71, 0x02, 0x39, 0x26, 0x99, 0x51, 0x27, 0x0f, 0xe0, 0x86, 0x13, 0x69, 0xab, 0x95,
+	0x7f, 0x4e, 0xc3, 0x0b, 0xcd, 0x2b, 0x27, 0x27, 0xfb, 0xb0, 0x8c, 0xef, 0xbb, 0xe8, 0x08, 0x74,
+	0xdf, 0x20, 0x0f, 0x59, 0xe0, 0x2b, 0x8e, 0xb3, 0x76, 0x56, 0x6c, 0x0d, 0x60, 0xa3, 0x75, 0x41,
+	0xfd, 0x73, 0x9c, 0x86, 0x42, 0xd6, 0x6f, 0x95, 0x02, 0xbf, 0x4d, 0x0f, 0xfd, 0x7b, 0x03, 0x56,
+	0x22, 0x6c, 0xe9, 0xb2, 0x32, 0xcc, 0x6f, 0xb4, 0x0f, 0x45, 0x6c, 0x66, 0x8b, 0xd9, 0xfc, 0x02,
+	0xc8, 0x29, 0x8a, 0x57, 0x34, 0x0c, 0xdf, 0x05, 0xdc, 0x2d, 0x63, 0x63, 0x42, 0xb5, 0xab, 0x55,
+	0x34, 0x99, 0xe1, 0xf8, 0x0a, 0x2b, 0xff, 0x9b, 0x01, 0xab, 0xd1, 0xca, 0x27, 0xe1, 0xed, 0xc3,
+	0xb2, 0xd3, 0xe3, 0x1c, 0x7d, 0xf1, 0x2a, 0x0d, 0x9b, 0x15, 0x93, 0x1d, 0xf8, 0xc4, 0xc7, 0x77,
+	0x43, 0xad, 0xc8, 0x15, 0x49, 0xd1, 0x15, 0x7c, 0xf1, 0x73, 0xa8, 0xbf, 0x41, 0xce, 0xda, 0x83,
+	0x16, 0x47, 0x17, 0x7d, 0xc1, 0xa8, 0x17, 0x5e, 0x25, 0x77, 0xc6, 0x78, 0xc9, 0x7a, 0x08, 0x1b,
+	0x05, 0x73, 0x97, 0xa4, 0x48, 0x0b, 0x6e, 0x6b, 0xdc, 0x43, 0xc7, 0x09, 0x7a, 0xbe, 0xb0, 0xd1,
+	0x09, 0xfa, 0xc8, 0x07, 0x57, 0x60, 0x63, 0xfd, 0xc1, 0x80, 0xad, 0x56, 0xd0, 0xe9, 0x7a, 0x28,
+	0xf0, 0xe3, 0xa7, 0x21, 0x77, 0x61, 0x91, 0x6b, 0xb3, 0xd7, 0xc1, 0x5b, 0xf4, 0xf5, 0xca, 0xd2,
+	0xc2, 0xc9, 0xdb, 0x20, 0x1d, 0x70, 0x2a, 0x28, 0x17, 0x2f, 0x4e, 0x0e, 0x8f, 0x7d, 0x1e, 0x78,
+	0x5e, 0x07, 0x7d, 0x11, 0x13, 0xc9, 0x3a, 0xe0, 0x0c, 0xcc, 0x22, 0x65, 0xed, 0xae, 0x2d, 0x00,
+	0x11, 0x88, 0xee, 0x29, 0x3a, 0x1c, 0x85, 0xb6, 0x4a, 0x48, 0x92, 0x94, 0x5b, 0x81, 0x8b, 0x32,
+	0x61, 0x67, 0x92, 0x94, 0x95, 0xd0, 0x7a, 0x0b, 0xb7, 0x74, 0x19, 0x9a, 0x86, 0x12, 0x21, 0x30,
+	0xeb, 0x04, 0x6e, 0x9c, 0x8b, 0xea, 0xfb, 0x0a, 0xa1, 0x4f, 0x61, 0xe5, 0x88, 0x85, 0xf4, 0xcc,
+	0xc3, 0x17, 0x27, 0x87, 0xff, 0x1f, 0x88, 0xa7, 0x50, 0x8b, 0x22, 0xec, 0xc5, 0xc9, 0xa1, 0x5c,
+	0xe1, 0x15, 0x50, 0xac, 0x01, 0xdc, 0x3c, 0x45, 0x71, 0x28, 0x04, 0x67, 0x67, 0x3d, 0x31, 0xce,
+	0xd4, 0x1f, 0xd5, 0x23, 0xf5, 0x4d, 0x6a, 0x70, 0xad, 0x4f, 0xbd, 0x5e, 0x5c, 0x85, 0xa2, 0xc1,
+	0x15, 0x92, 0xce, 0x87, 0xb5, 0x23, 0x6c, 0x33, 0x1f, 0x73, 0xe8, 0x31, 0x9a, 0x91, 0x40, 0x23,
+	0x30, 0x2b, 0x06, 0xdd, 0x21, 0x03, 0xf9, 0x4d, 0x1e, 0xc1, 0x4a, 0x9f, 0x7a, 0xcc, 0x55, 0xe7,
+	0xc0, 0x2b, 0x2a, 0x04, 0x72, 0x5f, 0xb3, 0xc9, 0xff, 0xb0, 0x9e, 0xc3, 0xe2, 0x11, 0x7a, 0x38,
+	0x82, 0x29, 0xa8, 0x3d, 0x59, 0xea, 0x95, 0x62, 0xea, 0xf7, 0xe0, 0xa6, 0x8d, 0x82, 0x33, 0xec,
+	0xe3, 0x1b, 0x86, 0xef, 0xca, 0x82, 0xf9, 0x1f, 0x33, 0x50, 0x4b, 0xeb, 0xe9, 0x38, 0x9e, 0x26,
+	0xfd, 0x9e, 0xc0, 0x3a, 0x0b, 0x93, 0x87, 0x94, 0x8e, 0x59, 0x8c, 0x4a, 0x4c, 0xd5, 0x2e, 0xfb,
+	0x9d, 0x3e, 0x41, 0x66, 0xc6, 0x9f, 0x20, 0xb3, 0xb9, 0x13, 0xa4, 0x0e, 0x73, 0x7d, 0xbd, 0xfa,
+	0x6b, 0x6a, 0xf5, 0xf1, 0x90, 0xb8, 0x70, 0x23, 0xba, 0xbe, 0x0c, 0x37, 0x2c, 0xac, 0x5f, 0xdf,
+	0x99, 0xd9, 0xff, 0xe4, 0xf1, 0x93, 0x46, 0xf2, 0x5e, 0xd3, 0x28, 0x5a, 0x73, 0xa3, 0x95, 0x31,
+	0x3d, 0xf6, 0x05, 0x1f, 0xd8, 0xb9, 0x19, 0xa5, 0x6f, 0x58, 0xa8, 0x12, 0x53, 0x26, 0x8d, 0x5b,
+	0x9f, 0x53, 0x8b, 0x4d, 0xc9, 0xb4, 0xa3, 0xab, 0xb1, 0xa3, 0xcd, 0x16, 0xac, 0x16, 0x4e, 0x4f,
+	0x6e, 0xc0, 0xcc, 0x5b, 0x1c, 0x68, 0xff, 0xca, 0xcf, 0x51, 0xd4, 0x56, 0x12, 0x51, 0xfb, 0xb4,
+	0xf2, 0xc4, 0xb0, 0xbe, 0x0f, 0xbb, 0x49, 0xe2, 0xcf, 0x06, 0x45, 0x37, 0x84, 0x69, 0xea, 0x6f,
+	0x0b, 0x36, 0x8e, 0xdf, 0x77, 0x03, 0x2e, 0x5a, 0xda, 0x29, 0x47, 0x54, 0xd0, 0xb2, 0xa0, 0x5b,
+	0x83, 0xeb, 0xed, 0x40, 0x5e, 0x65, 0x34, 0x21, 0x3d, 0xb2, 0xbe, 0xae, 0x40, 0xed, 0x87, 0x2c,
+	0x1c, 0xce, 0x31, 0x64, 0xd0, 0x00, 0x92, 0xbc, 0xfe, 0x9c, 0x0a, 0x2a, 0x7a, 0x31, 0x8f, 0x82,
+	0x3f, 0x72, 0xc1, 0xa1, 0xa0, 0x62, 0xb8, 0x60, 0x35, 0x20, 0x7b, 0xb0, 0xc4, 0xf5, 0x7d, 0x0d,
+	0xdd, 0x13, 0x1e, 0x74, 0x74, 0xa0, 0x64, 0xa4, 0x32, 0x27, 0x46, 0x92, 0x9f, 0xf8, 0x82, 0x79,
+	0x3a, 0x64, 0xb2, 0x62, 0xb9, 0x90, 0x30, 0xe0, 0xe2, 0xd9, 0x40, 0x85, 0xcd, 0xbc, 0xad, 0x47,
+	0x12, 0x49, 0x7e, 0x1d, 0x61, 0xe8, 0xa0, 0xef, 0x32, 0xff, 0xbc, 0x7e, 0x5d, 0xed, 0x68, 0x46,
+	0x1a, 0x9d, 0xa1, 0xe7, 0x78, 0xca, 0xbe, 0x44, 0xb5, 0xe7, 0x8b, 0xf6, 0x70, 0x2c, 0xe7, 0x76,
+	0x7a, 0x3c, 0x0c, 0xb8, 0xde, 0x73, 0x3d, 0xb2, 0x38, 0xac, 0x66, 0x7c, 0xa4, 0x13, 0xec, 0x3b,
+	0x30, 0x87, 0xbe, 0xdc, 0x4b, 0xe9, 0x19, 0x19, 0xa1, 0xdb, 0xe9, 0x08, 0x8d, 0x2d, 0xa4, 0x75,
+	0x14, 0x88, 0xb1, 0xbe, 0xcc, 0x0f, 0x1f, 0xdf, 0x8b, 0x56, 0x84, 0x17, 0x39, 0x2d, 0x21, 0xb1,
+	0xfe, 0x5a, 0x81, 0x95, 0x9c, 0xf9, 0x47, 0xdd, 0x1c, 0xc7, 0x64, 0xf8, 0xcc, 0x15, 0x32, 0x7c,
+	0x76, 0x7c, 0x86, 0x5f, 0xcb, 0x65, 0xf8, 0x26, 0xcc, 0xb3, 0x30, 0x2a, 0x85, 0xae, 0xde, 0x8c,
+	0x91, 0x40, 0x32, 0x1f, 0x6d, 0xed, 0xa1, 0x50, 0x7b, 0x31, 0x6f, 0xa7, 0x64, 0xc9, 0x1a, 0x51,
+	0x4d, 0xd5, 0x08, 0xeb, 0x25, 0xac, 0x9d, 0x22, 0xe5, 0xce, 0x45, 0x2e, 0x6e, 0x6b, 0x70, 0xed,
+	0x8b, 0x1e, 0xf2, 0x38, 0x19, 0xa3, 0x81, 0xe4, 0xda, 0xa1, 0xef, 0x6d, 0x0c, 0x7b, 0x9e, 0x88,
+	0xbc, 0xb4, 0x68, 0x27, 0x24, 0xd6, 0x6b, 0x58, 0xcf, 0xcd, 0xf7, 0x8d, 0xf7, 0xd8, 0xfa, 0x01,
+	0x6c, 0x49, 0xe9, 0x51, 0xaf, 0xeb, 0x31, 0x87, 0x0a, 0x6c, 0x51, 0xdf, 0x95, 0xa7, 0x05, 0x0e,
+	0xd9, 0xee, 0xc3, 0x32, 0xf3, 0x1d, 0xaf, 0xe7, 0xa2, 0x8d, 0x7d, 0x86, 0xef, 0x30, 0xda, 0xdc,
+	0xaa, 0x9d, 0x15, 0x5b, 0x0e, 0x6c, 0x97, 0xce, 0xa5, 0x99, 0x7e, 0x0f, 0xc0, 0x19, 0x4a, 0x35,
+	0xd9, 0x9d, 0x34, 0xd9, 0xbc, 0xb9, 0x9d, 0xb0, 0xb1, 0xfe, 0x52, 0x01, 0x92, 0x57, 0x21, 0xdf,
+	0x85, 0x6a, 0x3c, 0x8b, 0xa2, 0x37, 0x85, 0x0f, 0x86, 0x06, 0xe4, 0x18, 0x16, 0x03, 0x71, 0x81,
+	0x3c, 0xd6, 0xa9, 0x57, 0xa6, 0x9b, 0x21, 0x6d, 0x25, 0x73, 0x93, 0x23, 0x0d, 0x83, 0xf8, 0xe4,
+	0xd5, 0x23, 0xb9, 0xb3, 0x21, 0xeb, 0x30, 0x8f, 0x72, 0x26, 0x06, 0x2a, 0x48, 0x0d, 0x3b, 0x21,
+	0x91, 0x75, 0x81, 0x85, 0x2f, 0x83, 0x91, 0xe3, 0x54, 0xa4, 0x56, 0xed, 0x8c, 0x54, 0xce, 0xe3,
+	0xa2, 0x50, 0xc7, 0xef, 0xa1, 0x50, 0xe1, 0x3a, 0x6f, 0x27, 0x24, 0x16, 0xc2, 0xc6, 0x0b, 0xca,
+	0xdf, 0x1e, 0xa6, 0xac, 0xe2, 0x6d, 0xdc, 0x02, 0x88, 0x57, 0xf3, 0x3c, 0x4e, 0xcf, 0x84, 0x44,
+	0x6e, 0x73, 0x6a, 0x35, 0xcf, 0x87, 0xcf, 0x8d, 0x8c, 0xd8, 0x62, 0xa3, 0xd3, 0x21, 0x7a, 0xe8,
+	0x72, 0x55, 0x64, 0x4f, 0x7a, 0xbe, 0x8f, 0x5e, 0x0c, 0x67, 0x42, 0x95, 0xf9, 0x02, 0x79, 0x9f,
+	0x7a, 0x1a, 0x6c, 0x38, 0x96, 0x17, 0x98, 0xb6, 0xac, 0xb3, 0xfa, 0x02, 0x23, 0xbf, 0x65, 0x4e,
+	0xf4, 0x54, 0x4d, 0xd5, 0x57, 0x28, 0x35, 0xb0, 0xda, 0x60, 0x8d, 0x83, 0x1a, 0x06, 0xd5, 0x5c,
+	0x17, 0x39, 0x0b, 0xdc, 0x38, 0xa2, 0xf6, 0xb2, 0x87, 0x70, 0xd6, 0xf4, 0x95, 0x52, 0xb7, 0x63,
+	0x33, 0xeb, 0x3f, 0x15, 0xa8, 0x97, 0x69, 0xc9, 0x7b, 0x7d, 0xa4, 0xa7, 0xae, 0xe3, 0x7a, 0x35,
+	0x49, 0x51, 0x74, 0xd9, 0x1e, 0x59, 0x87, 0xfa, 0xb2, 0x94, 0x16, 0x4a, 0xad, 0xe4, 0xa1, 0x14,
+	0xea, 0x4b, 0x6c, 0x5a, 0x48, 0x1e, 0x43, 0x2d, 0x29, 0x38, 0xa1, 0xcc, 0xeb, 0x71, 0x0c, 0xf5,
+	0xd5, 0xb1, 0xf0, 0x9f, 0x2c, 0x9f, 0x05, 0x72, 0x3b, 0x8e, 0x24, 0xc3, 0x2e, 0xfb, 0x4d, 0x9e,
+	0xc1, 0x26, 0xed, 0x23, 0x97, 0xa7, 0x0b, 0x3a, 0x81, 0xef, 0x86, 0xaf, 0x83, 0x56, 0x42, 0x55,
+	0x05, 0x99, 0x61, 0x8f, 0xd5, 0x91, 0x45, 0xd4, 0x45, 0x0f, 0xa3, 0x35, 0xcd, 0x29, 0x9a, 0x23,
+	0xc1, 0xe3, 0x7f, 0xd5, 0xa1, 0x3a, 0xcc, 0x90, 0x33, 0xa8, 0xc6, 0xbd, 0x11, 0x72, 0xbb, 0x68,
+	0x93, 0x86, 0x8d, 0x1a, 0x73, 0xab, 0xec, 0x77, 0xb4, 0xe9, 0xd6, 0xfa, 0x57, 0xff, 0xfe, 0xef,
+	0x9f, 0x2b, 0x2b, 0xd6, 0x42, 0xb3, 0xff, 0x59, 0x33, 0x56, 0x7d, 0x6a, 0x3c, 0x20, 0xbf, 0x33,
+	0xe0, 0x66, 0x41, 0x6f, 0x85, 0xec, 0x67, 0xb2, 0xb9, 0xb4, 0xfd, 0x62, 0xae, 0x35, 0xa2, 0x96,
+	0x52, 0x23, 0xee, 0x46, 0x35, 0x8e, 0x65, 0x37, 0xca, 0xfa, 0x4c, 0x41, 0x3e, 0x34, 0xf7, 0x92,
+	0x90, 0xcd, 0x4b, 0xe6, 0x7e, 0x68, 0xaa, 0xd3, 0x8c, 0x46, 0xd3, 0x34, 0xb5, 0xb3, 0x25, 0x99,
+	0x5f, 0x19, 0x40, 0xf2, 0x4d, 0x16, 0x72, 0x3f, 0xc3, 0xa5, 0xac, 0x0d, 0x53, 0x4a, 0xe5, 0x53,
+	0x45, 0xe5, 0x8e, 0xb9, 0x35, 0x9e, 0x8a, 0xa4, 0x70, 0x01, 0x30, 0x6a, 0xb5, 0x90, 0xed, 0x22,
+	0xe4, 0x44, 0x13, 0xa6, 0x14, 0x71, 0x57, 0x21, 0xde, 0x32, 0xd7, 0xf2, 0x88, 0xf2, 0x55, 0x22,
+	0x91, 0x7c, 0xf8, 0x24, 0xd1, 0x47, 0x21, 0x99, 0xba, 0x9e, 0x6f, 0xb1, 0x94, 0x62, 0xdd, 0x53,
+	0x58, 0xdb, 0xa6, 0x99, 0xc7, 0x8a, 0x9b, 0x09, 0x12, 0xef, 0x12, 0x96, 0xd2, 0xad, 0x14, 0x72,
+	0xa7, 0x68, 0x75, 0xd3, 0xa2, 0x3e, 0x52, 0xa8, 0x7b, 0xe6, 0x6e, 0x39, 0x6a, 0xd3, 0x51, 0x33,
+	0x4a, 0xf0, 0x3f, 0x1a, 0xb0, 0x92, 0xeb, 0x66, 0x90, 0x4c, 0xe5, 0x29, 0x6b, 0xa5, 0x98, 0xf7,
+	0x27, 0xea, 0xe9, 0x30, 0x7f, 0xa0, 0x48, 0xdd, 0xb5, 0xb6, 0x53, 0xa4, 0x9c, 0x91, 0x66, 0xb3,
+	0xaf, 0x6c, 0x25, 0xa5, 0xdf, 0x18, 0xb0, 0x56, 0xdc, 0x33, 0x21, 0x0f, 0xb3, 0xd9, 0x34, 0xa6,
+	0xb3, 0x52, 0xea, 0xa0, 0x7d, 0xc5, 0xc5, 0xb2, 0x6e, 0xa7, 0xb8, 0xd0, 0x68, 0x92, 0x83, 0xb8,
+	0xb3, 0x20, 0x99, 0xfc, 0xc9, 0x80, 0xf5, 0x92, 0xbe, 0x0b, 0x79, 0x94, 0xcd, 0xc3, 0x71, 0xed,
+	0x99, 0x49, 0xb9, 0x68, 0xed, 0x8d, 0xe5, 0xd2, 0x74, 0xf4, 0xec, 0x92, 0xd4, 0x6f, 0x0d, 0x20,
+	0xf9, 0x8e, 0x4a, 0x36, 0x17, 0x4b, 0x1b, 0x34, 0xe6, 0xfe, 0x64, 0x45, 0xbd, 0x69, 0x3b, 0x8a,
+	0x9c, 0x69, 0xad, 0xe6, 0x23, 0xa9, 0xd3, 0xa6, 0x92, 0xcb, 0xaf, 0x0d, 0xa8, 0x15, 0x75, 0x5e,
+	0xc8, 0xa7, 0x85, 0x55, 0xaa, 0x90, 0xcf, 0x84, 0x6d, 0x32, 0x6f, 0x17, 0xa2, 0x27, 0xab, 0x53,
+	0x00, 0x30, 0xea, 0xc8, 0x64, 0x4b, 0x43, 0xae, 0x57, 0xf3, 0xb1, 0x80, 0x6e, 0x34, 0x91, 0x04,
+	0x14, 0xb0, 0x98, 0xea, 0xcf, 0x10, 0xab, 0x28, 0x0f, 0xd2, 0xcd, 0x9b, 0x52, 0xd8, 0xfb, 0x0a,
+	0x76, 0xd7, 0xda, 0x2c, 0x86, 0x1d, 0xe5, 0xc5, 0x00, 0x16, 0x92, 0x9d, 0x1d, 0xb2, 0x9b, 0x2b,
+	0x4c, 0xd9, 0xbe, 0x4b, 0x29, 0x66, 0x43, 0x61, 0xee, 0x9b, 0x77, 0xf2, 0x98, 0x34, 0x9e, 0x23,
+	0x6c, 0x5e, 0xca, 0x8a, 0xf8, 0x41, 0x42, 0x7f, 0x09, 0xcb, 0x99, 0xce, 0x0e, 0xb9, 0x9b, 0x71,
+	0x73, 0x61, 0xe3, 0xe7, 0x6a, 0x85, 0xff, 0xa0, 0x10, 0xfb, 0x67, 0x70, 0x3d, 0x7a, 0xc9, 0x90,
+	0x5b, 0x59, 0x48, 0x0f, 0x27, 0x23, 0x6d, 0x28, 0xa4, 0x9b, 0x0f, 0x56, 0x72, 0x4b, 0x25, 0x5d,
+	0x58, 0x48, 0xf6, 0x07, 0xb2, 0x0e, 0x2d, 0x68, 0x08, 0x99, 0xd6, 0xe4, 0xbe, 0x48, 0x8c, 0x48,
+	0x0a, 0x10, 0x7b, 0x40, 0xf2, 0x8d, 0x84, 0x6c, 0xea, 0x96, 0xb6, 0x1a, 0xcc, 0x5a, 0xbc, 0x46,
+	0xda, 0x65, 0x8d, 0xcf, 0x85, 0xe8, 0x3e, 0x0b, 0xdc, 0x41, 0x9c, 0xa6, 0xa4, 0x9e, 0xdf, 0x4c,
+	0x54, 0x53, 0xc9, 0x8a, 0x6a, 0x96, 0x77, 0x42, 0x48, 0xb3, 0x7c, 0x51, 0x85, 0x3d, 0x93, 0xa9,
+	0xbc, 0x50, 0x53, 0xac, 0x96, 0x48, 0xea, 0x62, 0x43, 0x02, 0x58, 0x4c, 0xbd, 0xef, 0xb3, 0x99,
+	0x53, 0xd4, 0x20, 0x31, 0xef, 0x8c, 0xd5, 0xd1, 0x78, 0xab, 0x0a, 0x6f, 0x99, 0x2c, 0x26, 0xf1,
+	0x42, 0xf2, 0x4b, 0x58, 0xce, 0x3c, 0x37, 0xb3, 0x91, 0x5b, 0xfc, 0xba, 0x35, 0xef, 0x4d, 0xd0,
+	0xd2, 0xb0, 0x9b, 0x0a, 0x76, 0x8d, 0xd4, 0x52, 0xb0, 0xcd, 0x50, 0xa9, 0x93, 0xaf, 0x0d, 0x58,
+	0x2f, 0x79, 0x4b, 0x66, 0x0f, 0x90, 0xf1, 0xcf, 0x57, 0xf3, 0x60, 0x4a, 0xed, 0xf4, 0x79, 0x4b,
+	0xac, 0x34, 0x2d, 0x37, 0x36, 0x39, 0x18, 0x3d, 0x45, 0xc9, 0x3f, 0x0d, 0x20, 0xf9, 0x07, 0x57,
+	0x36, 0x2a, 0x4b, 0x9f, 0x64, 0xa5, 0x99, 0xf7, 0x53, 0xc5, 0xe1, 0xc7, 0xe6, 0x8f, 0x26, 0x73,
+	0x68, 0x5e, 0x8e, 0x9e, 0x70, 0x1f, 0x9a, 0x97, 0x99, 0xa7, 0xda, 0x87, 0xa6, 0x1f, 0x88, 0x83,
+	0xa1, 0x1d, 0xf9, 0x7b, 0x22, 0x9c, 0xf3, 0xcf, 0x9d, 0xb2, 0x70, 0x2e, 0x7d, 0xe4, 0x99, 0xdf,
+	0x9a, 0xde, 0x40, 0xbb, 0x57, 0x97, 0x2f, 0xa2, 0xee, 0x58, 0xd4, 0xa7, 0xde, 0x40, 0x30, 0x27,
+	0x6c, 0x26, 0x1f, 0x4a, 0x07, 0x6d, 0x65, 0x72, 0x76, 0x5d, 0x79, 0xe5, 0xdb, 0xff, 0x1b, 0x00,
+	0x88, 0x49, 0x74, 0x88, 0x39, 0x1e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// CustomerClient is the client API for Customer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CustomerClient interface {
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
+	ConfirmEmailAddress(ctx context.Context, in *ConfirmEmailAddressRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ChangeEmailAddress(ctx context.Context, in *ChangeEmailAddressRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ChangeName(ctx context.Context, in *ChangeNameRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	SetPassword(ctx context.Context, in *SetPasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	VerifyCredentials(ctx context.Context, in *VerifyCredentialsRequest, opts ...grpc.CallOption) (*VerifyCredentialsResponse, error)
+	RequestAccountRecovery(ctx context.Context, in *RequestAccountRecoveryRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	CompleteAccountRecovery(ctx context.Context, in *CompleteAccountRecoveryRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	StartMFAEnrollment(ctx context.Context, in *StartMFAEnrollmentRequest, opts ...grpc.CallOption) (*StartMFAEnrollmentResponse, error)
+	ConfirmMFAEnrollment(ctx context.Context, in *ConfirmMFAEnrollmentRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	DisableMFA(ctx context.Context, in *DisableMFARequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	VerifyMFACode(ctx context.Context, in *VerifyMFACodeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	SetAttribute(ctx context.Context, in *SetAttributeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	DefineAttribute(ctx context.Context, in *DefineAttributeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	RetrieveView(ctx context.Context, in *RetrieveViewRequest, opts ...grpc.CallOption) (*RetrieveViewResponse, error)
+	ExportCustomerData(ctx context.Context, in *ExportCustomerDataRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	RetrieveViewByEmailAddress(ctx context.Context, in *RetrieveViewByEmailAddressRequest, opts ...grpc.CallOption) (*RetrieveViewResponse, error)
+	ListCustomers(ctx context.Context, in *ListCustomersRequest, opts ...grpc.CallOption) (*ListCustomersResponse, error)
+	SearchCustomers(ctx context.Context, in *SearchCustomersRequest, opts ...grpc.CallOption) (*SearchCustomersResponse, error)
+	ListDuplicateCandidates(ctx context.Context, in *ListDuplicateCandidatesRequest, opts ...grpc.CallOption) (*ListDuplicateCandidatesResponse, error)
+	MarkAsNotDuplicate(ctx context.Context, in *MarkAsNotDuplicateRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	RetrieveRegistrationFunnel(ctx context.Context, in *RetrieveRegistrationFunnelRequest, opts ...grpc.CallOption) (*RetrieveRegistrationFunnelResponse, error)
+}
+
+type customerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCustomerClient(cc grpc.ClientConnInterface) CustomerClient {
+	return &customerClient{cc}
+}
+
+func (c *customerClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
+	out := new(RegisterResponse)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) ConfirmEmailAddress(ctx context.Context, in *ConfirmEmailAddressRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/ConfirmEmailAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) ChangeEmailAddress(ctx context.Context, in *ChangeEmailAddressRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/ChangeEmailAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) ChangeName(ctx context.Context, in *ChangeNameRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/ChangeName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) SetPassword(ctx context.Context, in *SetPasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/SetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) VerifyCredentials(ctx context.Context, in *VerifyCredentialsRequest, opts ...grpc.CallOption) (*VerifyCredentialsResponse, error) {
+	out := new(VerifyCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/VerifyCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) RequestAccountRecovery(ctx context.Context, in *RequestAccountRecoveryRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/RequestAccountRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) CompleteAccountRecovery(ctx context.Context, in *CompleteAccountRecoveryRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/CompleteAccountRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) StartMFAEnrollment(ctx context.Context, in *StartMFAEnrollmentRequest, opts ...grpc.CallOption) (*StartMFAEnrollmentResponse, error) {
+	out := new(StartMFAEnrollmentResponse)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/StartMFAEnrollment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) ConfirmMFAEnrollment(ctx context.Context, in *ConfirmMFAEnrollmentRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/ConfirmMFAEnrollment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) DisableMFA(ctx context.Context, in *DisableMFARequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/DisableMFA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) VerifyMFACode(ctx context.Context, in *VerifyMFACodeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/VerifyMFACode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) SetAttribute(ctx context.Context, in *SetAttributeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/SetAttribute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) DefineAttribute(ctx context.Context, in *DefineAttributeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/DefineAttribute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) RetrieveView(ctx context.Context, in *RetrieveViewRequest, opts ...grpc.CallOption) (*RetrieveViewResponse, error) {
+	out := new(RetrieveViewResponse)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/RetrieveView", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) ExportCustomerData(ctx context.Context, in *ExportCustomerDataRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/ExportCustomerData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) RetrieveViewByEmailAddress(ctx context.Context, in *RetrieveViewByEmailAddressRequest, opts ...grpc.CallOption) (*RetrieveViewResponse, error) {
+	out := new(RetrieveViewResponse)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/RetrieveViewByEmailAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) ListCustomers(ctx context.Context, in *ListCustomersRequest, opts ...grpc.CallOption) (*ListCustomersResponse, error) {
+	out := new(ListCustomersResponse)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/ListCustomers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) SearchCustomers(ctx context.Context, in *SearchCustomersRequest, opts ...grpc.CallOption) (*SearchCustomersResponse, error) {
+	out := new(SearchCustomersResponse)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/SearchCustomers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) ListDuplicateCandidates(ctx context.Context, in *ListDuplicateCandidatesRequest, opts ...grpc.CallOption) (*ListDuplicateCandidatesResponse, error) {
+	out := new(ListDuplicateCandidatesResponse)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/ListDuplicateCandidates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) MarkAsNotDuplicate(ctx context.Context, in *MarkAsNotDuplicateRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/MarkAsNotDuplicate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerClient) RetrieveRegistrationFunnel(ctx context.Context, in *RetrieveRegistrationFunnelRequest, opts ...grpc.CallOption) (*RetrieveRegistrationFunnelResponse, error) {
+	out := new(RetrieveRegistrationFunnelResponse)
+	err := c.cc.Invoke(ctx, "/customergrpc.Customer/RetrieveRegistrationFunnel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CustomerServer is the server API for Customer service.
+type CustomerServer interface {
+	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	ConfirmEmailAddress(context.Context, *ConfirmEmailAddressRequest) (*empty.Empty, error)
+	ChangeEmailAddress(context.Context, *ChangeEmailAddressRequest) (*empty.Empty, error)
+	ChangeName(context.Context, *ChangeNameRequest) (*empty.Empty, error)
+	SetPassword(context.Context, *SetPasswordRequest) (*empty.Empty, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*empty.Empty, error)
+	VerifyCredentials(context.Context, *VerifyCredentialsRequest) (*VerifyCredentialsResponse, error)
+	RequestAccountRecovery(context.Context, *RequestAccountRecoveryRequest) (*empty.Empty, error)
+	CompleteAccountRecovery(context.Context, *CompleteAccountRecoveryRequest) (*empty.Empty, error)
+	StartMFAEnrollment(context.Context, *StartMFAEnrollmentRequest) (*StartMFAEnrollmentResponse, error)
+	ConfirmMFAEnrollment(context.Context, *ConfirmMFAEnrollmentRequest) (*empty.Empty, error)
+	DisableMFA(context.Context, *DisableMFARequest) (*empty.Empty, error)
+	VerifyMFACode(context.Context, *VerifyMFACodeRequest) (*empty.Empty, error)
+	SetAttribute(context.Context, *SetAttributeRequest) (*empty.Empty, error)
+	DefineAttribute(context.Context, *DefineAttributeRequest) (*empty.Empty, error)
+	Delete(context.Context, *DeleteRequest) (*empty.Empty, error)
+	RetrieveView(context.Context, *RetrieveViewRequest) (*RetrieveViewResponse, error)
+	ExportCustomerData(context.Context, *ExportCustomerDataRequest) (*httpbody.HttpBody, error)
+	RetrieveViewByEmailAddress(context.Context, *RetrieveViewByEmailAddressRequest) (*RetrieveViewResponse, error)
+	ListCustomers(context.Context, *ListCustomersRequest) (*ListCustomersResponse, error)
+	SearchCustomers(context.Context, *SearchCustomersRequest) (*SearchCustomersResponse, error)
+	ListDuplicateCandidates(context.Context, *ListDuplicateCandidatesRequest) (*ListDuplicateCandidatesResponse, error)
+	MarkAsNotDuplicate(context.Context, *MarkAsNotDuplicateRequest) (*empty.Empty, error)
+	RetrieveRegistrationFunnel(context.Context, *RetrieveRegistrationFunnelRequest) (*RetrieveRegistrationFunnelResponse, error)
+}
+
+// UnimplementedCustomerServer can be embedded to have forward compatible implementations.
+type UnimplementedCustomerServer struct {
+}
+
+func (*UnimplementedCustomerServer) Register(ctx context.Context, req *RegisterRequest) (*RegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (*UnimplementedCustomerServer) ConfirmEmailAddress(ctx context.Context, req *ConfirmEmailAddressRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmEmailAddress not implemented")
+}
+func (*UnimplementedCustomerServer) ChangeEmailAddress(ctx context.Context, req *ChangeEmailAddressRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeEmailAddress not implemented")
+}
+func (*UnimplementedCustomerServer) ChangeName(ctx context.Context, req *ChangeNameRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeName not implemented")
+}
+func (*UnimplementedCustomerServer) SetPassword(ctx context.Context, req *SetPasswordRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPassword not implemented")
+}
+func (*UnimplementedCustomerServer) ChangePassword(ctx context.Context, req *ChangePasswordRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (*UnimplementedCustomerServer) VerifyCredentials(ctx context.Context, req *VerifyCredentialsRequest) (*VerifyCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCredentials not implemented")
+}
+func (*UnimplementedCustomerServer) RequestAccountRecovery(ctx context.Context, req *RequestAccountRecoveryRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAccountRecovery not implemented")
+}
+func (*UnimplementedCustomerServer) CompleteAccountRecovery(ctx context.Context, req *CompleteAccountRecoveryRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteAccountRecovery not implemented")
+}
+func (*UnimplementedCustomerServer) StartMFAEnrollment(ctx context.Context, req *StartMFAEnrollmentRequest) (*StartMFAEnrollmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartMFAEnrollment not implemented")
+}
+func (*UnimplementedCustomerServer) ConfirmMFAEnrollment(ctx context.Context, req *ConfirmMFAEnrollmentRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmMFAEnrollment not implemented")
+}
+func (*UnimplementedCustomerServer) DisableMFA(ctx context.Context, req *DisableMFARequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableMFA not implemented")
+}
+func (*UnimplementedCustomerServer) VerifyMFACode(ctx context.Context, req *VerifyMFACodeRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyMFACode not implemented")
+}
+func (*UnimplementedCustomerServer) SetAttribute(ctx context.Context, req *SetAttributeRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttribute not implemented")
+}
+func (*UnimplementedCustomerServer) DefineAttribute(ctx context.Context, req *DefineAttributeRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DefineAttribute not implemented")
+}
+func (*UnimplementedCustomerServer) Delete(ctx context.Context, req *DeleteRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedCustomerServer) RetrieveView(ctx context.Context, req *RetrieveViewRequest) (*RetrieveViewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveView not implemented")
+}
+func (*UnimplementedCustomerServer) ExportCustomerData(ctx context.Context, req *ExportCustomerDataRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCustomerData not implemented")
+}
+func (*UnimplementedCustomerServer) RetrieveViewByEmailAddress(ctx context.Context, req *RetrieveViewByEmailAddressRequest) (*RetrieveViewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveViewByEmailAddress not implemented")
+}
+func (*UnimplementedCustomerServer) ListCustomers(ctx context.Context, req *ListCustomersRequest) (*ListCustomersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCustomers not implemented")
+}
+func (*UnimplementedCustomerServer) SearchCustomers(ctx context.Context, req *SearchCustomersRequest) (*SearchCustomersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCustomers not implemented")
+}
+func (*UnimplementedCustomerServer) ListDuplicateCandidates(ctx context.Context, req *ListDuplicateCandidatesRequest) (*ListDuplicateCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDuplicateCandidates not implemented")
+}
+func (*UnimplementedCustomerServer) MarkAsNotDuplicate(ctx context.Context, req *MarkAsNotDuplicateRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAsNotDuplicate not implemented")
+}
+func (*UnimplementedCustomerServer) RetrieveRegistrationFunnel(ctx context.Context, req *RetrieveRegistrationFunnelRequest) (*RetrieveRegistrationFunnelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveRegistrationFunnel not implemented")
+}
+
+func RegisterCustomerServer(s *grpc.Server, srv CustomerServer) {
+	s.RegisterService(&_Customer_serviceDesc, srv)
+}
+
+func _Customer_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customergrpc.Customer/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_ConfirmEmailAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEmailAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).ConfirmEmailAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customergrpc.Customer/ConfirmEmailAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).ConfirmEmailAddress(ctx, req.(*ConfirmEmailAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_ChangeEmailAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeEmailAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).ChangeEmailAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customergrpc.Customer/ChangeEmailAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).ChangeEmailAddress(ctx, req.(*ChangeEmailAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_ChangeName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeNameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CustomerServer).Register(ctx, in)
+		return srv.(CustomerServer).ChangeName(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/customergrpc.Customer/Register",
+		FullMethod: "/customergrpc.Customer/ChangeName",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CustomerServer).Register(ctx, req.(*RegisterRequest))
+		return srv.(CustomerServer).ChangeName(ctx, req.(*ChangeNameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Customer_ConfirmEmailAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfirmEmailAddressRequest)
+func _Customer_SetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPasswordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CustomerServer).ConfirmEmailAddress(ctx, in)
+		return srv.(CustomerServer).SetPassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/customergrpc.Customer/ConfirmEmailAddress",
+		FullMethod: "/customergrpc.Customer/SetPassword",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CustomerServer).ConfirmEmailAddress(ctx, req.(*ConfirmEmailAddressRequest))
+		return srv.(CustomerServer).SetPassword(ctx, req.(*SetPasswordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Customer_ChangeEmailAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangeEmailAddressRequest)
+func _Customer_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CustomerServer).ChangeEmailAddress(ctx, in)
+		return srv.(CustomerServer).ChangePassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/customergrpc.Customer/ChangeEmailAddress",
+		FullMethod: "/customergrpc.Customer/ChangePassword",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CustomerServer).ChangeEmailAddress(ctx, req.(*ChangeEmailAddressRequest))
+		return srv.(CustomerServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Customer_ChangeName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangeNameRequest)
+func _Customer_VerifyCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyCredentialsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CustomerServer).ChangeName(ctx, in)
+		return srv.(CustomerServer).VerifyCredentials(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/customergrpc.Customer/ChangeName",
+		FullMethod: "/customergrpc.Customer/VerifyCredentials",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CustomerServer).ChangeName(ctx, req.(*ChangeNameRequest))
+		return srv.(CustomerServer).VerifyCredentials(ctx, req.(*VerifyCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_RequestAccountRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestAccountRecoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).RequestAccountRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customergrpc.Customer/RequestAccountRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).RequestAccountRecovery(ctx, req.(*RequestAccountRecoveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_CompleteAccountRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteAccountRecoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).CompleteAccountRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customergrpc.Customer/CompleteAccountRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).CompleteAccountRecovery(ctx, req.(*CompleteAccountRecoveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_StartMFAEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartMFAEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).StartMFAEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customergrpc.Customer/StartMFAEnrollment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).StartMFAEnrollment(ctx, req.(*StartMFAEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_ConfirmMFAEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmMFAEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).ConfirmMFAEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customergrpc.Customer/ConfirmMFAEnrollment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).ConfirmMFAEnrollment(ctx, req.(*ConfirmMFAEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_DisableMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).DisableMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customergrpc.Customer/DisableMFA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).DisableMFA(ctx, req.(*DisableMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_VerifyMFACode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMFACodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).VerifyMFACode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customergrpc.Customer/VerifyMFACode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).VerifyMFACode(ctx, req.(*VerifyMFACodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_SetAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).SetAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customergrpc.Customer/SetAttribute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).SetAttribute(ctx, req.(*SetAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_DefineAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DefineAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).DefineAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customergrpc.Customer/DefineAttribute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).DefineAttribute(ctx, req.(*DefineAttributeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -696,6 +2782,132 @@ func _Customer_RetrieveView_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Customer_ExportCustomerData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCustomerDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).ExportCustomerData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customergrpc.Customer/ExportCustomerData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).ExportCustomerData(ctx, req.(*ExportCustomerDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_RetrieveViewByEmailAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveViewByEmailAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).RetrieveViewByEmailAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customergrpc.Customer/RetrieveViewByEmailAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).RetrieveViewByEmailAddress(ctx, req.(*RetrieveViewByEmailAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_ListCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCustomersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).ListCustomers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customergrpc.Customer/ListCustomers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).ListCustomers(ctx, req.(*ListCustomersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_SearchCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCustomersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).SearchCustomers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customergrpc.Customer/SearchCustomers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).SearchCustomers(ctx, req.(*SearchCustomersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_ListDuplicateCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDuplicateCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).ListDuplicateCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customergrpc.Customer/ListDuplicateCandidates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).ListDuplicateCandidates(ctx, req.(*ListDuplicateCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_MarkAsNotDuplicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAsNotDuplicateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).MarkAsNotDuplicate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customergrpc.Customer/MarkAsNotDuplicate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).MarkAsNotDuplicate(ctx, req.(*MarkAsNotDuplicateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Customer_RetrieveRegistrationFunnel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveRegistrationFunnelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServer).RetrieveRegistrationFunnel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customergrpc.Customer/RetrieveRegistrationFunnel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServer).RetrieveRegistrationFunnel(ctx, req.(*RetrieveRegistrationFunnelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Customer_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "customergrpc.Customer",
 	HandlerType: (*CustomerServer)(nil),
@@ -716,6 +2928,50 @@ var _Customer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeName",
 			Handler:    _Customer_ChangeName_Handler,
 		},
+		{
+			MethodName: "SetPassword",
+			Handler:    _Customer_SetPassword_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _Customer_ChangePassword_Handler,
+		},
+		{
+			MethodName: "VerifyCredentials",
+			Handler:    _Customer_VerifyCredentials_Handler,
+		},
+		{
+			MethodName: "RequestAccountRecovery",
+			Handler:    _Customer_RequestAccountRecovery_Handler,
+		},
+		{
+			MethodName: "CompleteAccountRecovery",
+			Handler:    _Customer_CompleteAccountRecovery_Handler,
+		},
+		{
+			MethodName: "StartMFAEnrollment",
+			Handler:    _Customer_StartMFAEnrollment_Handler,
+		},
+		{
+			MethodName: "ConfirmMFAEnrollment",
+			Handler:    _Customer_ConfirmMFAEnrollment_Handler,
+		},
+		{
+			MethodName: "DisableMFA",
+			Handler:    _Customer_DisableMFA_Handler,
+		},
+		{
+			MethodName: "VerifyMFACode",
+			Handler:    _Customer_VerifyMFACode_Handler,
+		},
+		{
+			MethodName: "SetAttribute",
+			Handler:    _Customer_SetAttribute_Handler,
+		},
+		{
+			MethodName: "DefineAttribute",
+			Handler:    _Customer_DefineAttribute_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _Customer_Delete_Handler,
@@ -724,6 +2980,34 @@ var _Customer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RetrieveView",
 			Handler:    _Customer_RetrieveView_Handler,
 		},
+		{
+			MethodName: "ExportCustomerData",
+			Handler:    _Customer_ExportCustomerData_Handler,
+		},
+		{
+			MethodName: "RetrieveViewByEmailAddress",
+			Handler:    _Customer_RetrieveViewByEmailAddress_Handler,
+		},
+		{
+			MethodName: "ListCustomers",
+			Handler:    _Customer_ListCustomers_Handler,
+		},
+		{
+			MethodName: "SearchCustomers",
+			Handler:    _Customer_SearchCustomers_Handler,
+		},
+		{
+			MethodName: "ListDuplicateCandidates",
+			Handler:    _Customer_ListDuplicateCandidates_Handler,
+		},
+		{
+			MethodName: "MarkAsNotDuplicate",
+			Handler:    _Customer_MarkAsNotDuplicate_Handler,
+		},
+		{
+			MethodName: "RetrieveRegistrationFunnel",
+			Handler:    _Customer_RetrieveRegistrationFunnel_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "customer.proto",
@@ -0,0 +1,435 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: customeradmin.proto
+
+package customergrpc
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type ImportCustomersRequest struct {
+	// Types that are valid to be assigned to Content:
+	//	*ImportCustomersRequest_Options
+	//	*ImportCustomersRequest_Row
+	Content              isImportCustomersRequest_Content `protobuf_oneof:"content"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *ImportCustomersRequest) Reset()         { *m = ImportCustomersRequest{} }
+func (m *ImportCustomersRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportCustomersRequest) ProtoMessage()    {}
+func (*ImportCustomersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a26851434a02adda, []int{0}
+}
+
+func (m *ImportCustomersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportCustomersRequest.Unmarshal(m, b)
+}
+func (m *ImportCustomersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportCustomersRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportCustomersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportCustomersRequest.Merge(m, src)
+}
+func (m *ImportCustomersRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportCustomersRequest.Size(m)
+}
+func (m *ImportCustomersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportCustomersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportCustomersRequest proto.InternalMessageInfo
+
+type isImportCustomersRequest_Content interface {
+	isImportCustomersRequest_Content()
+}
+
+type ImportCustomersRequest_Options struct {
+	Options *ImportCustomersOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type ImportCustomersRequest_Row struct {
+	Row *ImportCustomersRow `protobuf:"bytes,2,opt,name=row,proto3,oneof"`
+}
+
+func (*ImportCustomersRequest_Options) isImportCustomersRequest_Content() {}
+
+func (*ImportCustomersRequest_Row) isImportCustomersRequest_Content() {}
+
+func (m *ImportCustomersRequest) GetContent() isImportCustomersRequest_Content {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *ImportCustomersRequest) GetOptions() *ImportCustomersOptions {
+	if x, ok := m.GetContent().(*ImportCustomersRequest_Options); ok {
+		return x.Options
+	}
+	return nil
+}
+
+func (m *ImportCustomersRequest) GetRow() *ImportCustomersRow {
+	if x, ok := m.GetContent().(*ImportCustomersRequest_Row); ok {
+		return x.Row
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ImportCustomersRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ImportCustomersRequest_Options)(nil),
+		(*ImportCustomersRequest_Row)(nil),
+	}
+}
+
+type ImportCustomersOptions struct {
+	DryRun               bool     `protobuf:"varint,1,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	Parallelism          uint32   `protobuf:"varint,2,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportCustomersOptions) Reset()         { *m = ImportCustomersOptions{} }
+func (m *ImportCustomersOptions) String() string { return proto.CompactTextString(m) }
+func (*ImportCustomersOptions) ProtoMessage()    {}
+func (*ImportCustomersOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a26851434a02adda, []int{1}
+}
+
+func (m *ImportCustomersOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportCustomersOptions.Unmarshal(m, b)
+}
+func (m *ImportCustomersOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportCustomersOptions.Marshal(b, m, deterministic)
+}
+func (m *ImportCustomersOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportCustomersOptions.Merge(m, src)
+}
+func (m *ImportCustomersOptions) XXX_Size() int {
+	return xxx_messageInfo_ImportCustomersOptions.Size(m)
+}
+func (m *ImportCustomersOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportCustomersOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportCustomersOptions proto.InternalMessageInfo
+
+func (m *ImportCustomersOptions) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ImportCustomersOptions) GetParallelism() uint32 {
+	if m != nil {
+		return m.Parallelism
+	}
+	return 0
+}
+
+type ImportCustomersRow struct {
+	Row                     uint64   `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	EmailAddress            string   `protobuf:"bytes,2,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	GivenName               string   `protobuf:"bytes,3,opt,name=givenName,proto3" json:"givenName,omitempty"`
+	FamilyName              string   `protobuf:"bytes,4,opt,name=familyName,proto3" json:"familyName,omitempty"`
+	IsEmailAddressConfirmed bool     `protobuf:"varint,5,opt,name=isEmailAddressConfirmed,proto3" json:"isEmailAddressConfirmed,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
+}
+
+func (m *ImportCustomersRow) Reset()         { *m = ImportCustomersRow{} }
+func (m *ImportCustomersRow) String() string { return proto.CompactTextString(m) }
+func (*ImportCustomersRow) ProtoMessage()    {}
+func (*ImportCustomersRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a26851434a02adda, []int{2}
+}
+
+func (m *ImportCustomersRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportCustomersRow.Unmarshal(m, b)
+}
+func (m *ImportCustomersRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportCustomersRow.Marshal(b, m, deterministic)
+}
+func (m *ImportCustomersRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportCustomersRow.Merge(m, src)
+}
+func (m *ImportCustomersRow) XXX_Size() int {
+	return xxx_messageInfo_ImportCustomersRow.Size(m)
+}
+func (m *ImportCustomersRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportCustomersRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportCustomersRow proto.InternalMessageInfo
+
+func (m *ImportCustomersRow) GetRow() uint64 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *ImportCustomersRow) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
+}
+
+func (m *ImportCustomersRow) GetGivenName() string {
+	if m != nil {
+		return m.GivenName
+	}
+	return ""
+}
+
+func (m *ImportCustomersRow) GetFamilyName() string {
+	if m != nil {
+		return m.FamilyName
+	}
+	return ""
+}
+
+func (m *ImportCustomersRow) GetIsEmailAddressConfirmed() bool {
+	if m != nil {
+		return m.IsEmailAddressConfirmed
+	}
+	return false
+}
+
+type ImportCustomersResult struct {
+	Row                  uint64   `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	CustomerId           string   `protobuf:"bytes,3,opt,name=customerId,proto3" json:"customerId,omitempty"`
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportCustomersResult) Reset()         { *m = ImportCustomersResult{} }
+func (m *ImportCustomersResult) String() string { return proto.CompactTextString(m) }
+func (*ImportCustomersResult) ProtoMessage()    {}
+func (*ImportCustomersResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a26851434a02adda, []int{3}
+}
+
+func (m *ImportCustomersResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportCustomersResult.Unmarshal(m, b)
+}
+func (m *ImportCustomersResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportCustomersResult.Marshal(b, m, deterministic)
+}
+func (m *ImportCustomersResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportCustomersResult.Merge(m, src)
+}
+func (m *ImportCustomersResult) XXX_Size() int {
+	return xxx_messageInfo_ImportCustomersResult.Size(m)
+}
+func (m *ImportCustomersResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportCustomersResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportCustomersResult proto.InternalMessageInfo
+
+func (m *ImportCustomersResult) GetRow() uint64 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *ImportCustomersResult) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ImportCustomersResult) GetCustomerId() string {
+	if m != nil {
+		return m.CustomerId
+	}
+	return ""
+}
+
+func (m *ImportCustomersResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ImportCustomersRequest)(nil), "customergrpc.ImportCustomersRequest")
+	proto.RegisterType((*ImportCustomersOptions)(nil), "customergrpc.ImportCustomersOptions")
+	proto.RegisterType((*ImportCustomersRow)(nil), "customergrpc.ImportCustomersRow")
+	proto.RegisterType((*ImportCustomersResult)(nil), "customergrpc.ImportCustomersResult")
+}
+
+func init() { proto.RegisterFile("customeradmin.proto", fileDescriptor_a26851434a02adda) }
+
+var fileDescriptor_a26851434a02adda = []byte{
+	// 348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xdd, 0x4e, 0xf2, 0x40,
+	0x10, 0xa5, 0x1f, 0x7f, 0x1f, 0x03, 0xe4, 0xfb, 0x32, 0x6a, 0x6d, 0x8c, 0x31, 0xa4, 0x7a, 0xc1,
+	0x15, 0x31, 0xe8, 0x85, 0x97, 0x22, 0x31, 0x81, 0x1b, 0x4d, 0xf6, 0x09, 0x5c, 0xe9, 0x42, 0x36,
+	0xe9, 0xee, 0x96, 0xdd, 0xad, 0x84, 0x67, 0xf1, 0x6d, 0x7c, 0x32, 0xd3, 0xa5, 0x8d, 0x15, 0x10,
+	0x2f, 0xe7, 0xcc, 0x9c, 0x99, 0x73, 0x32, 0x07, 0x8e, 0x66, 0xa9, 0xb1, 0x4a, 0x30, 0x4d, 0x23,
+	0xc1, 0xe5, 0x20, 0xd1, 0xca, 0x2a, 0xec, 0x14, 0xe0, 0x42, 0x27, 0xb3, 0xf0, 0xdd, 0x03, 0x7f,
+	0x2a, 0x12, 0xa5, 0xed, 0x38, 0x87, 0x0d, 0x61, 0xcb, 0x94, 0x19, 0x8b, 0xf7, 0xd0, 0x54, 0x89,
+	0xe5, 0x4a, 0x9a, 0xc0, 0xeb, 0x79, 0xfd, 0xf6, 0xf0, 0x6a, 0x50, 0xa6, 0x0e, 0xb6, 0x68, 0xcf,
+	0x9b, 0xd9, 0x49, 0x85, 0x14, 0x34, 0xbc, 0x85, 0xaa, 0x56, 0xab, 0xe0, 0x8f, 0x63, 0xf7, 0x0e,
+	0xb2, 0x89, 0x5a, 0x4d, 0x2a, 0x24, 0x1b, 0x7f, 0x68, 0x41, 0x73, 0xa6, 0xa4, 0x65, 0xd2, 0x86,
+	0x04, 0xfc, 0xfd, 0x57, 0xd0, 0x87, 0x46, 0xa4, 0xd7, 0x24, 0x95, 0x4e, 0xdb, 0x5f, 0x92, 0x57,
+	0xd8, 0x83, 0x76, 0x42, 0x35, 0x8d, 0x63, 0x16, 0x73, 0x23, 0xdc, 0xe9, 0x2e, 0x29, 0x43, 0xe1,
+	0x87, 0x07, 0xb8, 0x7b, 0x1c, 0xff, 0x6f, 0xb4, 0x66, 0xdb, 0x6a, 0x4e, 0x07, 0x86, 0xd0, 0x61,
+	0x82, 0xf2, 0x78, 0x14, 0x45, 0x9a, 0x19, 0xe3, 0x76, 0xb5, 0xc8, 0x37, 0x0c, 0xcf, 0xa1, 0xb5,
+	0xe0, 0x6f, 0x4c, 0x3e, 0x51, 0xc1, 0x82, 0xaa, 0x1b, 0xf8, 0x02, 0xf0, 0x02, 0x60, 0x4e, 0x05,
+	0x8f, 0xd7, 0xae, 0x5d, 0x73, 0xed, 0x12, 0x82, 0x77, 0x70, 0xca, 0xcd, 0x63, 0x69, 0xdf, 0x58,
+	0xc9, 0x39, 0xd7, 0x82, 0x45, 0x41, 0xdd, 0xb9, 0xfa, 0xa9, 0x1d, 0xae, 0xe0, 0x64, 0xe7, 0x6b,
+	0x26, 0x8d, 0xed, 0x1e, 0x1b, 0x3e, 0x34, 0x8c, 0xa5, 0x36, 0x2d, 0x0c, 0xe4, 0x55, 0x26, 0xae,
+	0x78, 0xc8, 0x34, 0xca, 0xb5, 0x97, 0x10, 0x3c, 0x86, 0x3a, 0xd3, 0x5a, 0xe9, 0x5c, 0xf7, 0xa6,
+	0x18, 0x2e, 0xa1, 0x5b, 0x9c, 0x1c, 0x65, 0xa1, 0xc2, 0x17, 0xf8, 0xb7, 0xa5, 0x04, 0x0f, 0xe7,
+	0x24, 0x8f, 0xd7, 0xd9, 0xe5, 0x2f, 0x53, 0x99, 0x9d, 0xbe, 0x77, 0xed, 0xbd, 0x36, 0x5c, 0x6e,
+	0x6f, 0x3e, 0x07, 0x00, 0xfa, 0x50, 0xbf, 0x63, 0xce, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// CustomerAdminClient is the client API for CustomerAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CustomerAdminClient interface {
+	// Send the options first, then one message per row.
+	ImportCustomers(ctx context.Context, opts ...grpc.CallOption) (CustomerAdmin_ImportCustomersClient, error)
+}
+
+type customerAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCustomerAdminClient(cc grpc.ClientConnInterface) CustomerAdminClient {
+	return &customerAdminClient{cc}
+}
+
+func (c *customerAdminClient) ImportCustomers(ctx context.Context, opts ...grpc.CallOption) (CustomerAdmin_ImportCustomersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CustomerAdmin_serviceDesc.Streams[0], "/customergrpc.CustomerAdmin/ImportCustomers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &customerAdminImportCustomersClient{stream}
+	return x, nil
+}
+
+type CustomerAdmin_ImportCustomersClient interface {
+	Send(*ImportCustomersRequest) error
+	Recv() (*ImportCustomersResult, error)
+	grpc.ClientStream
+}
+
+type customerAdminImportCustomersClient struct {
+	grpc.ClientStream
+}
+
+func (x *customerAdminImportCustomersClient) Send(m *ImportCustomersRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *customerAdminImportCustomersClient) Recv() (*ImportCustomersResult, error) {
+	m := new(ImportCustomersResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// CustomerAdminServer is the server API for CustomerAdmin service.
+type CustomerAdminServer interface {
+	// Send the options first, then one message per row.
+	ImportCustomers(CustomerAdmin_ImportCustomersServer) error
+}
+
+// UnimplementedCustomerAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedCustomerAdminServer struct {
+}
+
+func (*UnimplementedCustomerAdminServer) ImportCustomers(srv CustomerAdmin_ImportCustomersServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportCustomers not implemented")
+}
+
+func RegisterCustomerAdminServer(s *grpc.Server, srv CustomerAdminServer) {
+	s.RegisterService(&_CustomerAdmin_serviceDesc, srv)
+}
+
+func _CustomerAdmin_ImportCustomers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CustomerAdminServer).ImportCustomers(&customerAdminImportCustomersServer{stream})
+}
+
+type CustomerAdmin_ImportCustomersServer interface {
+	Send(*ImportCustomersResult) error
+	Recv() (*ImportCustomersRequest, error)
+	grpc.ServerStream
+}
+
+type customerAdminImportCustomersServer struct {
+	grpc.ServerStream
+}
+
+func (x *customerAdminImportCustomersServer) Send(m *ImportCustomersResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *customerAdminImportCustomersServer) Recv() (*ImportCustomersRequest, error) {
+	m := new(ImportCustomersRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _CustomerAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "customergrpc.CustomerAdmin",
+	HandlerType: (*CustomerAdminServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportCustomers",
+			Handler:       _CustomerAdmin_ImportCustomers_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "customeradmin.proto",
+}
@@ -0,0 +1,538 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: eventstoreadmin.proto
+
+package customergrpc
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryEventsRequest struct {
+	EventName            string   `protobuf:"bytes,1,opt,name=eventName,proto3" json:"eventName,omitempty"`
+	CustomerId           string   `protobuf:"bytes,2,opt,name=customerId,proto3" json:"customerId,omitempty"`
+	OccurredFrom         string   `protobuf:"bytes,3,opt,name=occurredFrom,proto3" json:"occurredFrom,omitempty"`
+	OccurredUntil        string   `protobuf:"bytes,4,opt,name=occurredUntil,proto3" json:"occurredUntil,omitempty"`
+	PageSize             uint32   `protobuf:"varint,5,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	AfterPosition        uint64   `protobuf:"varint,6,opt,name=afterPosition,proto3" json:"afterPosition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryEventsRequest) Reset()         { *m = QueryEventsRequest{} }
+func (m *QueryEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEventsRequest) ProtoMessage()    {}
+func (*QueryEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2faad20d87d618, []int{0}
+}
+
+func (m *QueryEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryEventsRequest.Unmarshal(m, b)
+}
+func (m *QueryEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEventsRequest.Merge(m, src)
+}
+func (m *QueryEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryEventsRequest.Size(m)
+}
+func (m *QueryEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEventsRequest proto.InternalMessageInfo
+
+func (m *QueryEventsRequest) GetEventName() string {
+	if m != nil {
+		return m.EventName
+	}
+	return ""
+}
+
+func (m *QueryEventsRequest) GetCustomerId() string {
+	if m != nil {
+		return m.CustomerId
+	}
+	return ""
+}
+
+func (m *QueryEventsRequest) GetOccurredFrom() string {
+	if m != nil {
+		return m.OccurredFrom
+	}
+	return ""
+}
+
+func (m *QueryEventsRequest) GetOccurredUntil() string {
+	if m != nil {
+		return m.OccurredUntil
+	}
+	return ""
+}
+
+func (m *QueryEventsRequest) GetPageSize() uint32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *QueryEventsRequest) GetAfterPosition() uint64 {
+	if m != nil {
+		return m.AfterPosition
+	}
+	return 0
+}
+
+type QueryEventsResponse struct {
+	Events               []*StoredEventMetadata `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextAfterPosition    uint64                 `protobuf:"varint,2,opt,name=nextAfterPosition,proto3" json:"nextAfterPosition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *QueryEventsResponse) Reset()         { *m = QueryEventsResponse{} }
+func (m *QueryEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEventsResponse) ProtoMessage()    {}
+func (*QueryEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2faad20d87d618, []int{1}
+}
+
+func (m *QueryEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryEventsResponse.Unmarshal(m, b)
+}
+func (m *QueryEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *QueryEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEventsResponse.Merge(m, src)
+}
+func (m *QueryEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryEventsResponse.Size(m)
+}
+func (m *QueryEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEventsResponse proto.InternalMessageInfo
+
+func (m *QueryEventsResponse) GetEvents() []*StoredEventMetadata {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *QueryEventsResponse) GetNextAfterPosition() uint64 {
+	if m != nil {
+		return m.NextAfterPosition
+	}
+	return 0
+}
+
+type StoredEventMetadata struct {
+	Position             uint64   `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	StreamId             string   `protobuf:"bytes,2,opt,name=streamId,proto3" json:"streamId,omitempty"`
+	StreamVersion        uint64   `protobuf:"varint,3,opt,name=streamVersion,proto3" json:"streamVersion,omitempty"`
+	EventName            string   `protobuf:"bytes,4,opt,name=eventName,proto3" json:"eventName,omitempty"`
+	OccurredAt           string   `protobuf:"bytes,5,opt,name=occurredAt,proto3" json:"occurredAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StoredEventMetadata) Reset()         { *m = StoredEventMetadata{} }
+func (m *StoredEventMetadata) String() string { return proto.CompactTextString(m) }
+func (*StoredEventMetadata) ProtoMessage()    {}
+func (*StoredEventMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2faad20d87d618, []int{2}
+}
+
+func (m *StoredEventMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoredEventMetadata.Unmarshal(m, b)
+}
+func (m *StoredEventMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoredEventMetadata.Marshal(b, m, deterministic)
+}
+func (m *StoredEventMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoredEventMetadata.Merge(m, src)
+}
+func (m *StoredEventMetadata) XXX_Size() int {
+	return xxx_messageInfo_StoredEventMetadata.Size(m)
+}
+func (m *StoredEventMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoredEventMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoredEventMetadata proto.InternalMessageInfo
+
+func (m *StoredEventMetadata) GetPosition() uint64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *StoredEventMetadata) GetStreamId() string {
+	if m != nil {
+		return m.StreamId
+	}
+	return ""
+}
+
+func (m *StoredEventMetadata) GetStreamVersion() uint64 {
+	if m != nil {
+		return m.StreamVersion
+	}
+	return 0
+}
+
+func (m *StoredEventMetadata) GetEventName() string {
+	if m != nil {
+		return m.EventName
+	}
+	return ""
+}
+
+func (m *StoredEventMetadata) GetOccurredAt() string {
+	if m != nil {
+		return m.OccurredAt
+	}
+	return ""
+}
+
+type CountEventsPerDayRequest struct {
+	EventName            string   `protobuf:"bytes,1,opt,name=eventName,proto3" json:"eventName,omitempty"`
+	CustomerId           string   `protobuf:"bytes,2,opt,name=customerId,proto3" json:"customerId,omitempty"`
+	OccurredFrom         string   `protobuf:"bytes,3,opt,name=occurredFrom,proto3" json:"occurredFrom,omitempty"`
+	OccurredUntil        string   `protobuf:"bytes,4,opt,name=occurredUntil,proto3" json:"occurredUntil,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CountEventsPerDayRequest) Reset()         { *m = CountEventsPerDayRequest{} }
+func (m *CountEventsPerDayRequest) String() string { return proto.CompactTextString(m) }
+func (*CountEventsPerDayRequest) ProtoMessage()    {}
+func (*CountEventsPerDayRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2faad20d87d618, []int{3}
+}
+
+func (m *CountEventsPerDayRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountEventsPerDayRequest.Unmarshal(m, b)
+}
+func (m *CountEventsPerDayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountEventsPerDayRequest.Marshal(b, m, deterministic)
+}
+func (m *CountEventsPerDayRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountEventsPerDayRequest.Merge(m, src)
+}
+func (m *CountEventsPerDayRequest) XXX_Size() int {
+	return xxx_messageInfo_CountEventsPerDayRequest.Size(m)
+}
+func (m *CountEventsPerDayRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountEventsPerDayRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountEventsPerDayRequest proto.InternalMessageInfo
+
+func (m *CountEventsPerDayRequest) GetEventName() string {
+	if m != nil {
+		return m.EventName
+	}
+	return ""
+}
+
+func (m *CountEventsPerDayRequest) GetCustomerId() string {
+	if m != nil {
+		return m.CustomerId
+	}
+	return ""
+}
+
+func (m *CountEventsPerDayRequest) GetOccurredFrom() string {
+	if m != nil {
+		return m.OccurredFrom
+	}
+	return ""
+}
+
+func (m *CountEventsPerDayRequest) GetOccurredUntil() string {
+	if m != nil {
+		return m.OccurredUntil
+	}
+	return ""
+}
+
+type CountEventsPerDayResponse struct {
+	Counts               []*EventCount `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CountEventsPerDayResponse) Reset()         { *m = CountEventsPerDayResponse{} }
+func (m *CountEventsPerDayResponse) String() string { return proto.CompactTextString(m) }
+func (*CountEventsPerDayResponse) ProtoMessage()    {}
+func (*CountEventsPerDayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2faad20d87d618, []int{4}
+}
+
+func (m *CountEventsPerDayResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountEventsPerDayResponse.Unmarshal(m, b)
+}
+func (m *CountEventsPerDayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountEventsPerDayResponse.Marshal(b, m, deterministic)
+}
+func (m *CountEventsPerDayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountEventsPerDayResponse.Merge(m, src)
+}
+func (m *CountEventsPerDayResponse) XXX_Size() int {
+	return xxx_messageInfo_CountEventsPerDayResponse.Size(m)
+}
+func (m *CountEventsPerDayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountEventsPerDayResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountEventsPerDayResponse proto.InternalMessageInfo
+
+func (m *CountEventsPerDayResponse) GetCounts() []*EventCount {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+type EventCount struct {
+	Day                  string   `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	EventName            string   `protobuf:"bytes,2,opt,name=eventName,proto3" json:"eventName,omitempty"`
+	Count                uint64   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventCount) Reset()         { *m = EventCount{} }
+func (m *EventCount) String() string { return proto.CompactTextString(m) }
+func (*EventCount) ProtoMessage()    {}
+func (*EventCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef2faad20d87d618, []int{5}
+}
+
+func (m *EventCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventCount.Unmarshal(m, b)
+}
+func (m *EventCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventCount.Marshal(b, m, deterministic)
+}
+func (m *EventCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCount.Merge(m, src)
+}
+func (m *EventCount) XXX_Size() int {
+	return xxx_messageInfo_EventCount.Size(m)
+}
+func (m *EventCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCount proto.InternalMessageInfo
+
+func (m *EventCount) GetDay() string {
+	if m != nil {
+		return m.Day
+	}
+	return ""
+}
+
+func (m *EventCount) GetEventName() string {
+	if m != nil {
+		return m.EventName
+	}
+	return ""
+}
+
+func (m *EventCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryEventsRequest)(nil), "customergrpc.QueryEventsRequest")
+	proto.RegisterType((*QueryEventsResponse)(nil), "customergrpc.QueryEventsResponse")
+	proto.RegisterType((*StoredEventMetadata)(nil), "customergrpc.StoredEventMetadata")
+	proto.RegisterType((*CountEventsPerDayRequest)(nil), "customergrpc.CountEventsPerDayRequest")
+	proto.RegisterType((*CountEventsPerDayResponse)(nil), "customergrpc.CountEventsPerDayResponse")
+	proto.RegisterType((*EventCount)(nil), "customergrpc.EventCount")
+}
+
+func init() { proto.RegisterFile("eventstoreadmin.proto", fileDescriptor_ef2faad20d87d618) }
+
+var fileDescriptor_ef2faad20d87d618 = []byte{
+	// 435 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcb, 0x8e, 0xd3, 0x30,
+	0x14, 0x95, 0x9b, 0x4e, 0x44, 0xef, 0xcc, 0x08, 0xc6, 0x03, 0x92, 0xa9, 0x10, 0xca, 0x44, 0x08,
+	0xb2, 0x40, 0x15, 0x1a, 0x56, 0x2c, 0x2b, 0x1e, 0x12, 0x8b, 0x41, 0xc5, 0x15, 0xec, 0x4d, 0x72,
+	0xa9, 0x22, 0x91, 0x38, 0xd8, 0x0e, 0xa2, 0x2c, 0xf8, 0x18, 0xfe, 0x80, 0xef, 0xe0, 0x1f, 0xf8,
+	0x16, 0x94, 0x9b, 0x47, 0x63, 0x5a, 0x60, 0xcb, 0x2e, 0xf7, 0xf8, 0x9e, 0x6b, 0x9f, 0x73, 0xec,
+	0xc0, 0x2d, 0xfc, 0x84, 0xa5, 0xb3, 0x4e, 0x1b, 0x54, 0x59, 0x91, 0x97, 0x8b, 0xca, 0x68, 0xa7,
+	0xf9, 0x49, 0x5a, 0x5b, 0xa7, 0x0b, 0x34, 0x1b, 0x53, 0xa5, 0xf1, 0x4f, 0x06, 0xfc, 0x75, 0x8d,
+	0x66, 0xfb, 0x9c, 0x9a, 0x25, 0x7e, 0xac, 0xd1, 0x3a, 0x7e, 0x07, 0x66, 0xc4, 0x7e, 0xa5, 0x0a,
+	0x14, 0x2c, 0x62, 0xc9, 0x4c, 0xee, 0x00, 0x7e, 0x17, 0xa0, 0x1f, 0xf2, 0x32, 0x13, 0x13, 0x5a,
+	0x1e, 0x21, 0x3c, 0x86, 0x13, 0x9d, 0xa6, 0xb5, 0x31, 0x98, 0xbd, 0x30, 0xba, 0x10, 0x01, 0x75,
+	0x78, 0x18, 0xbf, 0x07, 0xa7, 0x7d, 0xfd, 0xa6, 0x74, 0xf9, 0x07, 0x31, 0xa5, 0x26, 0x1f, 0xe4,
+	0x73, 0xb8, 0x56, 0xa9, 0x0d, 0xae, 0xf3, 0x2f, 0x28, 0x8e, 0x22, 0x96, 0x9c, 0xca, 0xa1, 0x6e,
+	0x26, 0xa8, 0xf7, 0x0e, 0xcd, 0x4a, 0xdb, 0xdc, 0xe5, 0xba, 0x14, 0x61, 0xc4, 0x92, 0xa9, 0xf4,
+	0xc1, 0xf8, 0x2b, 0x9c, 0x7b, 0xfa, 0x6c, 0xa5, 0x4b, 0x8b, 0xfc, 0x09, 0x84, 0xad, 0x3d, 0x82,
+	0x45, 0x41, 0x72, 0x7c, 0x79, 0xb1, 0x18, 0xdb, 0xb2, 0x58, 0x37, 0xae, 0x65, 0xc4, 0xb9, 0x42,
+	0xa7, 0x32, 0xe5, 0x94, 0xec, 0x08, 0xfc, 0x21, 0x9c, 0x95, 0xf8, 0xd9, 0x2d, 0xbd, 0xbd, 0x27,
+	0xb4, 0xf7, 0xfe, 0x42, 0xfc, 0x9d, 0xc1, 0xf9, 0x81, 0x69, 0xa4, 0xac, 0x27, 0x33, 0x22, 0x0f,
+	0x75, 0xb3, 0x66, 0x9d, 0x41, 0x55, 0x0c, 0xee, 0x0e, 0x75, 0xa3, 0xba, 0xfd, 0x7e, 0x8b, 0xc6,
+	0x36, 0xe4, 0xa0, 0x55, 0xed, 0x81, 0x7e, 0x7e, 0xd3, 0x03, 0xf9, 0xf5, 0x36, 0x2f, 0x1d, 0xf9,
+	0x3a, 0x93, 0x23, 0x24, 0xfe, 0xc6, 0x40, 0x3c, 0xd5, 0x75, 0xe9, 0x5a, 0xd3, 0x56, 0x68, 0x9e,
+	0xa9, 0xed, 0x7f, 0x76, 0x35, 0xe2, 0x2b, 0xb8, 0x7d, 0xe0, 0x8c, 0x5d, 0xbc, 0x8f, 0x20, 0x4c,
+	0x75, 0xbd, 0x8b, 0x57, 0xf8, 0xf1, 0x12, 0x87, 0xd8, 0xb2, 0xeb, 0x8b, 0x25, 0xc0, 0x0e, 0xe5,
+	0x37, 0x20, 0xc8, 0xd4, 0xb6, 0x93, 0xd7, 0x7c, 0xfa, 0xb2, 0x27, 0xbf, 0xcb, 0xbe, 0x09, 0x47,
+	0x34, 0xa7, 0x4b, 0xa3, 0x2d, 0x2e, 0x7f, 0x30, 0xb8, 0x4e, 0x43, 0xe9, 0x02, 0x2c, 0x9b, 0x47,
+	0xc8, 0x25, 0x1c, 0x8f, 0xee, 0x23, 0x8f, 0xfc, 0x83, 0xed, 0x3f, 0xc5, 0xf9, 0xc5, 0x5f, 0x3a,
+	0x3a, 0xb5, 0x19, 0x9c, 0xed, 0x59, 0xc1, 0xef, 0xfb, 0xbc, 0x3f, 0xe5, 0x39, 0x7f, 0xf0, 0xcf,
+	0xbe, 0x76, 0x97, 0x77, 0x21, 0xfd, 0x3f, 0x1e, 0xff, 0x1a, 0x00, 0xd1, 0xf4, 0x04, 0x29, 0x58,
+	0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// EventStoreAdminClient is the client API for EventStoreAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EventStoreAdminClient interface {
+	QueryEvents(ctx context.Context, in *QueryEventsRequest, opts ...grpc.CallOption) (*QueryEventsResponse, error)
+	CountEventsPerDay(ctx context.Context, in *CountEventsPerDayRequest, opts ...grpc.CallOption) (*CountEventsPerDayResponse, error)
+}
+
+type eventStoreAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventStoreAdminClient(cc grpc.ClientConnInterface) EventStoreAdminClient {
+	return &eventStoreAdminClient{cc}
+}
+
+func (c *eventStoreAdminClient) QueryEvents(ctx context.Context, in *QueryEventsRequest, opts ...grpc.CallOption) (*QueryEventsResponse, error) {
+	out := new(QueryEventsResponse)
+	err := c.cc.Invoke(ctx, "/customergrpc.EventStoreAdmin/QueryEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventStoreAdminClient) CountEventsPerDay(ctx context.Context, in *CountEventsPerDayRequest, opts ...grpc.CallOption) (*CountEventsPerDayResponse, error) {
+	out := new(CountEventsPerDayResponse)
+	err := c.cc.Invoke(ctx, "/customergrpc.EventStoreAdmin/CountEventsPerDay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventStoreAdminServer is the server API for EventStoreAdmin service.
+type EventStoreAdminServer interface {
+	QueryEvents(context.Context, *QueryEventsRequest) (*QueryEventsResponse, error)
+	CountEventsPerDay(context.Context, *CountEventsPerDayRequest) (*CountEventsPerDayResponse, error)
+}
+
+// UnimplementedEventStoreAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedEventStoreAdminServer struct {
+}
+
+func (*UnimplementedEventStoreAdminServer) QueryEvents(ctx context.Context, req *QueryEventsRequest) (*QueryEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryEvents not implemented")
+}
+func (*UnimplementedEventStoreAdminServer) CountEventsPerDay(ctx context.Context, req *CountEventsPerDayRequest) (*CountEventsPerDayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountEventsPerDay not implemented")
+}
+
+func RegisterEventStoreAdminServer(s *grpc.Server, srv EventStoreAdminServer) {
+	s.RegisterService(&_EventStoreAdmin_serviceDesc, srv)
+}
+
+func _EventStoreAdmin_QueryEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventStoreAdminServer).QueryEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customergrpc.EventStoreAdmin/QueryEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventStoreAdminServer).QueryEvents(ctx, req.(*QueryEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventStoreAdmin_CountEventsPerDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountEventsPerDayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventStoreAdminServer).CountEventsPerDay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/customergrpc.EventStoreAdmin/CountEventsPerDay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventStoreAdminServer).CountEventsPerDay(ctx, req.(*CountEventsPerDayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _EventStoreAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "customergrpc.EventStoreAdmin",
+	HandlerType: (*EventStoreAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryEvents",
+			Handler:    _EventStoreAdmin_QueryEvents_Handler,
+		},
+		{
+			MethodName: "CountEventsPerDay",
+			Handler:    _EventStoreAdmin_CountEventsPerDay_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "eventstoreadmin.proto",
+}
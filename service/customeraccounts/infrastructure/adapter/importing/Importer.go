@@ -0,0 +1,178 @@
+package importing
+
+import (
+	"io"
+	"sync"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+const defaultParallelism = uint(4)
+
+type ForReportingRowResults func(result RowResult) error
+
+// Importer validates rows and registers them as Customers with the configured parallelism.
+// In dry-run mode the rows are only validated, so importCustomer can be nil.
+type Importer struct {
+	importCustomer hexagon.ForImportingCustomers
+	dryRun         bool
+	parallelism    uint
+}
+
+func NewImporter(importCustomer hexagon.ForImportingCustomers, dryRun bool, parallelism uint) *Importer {
+	if parallelism == 0 {
+		parallelism = defaultParallelism
+	}
+
+	return &Importer{
+		importCustomer: importCustomer,
+		dryRun:         dryRun,
+		parallelism:    parallelism,
+	}
+}
+
+// Import skips all rows contained in processedRows and reports the result of every other row, in no particular order.
+// It returns a count per status, even if it fails.
+func (importer *Importer) Import(
+	rows RowReader,
+	processedRows map[uint64]bool,
+	reportResult ForReportingRowResults,
+) (map[string]uint, error) {
+
+	var err error
+	var mutex sync.Mutex
+	var workers sync.WaitGroup
+	summary := make(map[string]uint)
+	pendingRows := make(chan Row)
+	failed := make(chan struct{})
+	wrapWithMsg := "importer.Import"
+
+	fail := func(reason error) {
+		if err == nil {
+			err = reason
+			close(failed)
+		}
+	}
+
+	for i := uint(0); i < importer.parallelism; i++ {
+		workers.Add(1)
+
+		go func() {
+			defer workers.Done()
+
+			for row := range pendingRows {
+				select {
+				case <-failed:
+					continue // drain the remaining rows without importing them
+				default:
+				}
+
+				result := importer.importRow(row)
+
+				mutex.Lock()
+
+				if err == nil {
+					summary[result.Status]++
+
+					if reportErr := reportResult(result); reportErr != nil {
+						fail(reportErr)
+					}
+				}
+
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	readRows := func() {
+		defer close(pendingRows)
+
+		for {
+			row, readErr := rows.Next()
+
+			if readErr == io.EOF {
+				return
+			}
+
+			if readErr != nil {
+				mutex.Lock()
+				fail(readErr)
+				mutex.Unlock()
+
+				return
+			}
+
+			if processedRows[row.Number] {
+				continue
+			}
+
+			select {
+			case pendingRows <- row:
+			case <-failed:
+				return
+			}
+		}
+	}
+
+	readRows()
+	workers.Wait()
+
+	if err != nil {
+		return summary, errors.Wrap(err, wrapWithMsg)
+	}
+
+	return summary, nil
+}
+
+func (importer *Importer) importRow(row Row) RowResult {
+	result := RowResult{Row: row.Number}
+
+	err := row.Err
+
+	if err == nil {
+		_, err = value.BuildEmailAddress(row.EmailAddress)
+	}
+
+	if err == nil {
+		_, err = value.BuildPersonName(row.GivenName, row.FamilyName)
+	}
+
+	if err != nil {
+		result.Status = StatusInvalid
+		result.Error = err.Error()
+
+		return result
+	}
+
+	if importer.dryRun {
+		result.Status = StatusValid
+
+		return result
+	}
+
+	customerID, err := importer.importCustomer(row.EmailAddress, row.GivenName, row.FamilyName, row.IsEmailAddressConfirmed)
+
+	switch {
+	case err == nil:
+		result.Status = StatusImported
+	case errors.Is(err, shared.ErrDuplicate):
+		result.Status = StatusDuplicate
+	case errors.Is(err, shared.ErrInputIsInvalid):
+		result.Status = StatusInvalid
+	default:
+		result.Status = StatusFailed
+	}
+
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	if customerID.String() != "" {
+		result.CustomerID = customerID.String()
+	}
+
+	return result
+}
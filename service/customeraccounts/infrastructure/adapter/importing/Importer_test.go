@@ -0,0 +1,226 @@
+package importing_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/adapter/importing"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const csvInput = `emailAddress,givenName,familyName,isEmailAddressConfirmed
+fiona@gallagher.net,Fiona,Gallagher,true
+not-an-email,Lip,Gallagher,false
+ian@gallagher.net,Ian,Gallagher,
+debbie@gallagher.net,Debbie,Gallagher,maybe
+"broken,Carl`
+
+const ndjsonInput = `{"emailAddress":"fiona@gallagher.net","givenName":"Fiona","familyName":"Gallagher","isEmailAddressConfirmed":true}
+
+{"emailAddress":"ian@gallagher.net","givenName":"","familyName":"Gallagher"}
+{not json`
+
+func TestImporter_Import(t *testing.T) {
+	Convey("Given an Importer which records all imported Customers", t, func() {
+		var mutex sync.Mutex
+		imported := make(map[string]bool)
+
+		importCustomer := func(emailAddress, givenName, familyName string, isEmailAddressConfirmed bool) (value.CustomerID, error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if emailAddress == "ian@gallagher.net" {
+				return value.CustomerID{}, shared.MarkAndWrapError(errors.New("taken"), shared.ErrDuplicate, "test")
+			}
+
+			imported[emailAddress] = isEmailAddressConfirmed
+
+			return value.GenerateCustomerID(), nil
+		}
+
+		results := make(map[uint64]importing.RowResult)
+		reportResult := func(result importing.RowResult) error {
+			results[result.Row] = result
+			return nil
+		}
+
+		Convey("When CSV rows are imported", func() {
+			rows, err := importing.NewRowReader(strings.NewReader(csvInput), importing.FormatCSV)
+			So(err, ShouldBeNil)
+
+			summary, err := importing.NewImporter(importCustomer, false, 2).Import(rows, nil, reportResult)
+			So(err, ShouldBeNil)
+
+			Convey("Then valid rows should be imported and each row should be reported", func() {
+				So(imported, ShouldResemble, map[string]bool{"fiona@gallagher.net": true})
+				So(results, ShouldHaveLength, 5)
+				So(results[1].Status, ShouldEqual, importing.StatusImported)
+				So(results[1].CustomerID, ShouldNotBeBlank)
+				So(results[2].Status, ShouldEqual, importing.StatusInvalid)
+				So(results[3].Status, ShouldEqual, importing.StatusDuplicate)
+				So(results[4].Status, ShouldEqual, importing.StatusInvalid)
+				So(results[5].Status, ShouldEqual, importing.StatusInvalid)
+				So(summary, ShouldResemble, map[string]uint{"imported": 1, "invalid": 3, "duplicate": 1})
+			})
+		})
+
+		Convey("When NDJSON rows are imported in dry-run mode", func() {
+			rows, err := importing.NewRowReader(strings.NewReader(ndjsonInput), importing.FormatNDJSON)
+			So(err, ShouldBeNil)
+
+			_, err = importing.NewImporter(nil, true, 0).Import(rows, nil, reportResult)
+			So(err, ShouldBeNil)
+
+			Convey("Then the rows should only be validated", func() {
+				So(imported, ShouldBeEmpty)
+				So(results, ShouldHaveLength, 3)
+				So(results[1].Status, ShouldEqual, importing.StatusValid)
+				So(results[2].Status, ShouldEqual, importing.StatusInvalid)
+				So(results[3].Status, ShouldEqual, importing.StatusInvalid)
+			})
+		})
+
+		Convey("When an interrupted import is resumed from its report", func() {
+			report := &bytes.Buffer{}
+			reportWriter := importing.NewReportWriter(report)
+			So(reportWriter.Write(importing.RowResult{Row: 1, Status: importing.StatusFailed}), ShouldBeNil)
+			So(reportWriter.Write(importing.RowResult{Row: 2, Status: importing.StatusInvalid}), ShouldBeNil)
+			report.WriteString(`{"row":3,"sta`) // truncated by a crash
+
+			processedRows, err := importing.ReadProcessedRows(report)
+			So(err, ShouldBeNil)
+
+			rows, err := importing.NewRowReader(strings.NewReader(csvInput), importing.FormatCSV)
+			So(err, ShouldBeNil)
+
+			_, err = importing.NewImporter(importCustomer, false, 1).Import(rows, processedRows, reportResult)
+			So(err, ShouldBeNil)
+
+			Convey("Then only the rows which were not finished before should be processed", func() {
+				So(imported, ShouldResemble, map[string]bool{"fiona@gallagher.net": true})
+				So(results, ShouldHaveLength, 4)
+				So(results, ShouldNotContainKey, uint64(2))
+				So(results[1].Status, ShouldEqual, importing.StatusImported)
+			})
+		})
+
+		Convey("When reporting a result fails", func() {
+			rows, err := importing.NewRowReader(strings.NewReader(csvInput), importing.FormatCSV)
+			So(err, ShouldBeNil)
+
+			_, err = importing.NewImporter(importCustomer, true, 1).Import(
+				rows,
+				nil,
+				func(result importing.RowResult) error { return errors.New("disk full") },
+			)
+
+			Convey("Then the import should fail", func() {
+				So(err, ShouldBeError)
+			})
+		})
+	})
+
+	Convey("When a CSV header misses a required column", t, func() {
+		_, err := importing.NewRowReader(strings.NewReader("emailAddress,givenName\n"), importing.FormatCSV)
+
+		Convey("Then it should fail", func() {
+			So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+		})
+	})
+}
+
+func TestImporter_ResumeAfterFailedAppend(t *testing.T) {
+	Convey("Given an Importer which imports confirmed Customers with the CustomerCommandHandler", t, func() {
+		var mutex sync.Mutex
+		streams := make(map[string]es.EventStream)
+		appendFails := true
+		storeBeforeFailing := false
+
+		startCustomerEventStream := func(customerRegistered domain.CustomerRegistered, furtherEvents ...es.DomainEvent) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			emailAddress := customerRegistered.EmailAddress().String()
+
+			if _, ok := streams[emailAddress]; ok {
+				return shared.MarkAndWrapError(errors.New("taken"), shared.ErrDuplicate, "test")
+			}
+
+			if appendFails {
+				appendFails = false
+
+				if !storeBeforeFailing {
+					return errors.New("connection lost before commit")
+				}
+
+				streams[emailAddress] = append(es.EventStream{customerRegistered}, furtherEvents...)
+
+				return errors.New("connection lost after commit")
+			}
+
+			streams[emailAddress] = append(es.EventStream{customerRegistered}, furtherEvents...)
+
+			return nil
+		}
+
+		commandHandler := application.NewCustomerCommandHandler(nil, startCustomerEventStream, nil, nil, nil)
+		input := `{"emailAddress":"fiona@gallagher.net","givenName":"Fiona","familyName":"Gallagher","isEmailAddressConfirmed":true}`
+
+		importAndResume := func() map[uint64]importing.RowResult {
+			report := &bytes.Buffer{}
+			reportWriter := importing.NewReportWriter(report)
+
+			for i := 0; i < 2; i++ {
+				processedRows, err := importing.ReadProcessedRows(bytes.NewReader(report.Bytes()))
+				So(err, ShouldBeNil)
+
+				rows, err := importing.NewRowReader(strings.NewReader(input), importing.FormatNDJSON)
+				So(err, ShouldBeNil)
+
+				_, err = importing.NewImporter(commandHandler.ImportCustomer, false, 1).Import(rows, processedRows, reportWriter.Write)
+				So(err, ShouldBeNil)
+			}
+
+			results := make(map[uint64]importing.RowResult)
+			scanner := bufio.NewScanner(report)
+
+			for scanner.Scan() {
+				var result importing.RowResult
+				So(json.Unmarshal(scanner.Bytes(), &result), ShouldBeNil)
+				results[result.Row] = result
+			}
+
+			return results
+		}
+
+		Convey("When the first append fails before it was committed and the import is resumed", func() {
+			results := importAndResume()
+
+			Convey("Then the row should be imported with a confirmed email address", func() {
+				So(results[1].Status, ShouldEqual, importing.StatusImported)
+				So(customer.BuildViewFrom(streams["fiona@gallagher.net"]).IsEmailAddressConfirmed, ShouldBeTrue)
+			})
+		})
+
+		Convey("When the first append was committed but reported a failure and the import is resumed", func() {
+			storeBeforeFailing = true
+			results := importAndResume()
+
+			Convey("Then the row should be a duplicate of a Customer with a confirmed email address", func() {
+				So(results[1].Status, ShouldEqual, importing.StatusDuplicate)
+				So(customer.BuildViewFrom(streams["fiona@gallagher.net"]).IsEmailAddressConfirmed, ShouldBeTrue)
+			})
+		})
+	})
+}
@@ -0,0 +1,62 @@
+package importing
+
+import (
+	"bufio"
+	"io"
+	"sync"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// ReportWriter writes one RowResult per line as JSON, it is safe for concurrent use.
+type ReportWriter struct {
+	writer io.Writer
+	mutex  sync.Mutex
+}
+
+func NewReportWriter(writer io.Writer) *ReportWriter {
+	return &ReportWriter{writer: writer}
+}
+
+func (w *ReportWriter) Write(result RowResult) error {
+	line, err := jsoniter.ConfigFastest.Marshal(result)
+	if err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrMarshalingFailed, "reportWriter.Write")
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if _, err = w.writer.Write(append(line, '\n')); err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, "reportWriter.Write")
+	}
+
+	return nil
+}
+
+// ReadProcessedRows returns the numbers of all rows with a final status contained in a report, so that an interrupted
+// import can be resumed. Failed rows are retried. A truncated last line (e.g. after a crash) is ignored.
+func ReadProcessedRows(report io.Reader) (map[uint64]bool, error) {
+	processedRows := make(map[uint64]bool)
+	scanner := bufio.NewScanner(report)
+
+	for scanner.Scan() {
+		var result RowResult
+
+		if err := jsoniter.ConfigFastest.Unmarshal(scanner.Bytes(), &result); err != nil || result.Row == 0 {
+			continue
+		}
+
+		switch result.Status {
+		case StatusImported, StatusDuplicate, StatusInvalid:
+			processedRows[result.Row] = true
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, "ReadProcessedRows")
+	}
+
+	return processedRows, nil
+}
@@ -0,0 +1,27 @@
+package importing
+
+const (
+	StatusImported  = "imported"
+	StatusValid     = "valid" // only used in dry-run mode
+	StatusInvalid   = "invalid"
+	StatusDuplicate = "duplicate"
+	StatusFailed    = "failed"
+)
+
+// Row is one Customer to import, Number is the 1-based position of the row in the input (without a header).
+// Err is set if the row could not be parsed.
+type Row struct {
+	Number                  uint64
+	EmailAddress            string
+	GivenName               string
+	FamilyName              string
+	IsEmailAddressConfirmed bool
+	Err                     error
+}
+
+type RowResult struct {
+	Row        uint64 `json:"row"`
+	Status     string `json:"status"`
+	CustomerID string `json:"customerID,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
@@ -0,0 +1,168 @@
+package importing
+
+import (
+	"bufio"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+	jsoniter "github.com/json-iterator/go"
+)
+
+const (
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+
+	columnEmailAddress            = "emailAddress"
+	columnGivenName               = "givenName"
+	columnFamilyName              = "familyName"
+	columnIsEmailAddressConfirmed = "isEmailAddressConfirmed"
+)
+
+// RowReader returns io.EOF after the last Row, all other errors mean that the input can't be read any further.
+type RowReader interface {
+	Next() (Row, error)
+}
+
+func NewRowReader(input io.Reader, format string) (RowReader, error) {
+	switch format {
+	case FormatCSV:
+		return NewCSVRowReader(input)
+	case FormatNDJSON:
+		return NewNDJSONRowReader(input), nil
+	default:
+		err := errors.Newf("format [%s] is not supported", format)
+		return nil, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, "NewRowReader")
+	}
+}
+
+/***** CSV *****/
+
+type csvRowReader struct {
+	reader  *csv.Reader
+	columns map[string]int
+	number  uint64
+}
+
+// NewCSVRowReader expects a header with the columns emailAddress, givenName, familyName
+// and optionally isEmailAddressConfirmed, in any order.
+func NewCSVRowReader(input io.Reader) (RowReader, error) {
+	wrapWithMsg := "NewCSVRowReader"
+
+	reader := csv.NewReader(input)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	columns := make(map[string]int)
+	for idx, column := range header {
+		columns[strings.TrimSpace(column)] = idx
+	}
+
+	for _, required := range []string{columnEmailAddress, columnGivenName, columnFamilyName} {
+		if _, ok := columns[required]; !ok {
+			err = errors.Newf("the header must contain the column [%s]", required)
+			return nil, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+		}
+	}
+
+	return &csvRowReader{reader: reader, columns: columns}, nil
+}
+
+func (r *csvRowReader) Next() (Row, error) {
+	record, err := r.reader.Read()
+	if err == io.EOF {
+		return Row{}, io.EOF
+	}
+
+	r.number++
+	row := Row{Number: r.number}
+
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		row.Err = shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, "csvRowReader.Next")
+		return row, nil
+	}
+
+	if err != nil {
+		return Row{}, shared.MarkAndWrapError(err, shared.ErrTechnical, "csvRowReader.Next")
+	}
+
+	field := func(column string) string {
+		idx, ok := r.columns[column]
+		if !ok || idx >= len(record) {
+			return ""
+		}
+
+		return record[idx]
+	}
+
+	row.EmailAddress = field(columnEmailAddress)
+	row.GivenName = field(columnGivenName)
+	row.FamilyName = field(columnFamilyName)
+
+	if isConfirmed := field(columnIsEmailAddressConfirmed); isConfirmed != "" {
+		if row.IsEmailAddressConfirmed, err = strconv.ParseBool(isConfirmed); err != nil {
+			row.Err = shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, "csvRowReader.Next")
+		}
+	}
+
+	return row, nil
+}
+
+/***** NDJSON *****/
+
+type ndjsonRowReader struct {
+	scanner *bufio.Scanner
+	number  uint64
+}
+
+// NewNDJSONRowReader expects one JSON object per line with the same keys as the CSV columns, empty lines are skipped.
+func NewNDJSONRowReader(input io.Reader) RowReader {
+	return &ndjsonRowReader{scanner: bufio.NewScanner(input)}
+}
+
+func (r *ndjsonRowReader) Next() (Row, error) {
+	var line []byte
+
+	for len(line) == 0 {
+		if !r.scanner.Scan() {
+			if err := r.scanner.Err(); err != nil {
+				return Row{}, shared.MarkAndWrapError(err, shared.ErrTechnical, "ndjsonRowReader.Next")
+			}
+
+			return Row{}, io.EOF
+		}
+
+		line = []byte(strings.TrimSpace(r.scanner.Text()))
+	}
+
+	r.number++
+	row := Row{Number: r.number}
+
+	data := struct {
+		EmailAddress            string `json:"emailAddress"`
+		GivenName               string `json:"givenName"`
+		FamilyName              string `json:"familyName"`
+		IsEmailAddressConfirmed bool   `json:"isEmailAddressConfirmed"`
+	}{}
+
+	if err := jsoniter.ConfigFastest.Unmarshal(line, &data); err != nil {
+		row.Err = shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, "ndjsonRowReader.Next")
+		return row, nil
+	}
+
+	row.EmailAddress = data.EmailAddress
+	row.GivenName = data.GivenName
+	row.FamilyName = data.FamilyName
+	row.IsEmailAddressConfirmed = data.IsEmailAddressConfirmed
+
+	return row, nil
+}
@@ -0,0 +1,26 @@
+package notification
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+)
+
+// LoggingAccountRecoveryTokenSender is meant for local development only, it logs that a recovery token
+// would be sent instead of sending it. The token itself is never logged, because logs are not a secure channel.
+type LoggingAccountRecoveryTokenSender struct {
+	logger *shared.Logger
+}
+
+func NewLoggingAccountRecoveryTokenSender(logger *shared.Logger) *LoggingAccountRecoveryTokenSender {
+	return &LoggingAccountRecoveryTokenSender{logger: logger}
+}
+
+func (sender *LoggingAccountRecoveryTokenSender) SendAccountRecoveryToken(
+	emailAddress value.EmailAddress,
+	_ string,
+) error {
+
+	sender.logger.Infof("account recovery: would send a recovery token to [%s]", emailAddress.String())
+
+	return nil
+}
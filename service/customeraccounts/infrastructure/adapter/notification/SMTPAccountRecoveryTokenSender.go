@@ -0,0 +1,79 @@
+package notification
+
+import (
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+)
+
+const accountRecoveryMailSubject = "Recover your account"
+
+// SMTPAccountRecoveryTokenSender sends the recovery token via email. If a username is given it authenticates
+// with PLAIN auth, which net/smtp only allows via TLS or to localhost.
+type SMTPAccountRecoveryTokenSender struct {
+	hostAndPort   string
+	auth          smtp.Auth
+	senderAddress string
+}
+
+func NewSMTPAccountRecoveryTokenSender(
+	hostAndPort string,
+	username string,
+	password string,
+	senderAddress string,
+) *SMTPAccountRecoveryTokenSender {
+
+	sender := &SMTPAccountRecoveryTokenSender{
+		hostAndPort:   hostAndPort,
+		senderAddress: senderAddress,
+	}
+
+	if username != "" {
+		host, _, _ := net.SplitHostPort(hostAndPort)
+		sender.auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return sender
+}
+
+func (sender *SMTPAccountRecoveryTokenSender) SendAccountRecoveryToken(
+	emailAddress value.EmailAddress,
+	recoveryToken string,
+) error {
+
+	err := smtp.SendMail(
+		sender.hostAndPort,
+		sender.auth,
+		sender.senderAddress,
+		[]string{emailAddress.String()},
+		sender.buildMessage(emailAddress, recoveryToken),
+	)
+
+	if err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, "smtpAccountRecoveryTokenSender.SendAccountRecoveryToken")
+	}
+
+	return nil
+}
+
+func (sender *SMTPAccountRecoveryTokenSender) buildMessage(emailAddress value.EmailAddress, recoveryToken string) []byte {
+	lines := []string{
+		fmt.Sprintf("From: %s", sender.senderAddress),
+		fmt.Sprintf("To: %s", emailAddress.String()),
+		fmt.Sprintf("Subject: %s", accountRecoveryMailSubject),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		"Somebody requested to recover your account. If it was you, use this recovery token to set a new password:",
+		"",
+		recoveryToken,
+		"",
+		"If it was not you, you can ignore this email.",
+	}
+
+	return []byte(strings.Join(lines, "\r\n") + "\r\n")
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+	"github.com/lib/pq"
+)
+
+type CustomAttributeDefinitions struct {
+	db        *sql.DB
+	tableName string
+}
+
+func NewCustomAttributeDefinitions(db *sql.DB, tableName string) *CustomAttributeDefinitions {
+	return &CustomAttributeDefinitions{
+		db:        db,
+		tableName: tableName,
+	}
+}
+
+func (s *CustomAttributeDefinitions) RetrieveDefinition(name string) (value.CustomAttributeDefinition, error) {
+	var attributeType string
+	var validationPattern string
+	wrapWithMsg := "customAttributeDefinitions.RetrieveDefinition"
+
+	queryTemplate := `SELECT attribute_type, validation_pattern FROM %tablename% WHERE name = $1`
+	query := strings.Replace(queryTemplate, "%tablename%", s.tableName, 1)
+
+	err := s.db.QueryRow(query, name).Scan(&attributeType, &validationPattern)
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		err = errors.Newf("custom attribute definition [%s] not found", name)
+		return value.CustomAttributeDefinition{}, shared.MarkAndWrapError(err, shared.ErrNotFound, wrapWithMsg)
+	case err != nil:
+		return value.CustomAttributeDefinition{}, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	return value.RebuildCustomAttributeDefinition(name, attributeType, validationPattern), nil
+}
+
+func (s *CustomAttributeDefinitions) StoreDefinition(definition value.CustomAttributeDefinition) error {
+	wrapWithMsg := "customAttributeDefinitions.StoreDefinition"
+
+	queryTemplate := `INSERT INTO %tablename% (name, attribute_type, validation_pattern) VALUES ($1, $2, $3)`
+	query := strings.Replace(queryTemplate, "%tablename%", s.tableName, 1)
+
+	_, err := s.db.Exec(
+		query,
+		definition.Name(),
+		definition.AttributeType(),
+		definition.ValidationPattern(),
+	)
+
+	if err != nil {
+		return errors.Wrap(s.mapPostgresErrors(err), wrapWithMsg)
+	}
+
+	return nil
+}
+
+func (s *CustomAttributeDefinitions) mapPostgresErrors(err error) error {
+	switch actualErr := err.(type) {
+	case *pq.Error:
+		switch actualErr.Code {
+		case "23505":
+			return errors.Mark(errors.Newf("duplicate custom attribute definition"), shared.ErrDuplicate)
+		}
+	}
+
+	return errors.Mark(err, shared.ErrTechnical) // some other DB error (Tx closed, wrong table, ...)
+}
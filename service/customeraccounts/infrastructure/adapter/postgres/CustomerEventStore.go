@@ -8,6 +8,7 @@ import (
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/adapter/eventdump"
 	"github.com/AntonStoeckl/go-iddd/service/shared"
 	"github.com/AntonStoeckl/go-iddd/service/shared/es"
 	"github.com/cockroachdb/errors"
@@ -16,38 +17,73 @@ import (
 
 const streamPrefix = "customer"
 
+// CustomerEventStore stores the Customer streams in an es.PostgresEventStore. Its hooks maintain the unique
+// email addresses, the projections and the tombstones in the same transactions as the events.
 type CustomerEventStore struct {
 	db                                *sql.DB
-	eventStoreTableName               string
-	marshalDomainEvent                es.MarshalDomainEvent
-	unmarshalDomainEvent              es.UnmarshalDomainEvent
+	eventStore                        *es.PostgresEventStore
+	payloadCodecs                     es.EventPayloadCodecs
 	uniqueEmailAddressesTableName     string
 	buildUniqueEmailAddressAssertions customer.ForBuildingUniqueEmailAddressAssertions
+	customerList                      *CustomerList
+	customerFunnelAnalytics           *CustomerFunnelAnalytics
+	eventStoreIntegrity               *EventStoreIntegrity
 }
 
 func NewCustomerEventStore(
 	db *sql.DB,
 	eventStoreTableName string,
-	marshalDomainEvent es.MarshalDomainEvent,
-	unmarshalDomainEvent es.UnmarshalDomainEvent,
+	streamsTableName string,
+	chainKey []byte,
+	payloadCodecs es.EventPayloadCodecs,
 	uniqueEmailAddressesTableName string,
 	buildUniqueEmailAddressAssertions customer.ForBuildingUniqueEmailAddressAssertions,
+	customerList *CustomerList,
+	customerFunnelAnalytics *CustomerFunnelAnalytics,
+	eventStoreIntegrity *EventStoreIntegrity,
+	eventQuarantine *es.EventQuarantine,
 ) *CustomerEventStore {
 
-	return &CustomerEventStore{
+	store := &CustomerEventStore{
 		db:                                db,
-		eventStoreTableName:               eventStoreTableName,
-		marshalDomainEvent:                marshalDomainEvent,
-		unmarshalDomainEvent:              unmarshalDomainEvent,
+		payloadCodecs:                     payloadCodecs,
 		uniqueEmailAddressesTableName:     uniqueEmailAddressesTableName,
 		buildUniqueEmailAddressAssertions: buildUniqueEmailAddressAssertions,
+		customerList:                      customerList,
+		customerFunnelAnalytics:           customerFunnelAnalytics,
+		eventStoreIntegrity:               eventStoreIntegrity,
 	}
+
+	store.eventStore = es.NewPostgresEventStore(
+		db,
+		eventStoreTableName,
+		streamsTableName,
+		chainKey,
+		payloadCodecs,
+		eventQuarantine,
+		es.EventStoreHooks{
+			BeforeAppend: store.beforeAppend,
+			AfterAppend:  store.afterAppend,
+			BeforePurge:  store.beforePurge,
+		},
+	)
+
+	return store
+}
+
+// WithCorruptEventPolicy returns a copy of the store which loads streams with the given policy, so that
+// each consumer can choose it. Consumers which make decisions based on the stream must use FailOnCorruptEvents.
+func (s *CustomerEventStore) WithCorruptEventPolicy(policy es.CorruptEventPolicy) *CustomerEventStore {
+	store := *s
+	store.eventStore = s.eventStore.WithCorruptEventPolicy(policy)
+
+	return &store
 }
 
 func (s *CustomerEventStore) RetrieveEventStream(id value.CustomerID) (es.EventStream, error) {
 	wrapWithMsg := "customerEventStore.RetrieveEventStream"
 
-	eventStream, err := s.loadEventStream(s.streamID(id), 0, math.MaxUint32)
+	eventStream, err := s.eventStore.LoadEventStream(s.streamID(id), 0, math.MaxUint32)
 	if err != nil {
 		return nil, errors.Wrap(err, wrapWithMsg)
 	}
@@ -60,26 +96,12 @@ func (s *CustomerEventStore) RetrieveEventStream(id value.CustomerID) (es.EventS
 	return eventStream, nil
 }
 
-func (s *CustomerEventStore) StartEventStream(customerRegistered domain.CustomerRegistered) error {
-	var err error
+// StartEventStream appends customerRegistered and the furtherEvents in one transaction.
+func (s *CustomerEventStore) StartEventStream(customerRegistered domain.CustomerRegistered, furtherEvents ...es.DomainEvent) error {
 	wrapWithMsg := "customerEventStore.StartEventStream"
+	events := append([]es.DomainEvent{customerRegistered}, furtherEvents...)
 
-	tx, err := s.db.Begin()
-	if err != nil {
-		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
-	}
-
-	assertionsForUniqueEmailAddresses := s.buildUniqueEmailAddressAssertions(customerRegistered)
-
-	if err = s.assertUniqueEmailAddress(assertionsForUniqueEmailAddresses, tx); err != nil {
-		_ = tx.Rollback()
-
-		return errors.Wrap(err, wrapWithMsg)
-	}
-
-	if err = s.appendEventsToStream(tx, s.streamID(customerRegistered.CustomerID()), customerRegistered); err != nil {
-		_ = tx.Rollback()
-
+	if err := s.eventStore.AppendEventsToStream(s.streamID(customerRegistered.CustomerID()), es.NoStream(), events...); err != nil {
 		if errors.Is(err, shared.ErrConcurrencyConflict) {
 			return shared.MarkAndWrapError(errors.New("found duplicate customer"), shared.ErrDuplicate, wrapWithMsg)
 		}
@@ -87,180 +109,254 @@ func (s *CustomerEventStore) StartEventStream(customerRegistered domain.Customer
 		return errors.Wrap(err, wrapWithMsg)
 	}
 
-	if err = tx.Commit(); err != nil {
-		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
-	}
-
 	return nil
 }
 
 func (s *CustomerEventStore) AppendToEventStream(recordedEvents es.RecordedEvents, id value.CustomerID) error {
-	var err error
 	wrapWithMsg := "customerEventStore.AppendToEventStream"
 
-	tx, err := s.db.Begin()
-	if err != nil {
-		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	if len(recordedEvents) == 0 {
+		return nil
 	}
 
-	assertionsForUniqueEmailAddresses := s.buildUniqueEmailAddressAssertions(recordedEvents...)
-
-	if err = s.assertUniqueEmailAddress(assertionsForUniqueEmailAddresses, tx); err != nil {
-		_ = tx.Rollback()
+	// the events were recorded on the stream which the command handler has loaded
+	expectedVersion := es.ExactVersion(recordedEvents[0].Meta().StreamVersion() - 1)
 
+	if err := s.eventStore.AppendEventsToStream(s.streamID(id), expectedVersion, recordedEvents...); err != nil {
 		return errors.Wrap(err, wrapWithMsg)
 	}
 
-	if err = s.appendEventsToStream(tx, s.streamID(id), recordedEvents...); err != nil {
-		_ = tx.Rollback()
-
-		return errors.Wrap(err, wrapWithMsg)
-	}
+	return nil
+}
 
-	if err = tx.Commit(); err != nil {
-		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+func (s *CustomerEventStore) PurgeEventStream(id value.CustomerID) error {
+	if err := s.eventStore.PurgeEventStream(s.streamID(id)); err != nil {
+		return errors.Wrap(err, "customerEventStore.PurgeEventStream")
 	}
 
 	return nil
 }
 
-func (s *CustomerEventStore) PurgeEventStream(id value.CustomerID) error {
-	var err error
-	wrapWithMsg := "customerEventStore.PurgeEventStream"
+// RetrieveStreamMetadata tells whether a Customer exists and at which version, without loading its events.
+func (s *CustomerEventStore) RetrieveStreamMetadata(id value.CustomerID) (es.StreamMetadata, error) {
+	metadata, err := s.eventStore.RetrieveStreamMetadata(s.streamID(id))
+	if err != nil {
+		return es.StreamMetadata{}, errors.Wrap(err, "customerEventStore.RetrieveStreamMetadata")
+	}
 
-	tx, err := s.db.Begin()
+	return metadata, nil
+}
+
+// ListEventStreams returns the metadata of at most maxStreams Customer streams, including the purged ones,
+// which follow the stream with afterStreamID.
+func (s *CustomerEventStore) ListEventStreams(afterStreamID string, maxStreams uint) ([]es.StreamMetadata, error) {
+	streams, err := s.eventStore.ListStreams(streamPrefix+"-", afterStreamID, maxStreams)
 	if err != nil {
-		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		return nil, errors.Wrap(err, "customerEventStore.ListEventStreams")
 	}
 
-	if err = s.clearUniqueEmailAddress(id, tx); err != nil {
-		_ = tx.Rollback()
+	return streams, nil
+}
 
-		return errors.Wrap(err, wrapWithMsg)
+// QueryEvents returns one page of the events of all Customers, or of one Customer if customerID is not empty.
+func (s *CustomerEventStore) QueryEvents(customerID string, query es.EventQuery) (es.EventPage, error) {
+	query.StreamIDPrefix = streamPrefix + "-"
+
+	if customerID != "" {
+		query.StreamID = s.streamID(value.RebuildCustomerID(customerID)).String()
 	}
 
-	if err = tx.Commit(); err != nil {
-		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	page, err := s.eventStore.QueryEvents(query)
+	if err != nil {
+		return es.EventPage{}, errors.Wrap(err, "customerEventStore.QueryEvents")
 	}
 
-	if err := s.purgeEventStream(s.streamID(id)); err != nil {
-		return errors.Wrap(err, wrapWithMsg)
+	return page, nil
+}
+
+// CountEventsPerDay counts the events of all Customers, or of one Customer if customerID is not empty.
+func (s *CustomerEventStore) CountEventsPerDay(customerID string, filter es.EventFilter) ([]es.EventCount, error) {
+	filter.StreamIDPrefix = streamPrefix + "-"
+
+	if customerID != "" {
+		filter.StreamID = s.streamID(value.RebuildCustomerID(customerID)).String()
 	}
 
-	return nil
+	counts, err := s.eventStore.CountEventsPerDay(filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "customerEventStore.CountEventsPerDay")
+	}
+
+	return counts, nil
 }
 
-func (s *CustomerEventStore) streamID(id value.CustomerID) es.StreamID {
-	return es.NewStreamID(streamPrefix + "-" + id.String())
+func (s *CustomerEventStore) RetrieveCustomerIDByEmailAddress(emailAddress value.EmailAddress) (value.CustomerID, error) {
+	var customerID string
+	wrapWithMsg := "customerEventStore.RetrieveCustomerIDByEmailAddress"
+
+	queryTemplate := `SELECT customer_id FROM %tablename% WHERE email_address = $1`
+	query := strings.Replace(queryTemplate, "%tablename%", s.uniqueEmailAddressesTableName, 1)
+
+	err := s.db.QueryRow(query, emailAddress.String()).Scan(&customerID)
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		err = errors.New("customer not found")
+		return value.CustomerID{}, shared.MarkAndWrapError(err, shared.ErrNotFound, wrapWithMsg)
+	case err != nil:
+		return value.CustomerID{}, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	return value.RebuildCustomerID(customerID), nil
 }
 
-/***** local methods for reading from and writing to the event store *****/
+// ExportEventStreams calls forEachStream with all stored events of one stream at a time, ordered by stream and version.
+// Binary payloads are converted to JSON, so that exports don't depend on the configured payload codec.
+func (s *CustomerEventStore) ExportEventStreams(forEachStream func(records []eventdump.Record) error) error {
+	err := s.eventStore.ExportEventStreams(func(events []es.ExportedEvent) error {
+		records := make([]eventdump.Record, 0, len(events))
+
+		for _, event := range events {
+			records = append(records, eventdump.Record{
+				StreamID:      event.StreamID,
+				StreamVersion: event.StreamVersion,
+				EventName:     event.EventName,
+				OccurredAt:    event.OccurredAt,
+				Payload:       event.Payload,
+			})
+		}
+
+		return forEachStream(records)
+	})
 
-func (s *CustomerEventStore) loadEventStream(
-	streamID es.StreamID,
-	fromVersion uint,
-	maxEvents uint,
-) (es.EventStream, error) {
+	if err != nil {
+		return errors.Wrap(err, "customerEventStore.ExportEventStreams")
+	}
 
+	return nil
+}
+
+// ImportEventStream stores exported events as a new stream, which must not exist yet and must not have gaps.
+// It maintains the unique email addresses and the projections exactly like appending the same events would do.
+func (s *CustomerEventStore) ImportEventStream(records []eventdump.Record) error {
 	var err error
-	wrapWithMsg := "loadEventStream"
+	wrapWithMsg := "customerEventStore.ImportEventStream"
 
-	queryTemplate := `SELECT event_name, payload, stream_version FROM %name% 
-						WHERE stream_id = $1 AND stream_version >= $2
-						ORDER BY stream_version ASC
-						LIMIT $3`
+	if len(records) == 0 {
+		return nil
+	}
 
-	query := strings.Replace(queryTemplate, "%name%", s.eventStoreTableName, 1)
+	if !strings.HasPrefix(records[0].StreamID, streamPrefix+"-") {
+		err = errors.Newf("stream [%s] is not a customer stream", records[0].StreamID)
+		return shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
 
-	eventRows, err := s.db.Query(query, streamID.String(), fromVersion, maxEvents)
-	if err != nil {
-		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	if err = eventdump.ValidateStream(records); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
 	}
 
-	var eventStream es.EventStream
-	var eventName string
-	var payload string
-	var streamVersion uint
-	var domainEvent es.DomainEvent
+	events := make(es.RecordedEvents, 0, len(records))
 
-	for eventRows.Next() {
-		if eventRows.Err() != nil {
-			return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	for _, record := range records {
+		event, err := s.payloadCodecs.UnmarshalJSON(record.EventName, record.Payload, record.StreamVersion)
+		if err != nil {
+			return shared.MarkAndWrapError(err, shared.ErrUnmarshalingFailed, wrapWithMsg)
 		}
 
-		if err = eventRows.Scan(&eventName, &payload, &streamVersion); err != nil {
-			return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
-		}
+		events = append(events, event)
+	}
 
-		if domainEvent, err = s.unmarshalDomainEvent(eventName, []byte(payload), streamVersion); err != nil {
-			return nil, shared.MarkAndWrapError(err, shared.ErrUnmarshalingFailed, wrapWithMsg)
+	if err = s.eventStore.AppendEventsToStream(es.NewStreamID(records[0].StreamID), es.NoStream(), events...); err != nil {
+		if errors.Is(err, shared.ErrConcurrencyConflict) {
+			return shared.MarkAndWrapError(errors.New("found existing stream"), shared.ErrDuplicate, wrapWithMsg)
 		}
 
-		eventStream = append(eventStream, domainEvent)
+		return errors.Wrap(err, wrapWithMsg)
 	}
 
-	return eventStream, nil
+	return nil
 }
 
-func (s *CustomerEventStore) appendEventsToStream(
-	tx *sql.Tx,
-	streamID es.StreamID,
-	events ...es.DomainEvent,
-) error {
+// ConvertEventPayloads rewrites all streams with payloads which are not stored in the WriteFormat of the payload codecs.
+// It returns the number of converted streams.
+func (s *CustomerEventStore) ConvertEventPayloads() (uint, error) {
+	converted, err := s.eventStore.ConvertEventPayloads()
+	if err != nil {
+		return converted, errors.Wrap(err, "customerEventStore.ConvertEventPayloads")
+	}
 
-	var err error
-	wrapWithMsg := "appendEventsToStream"
+	return converted, nil
+}
 
-	queryTemplate := `INSERT INTO %name% (stream_id, stream_version, event_name, occurred_at, payload)
-						VALUES ($1, $2, $3, $4, $5)`
-	query := strings.Replace(queryTemplate, "%name%", s.eventStoreTableName, 1)
+// RechainEventStreams recomputes the hashes of all streams with the chain key and returns the number of rechained streams.
+func (s *CustomerEventStore) RechainEventStreams() (uint, error) {
+	rechained, err := s.eventStore.RechainEventStreams()
+	if err != nil {
+		return rechained, errors.Wrap(err, "customerEventStore.RechainEventStreams")
+	}
 
-	for _, event := range events {
-		var eventJson []byte
+	return rechained, nil
+}
 
-		eventJson, err = s.marshalDomainEvent(event)
-		if err != nil {
-			return shared.MarkAndWrapError(err, shared.ErrMarshalingFailed, wrapWithMsg)
-		}
+// CheckEventStreams loads all streams with the CorruptEventPolicy of the store and returns the number of streams.
+func (s *CustomerEventStore) CheckEventStreams() (uint, error) {
+	streams, err := s.eventStore.CheckEventStreams()
+	if err != nil {
+		return streams, errors.Wrap(err, "customerEventStore.CheckEventStreams")
+	}
 
-		_, err = tx.Exec(
-			query,
-			streamID.String(),
-			event.Meta().StreamVersion(),
-			event.Meta().EventName(),
-			event.Meta().OccurredAt(),
-			eventJson,
-		)
+	return streams, nil
+}
 
-		if err != nil {
-			return errors.Wrap(s.mapEventStorePostgresErrors(err), wrapWithMsg)
-		}
+func (s *CustomerEventStore) IsEmpty() (bool, error) {
+	isEmpty, err := s.eventStore.IsEmpty()
+	if err != nil {
+		return false, errors.Wrap(err, "customerEventStore.IsEmpty")
 	}
 
-	return nil
+	return isEmpty, nil
+}
+
+func (s *CustomerEventStore) streamID(id value.CustomerID) es.StreamID {
+	return es.NewStreamID(streamPrefix + "-" + id.String())
 }
 
-func (s *CustomerEventStore) purgeEventStream(streamID es.StreamID) error {
-	queryTemplate := `DELETE FROM %name% WHERE stream_id = $1`
-	query := strings.Replace(queryTemplate, "%name%", s.eventStoreTableName, 1)
+func (s *CustomerEventStore) customerID(streamID es.StreamID) value.CustomerID {
+	return value.RebuildCustomerID(strings.TrimPrefix(streamID.String(), streamPrefix+"-"))
+}
+
+/***** hooks which run in the transactions of the event store *****/
+
+func (s *CustomerEventStore) beforeAppend(tx *sql.Tx, _ es.StreamID, events []es.DomainEvent) error {
+	return s.assertUniqueEmailAddress(s.buildUniqueEmailAddressAssertions(events...), tx)
+}
 
-	if _, err := s.db.Exec(query, streamID.String()); err != nil {
-		return shared.MarkAndWrapError(err, shared.ErrTechnical, "purgeEventStream")
+func (s *CustomerEventStore) afterAppend(tx *sql.Tx, streamID es.StreamID, events []es.DomainEvent) error {
+	id := s.customerID(streamID)
+
+	if err := s.customerList.project(tx, id, events...); err != nil {
+		return err
 	}
 
-	return nil
+	return s.customerFunnelAnalytics.project(tx, id, events...)
 }
 
-func (s *CustomerEventStore) mapEventStorePostgresErrors(err error) error {
-	switch actualErr := err.(type) {
-	case *pq.Error:
-		switch actualErr.Code {
-		case "23505":
-			return errors.Mark(err, shared.ErrConcurrencyConflict)
-		}
+func (s *CustomerEventStore) beforePurge(tx *sql.Tx, streamID es.StreamID) error {
+	id := s.customerID(streamID)
+
+	if err := s.clearUniqueEmailAddress(id, tx); err != nil {
+		return err
 	}
 
-	return errors.Mark(err, shared.ErrTechnical) // some other DB error (Tx closed, wrong table, ...)
+	if err := s.customerList.remove(tx, id); err != nil {
+		return err
+	}
+
+	if err := s.customerFunnelAnalytics.remove(tx, id); err != nil {
+		return err
+	}
+
+	return s.eventStoreIntegrity.writeTombstone(tx, streamID)
 }
 
 /***** local methods for asserting unique email addresses *****/
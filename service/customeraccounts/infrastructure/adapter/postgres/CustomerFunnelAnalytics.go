@@ -0,0 +1,196 @@
+package postgres
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+)
+
+// CustomerFunnelAnalytics is projected by the CustomerEventStore in the same transaction that appends the events.
+// It only inserts one row per counted event, which RetrievePeriods aggregates, so that concurrent appends
+// don't have to wait for each other to update the same counters.
+type CustomerFunnelAnalytics struct {
+	db                     *sql.DB
+	eventsTableName        string
+	registrationsTableName string
+}
+
+func NewCustomerFunnelAnalytics(
+	db *sql.DB,
+	eventsTableName string,
+	registrationsTableName string,
+) *CustomerFunnelAnalytics {
+
+	return &CustomerFunnelAnalytics{
+		db:                     db,
+		eventsTableName:        eventsTableName,
+		registrationsTableName: registrationsTableName,
+	}
+}
+
+func (analytics *CustomerFunnelAnalytics) RetrievePeriods(criteria customer.FunnelCriteria) ([]customer.FunnelPeriod, error) {
+	wrapWithMsg := "customerFunnelAnalytics.RetrievePeriods"
+
+	// the periods are in UTC, they are converted back to timestamptz so that the index on occurred_at can be used
+	queryTemplate := `SELECT periods.period_start,
+					COUNT(funnel.id) FILTER (WHERE funnel.metric = 'registrations'),
+					COUNT(funnel.id) FILTER (WHERE funnel.metric = 'confirmations'),
+					COUNT(funnel.id) FILTER (WHERE funnel.metric = 'first_confirmations'),
+					COALESCE(SUM(funnel.seconds_to_first_confirmation), 0),
+					COUNT(funnel.id) FILTER (WHERE funnel.metric = 'confirmation_failures'),
+					COUNT(funnel.id) FILTER (WHERE funnel.metric = 'deletions')
+				FROM generate_series(
+					date_trunc($1::text, $2::timestamptz AT TIME ZONE 'UTC'),
+					$3::timestamptz AT TIME ZONE 'UTC',
+					('1 ' || $1::text)::interval
+				) AS periods (period_start)
+				LEFT JOIN %tablename% funnel
+					ON funnel.occurred_at >= periods.period_start AT TIME ZONE 'UTC'
+					AND funnel.occurred_at < (periods.period_start + ('1 ' || $1::text)::interval) AT TIME ZONE 'UTC'
+				GROUP BY periods.period_start
+				ORDER BY periods.period_start`
+
+	query := strings.Replace(queryTemplate, "%tablename%", analytics.eventsTableName, 1)
+
+	rows, err := analytics.db.Query(query, criteria.Interval, criteria.From, criteria.Until)
+	if err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	defer rows.Close()
+
+	periods := []customer.FunnelPeriod{}
+
+	for rows.Next() {
+		var period customer.FunnelPeriod
+		var firstConfirmations uint
+		var secondsToFirstConfirmation float64
+
+		err = rows.Scan(
+			&period.PeriodStart,
+			&period.Registrations,
+			&period.Confirmations,
+			&firstConfirmations,
+			&secondsToFirstConfirmation,
+			&period.ConfirmationFailures,
+			&period.Deletions,
+		)
+
+		if err != nil {
+			return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		if attempts := period.Confirmations + period.ConfirmationFailures; attempts > 0 {
+			period.ConfirmationFailureRate = float64(period.ConfirmationFailures) / float64(attempts)
+		}
+
+		if firstConfirmations > 0 {
+			period.AverageTimeToConfirmation = time.Duration(
+				secondsToFirstConfirmation / float64(firstConfirmations) * float64(time.Second),
+			)
+		}
+
+		periods = append(periods, period)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	return periods, nil
+}
+
+/***** local methods for projecting the aggregates *****/
+
+func (analytics *CustomerFunnelAnalytics) project(tx *sql.Tx, id value.CustomerID, events ...es.DomainEvent) error {
+	var err error
+	wrapWithMsg := "customerFunnelAnalytics.project"
+
+	for _, event := range events {
+		occurredAt := event.Meta().OccurredAt()
+
+		switch event.(type) {
+		case domain.CustomerRegistered:
+			_, err = tx.Exec(
+				`INSERT INTO `+analytics.registrationsTableName+` (customer_id, registered_at) VALUES ($1, $2)`,
+				id.String(),
+				occurredAt,
+			)
+
+			if err == nil {
+				err = analytics.count(tx, id, occurredAt, "registrations", nil)
+			}
+		case domain.CustomerEmailAddressConfirmed:
+			err = analytics.projectConfirmation(tx, id, occurredAt)
+		case domain.CustomerEmailAddressConfirmationFailed:
+			err = analytics.count(tx, id, occurredAt, "confirmation_failures", nil)
+		case domain.CustomerDeleted:
+			err = analytics.count(tx, id, occurredAt, "deletions", nil)
+		}
+
+		if err != nil {
+			return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+	}
+
+	return nil
+}
+
+func (analytics *CustomerFunnelAnalytics) projectConfirmation(tx *sql.Tx, id value.CustomerID, occurredAt string) error {
+	var secondsToFirstConfirmation float64
+
+	if err := analytics.count(tx, id, occurredAt, "confirmations", nil); err != nil {
+		return err
+	}
+
+	query := `UPDATE ` + analytics.registrationsTableName + `
+				SET first_confirmed_at = $2
+				WHERE customer_id = $1 AND first_confirmed_at IS NULL
+				RETURNING EXTRACT(EPOCH FROM first_confirmed_at - registered_at)`
+
+	err := tx.QueryRow(query, id.String(), occurredAt).Scan(&secondsToFirstConfirmation)
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil // the email address was confirmed before
+	case err != nil:
+		return err
+	}
+
+	return analytics.count(tx, id, occurredAt, "first_confirmations", &secondsToFirstConfirmation)
+}
+
+// count inserts a row for the metric, which RetrievePeriods counts for the period the event occurred in.
+func (analytics *CustomerFunnelAnalytics) count(
+	tx *sql.Tx,
+	id value.CustomerID,
+	occurredAt string,
+	metric string,
+	secondsToFirstConfirmation *float64,
+) error {
+
+	query := `INSERT INTO ` + analytics.eventsTableName + ` (customer_id, metric, occurred_at, seconds_to_first_confirmation)
+				VALUES ($1, $2, $3, $4)`
+
+	_, err := tx.Exec(query, id.String(), metric, occurredAt, secondsToFirstConfirmation)
+
+	return err
+}
+
+// remove forgets the Customer's registration and counted events, so that restoring the stream doesn't count them twice.
+func (analytics *CustomerFunnelAnalytics) remove(tx *sql.Tx, id value.CustomerID) error {
+	for _, tableName := range []string{analytics.registrationsTableName, analytics.eventsTableName} {
+		if _, err := tx.Exec(`DELETE FROM `+tableName+` WHERE customer_id = $1`, id.String()); err != nil {
+			return shared.MarkAndWrapError(err, shared.ErrTechnical, "customerFunnelAnalytics.remove")
+		}
+	}
+
+	return nil
+}
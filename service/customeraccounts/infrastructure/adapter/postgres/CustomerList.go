@@ -0,0 +1,358 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// customerListFulltextExpression must match the expression of the customer_list_fulltext_idx index.
+const customerListFulltextExpression = `to_tsvector('simple', given_name || ' ' || family_name || ' ' || split_part(email_address, '@', 1))`
+
+type customerListSortColumn struct {
+	name string
+	cast string
+}
+
+var customerListSortColumns = map[string]customerListSortColumn{
+	customer.ListSortByRegisteredAt: {name: "registered_at", cast: "::timestamptz"},
+	customer.ListSortByEmailAddress: {name: "email_address"},
+	customer.ListSortByFamilyName:   {name: "family_name"},
+}
+
+// customerListCursor points to the last entry of a page, it is only valid for the sorting it was created with.
+type customerListCursor struct {
+	SortBy         string `json:"sortBy"`
+	SortDescending bool   `json:"sortDescending"`
+	SortValue      string `json:"sortValue"`
+	CustomerID     string `json:"customerID"`
+}
+
+// CustomerList is a read model which is projected by the CustomerEventStore in the same transaction
+// that appends the events, so it is always consistent with the event streams.
+type CustomerList struct {
+	db        *sql.DB
+	tableName string
+}
+
+func NewCustomerList(db *sql.DB, tableName string) *CustomerList {
+	return &CustomerList{
+		db:        db,
+		tableName: tableName,
+	}
+}
+
+func (list *CustomerList) RetrievePage(criteria customer.ListCriteria) (customer.ListPage, error) {
+	var err error
+	var args []interface{}
+	var conditions []string
+	wrapWithMsg := "customerList.RetrievePage"
+
+	sortColumn, ok := customerListSortColumns[criteria.SortBy]
+	if !ok {
+		err = errors.Newf("sorting by [%s] is not supported", criteria.SortBy)
+		return customer.ListPage{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	addArg := func(arg interface{}) string {
+		args = append(args, arg)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if criteria.IsEmailAddressConfirmed != nil {
+		conditions = append(conditions, "is_email_address_confirmed = "+addArg(*criteria.IsEmailAddressConfirmed))
+	}
+
+	if criteria.IsDeleted != nil {
+		conditions = append(conditions, "is_deleted = "+addArg(*criteria.IsDeleted))
+	}
+
+	if !criteria.RegisteredFrom.IsZero() {
+		conditions = append(conditions, "registered_at >= "+addArg(criteria.RegisteredFrom))
+	}
+
+	if !criteria.RegisteredUntil.IsZero() {
+		conditions = append(conditions, "registered_at <= "+addArg(criteria.RegisteredUntil))
+	}
+
+	direction, comparison := "ASC", ">"
+	if criteria.SortDescending {
+		direction, comparison = "DESC", "<"
+	}
+
+	if criteria.Cursor != "" {
+		cursor, err := decodeCustomerListCursor(criteria)
+		if err != nil {
+			return customer.ListPage{}, errors.Wrap(err, wrapWithMsg)
+		}
+
+		conditions = append(
+			conditions,
+			fmt.Sprintf(
+				"(%s, customer_id) %s (%s%s, %s)",
+				sortColumn.name,
+				comparison,
+				addArg(cursor.SortValue),
+				sortColumn.cast,
+				addArg(cursor.CustomerID),
+			),
+		)
+	}
+
+	query := `SELECT customer_id, email_address, is_email_address_confirmed, given_name, family_name,
+					is_deleted, registered_at, stream_version
+				FROM ` + list.tableName
+
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	query += fmt.Sprintf(
+		" ORDER BY %s %s, customer_id %s LIMIT %s",
+		sortColumn.name,
+		direction,
+		direction,
+		addArg(criteria.PageSize+1), // one more to find out if there is a next page
+	)
+
+	rows, err := list.db.Query(query, args...)
+	if err != nil {
+		return customer.ListPage{}, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	defer rows.Close()
+
+	listPage := customer.ListPage{}
+
+	if listPage.Entries, err = scanCustomerListEntries(rows); err != nil {
+		return customer.ListPage{}, errors.Wrap(err, wrapWithMsg)
+	}
+
+	if uint(len(listPage.Entries)) > criteria.PageSize {
+		listPage.Entries = listPage.Entries[:criteria.PageSize]
+		listPage.NextCursor = encodeCustomerListCursor(criteria, listPage.Entries[criteria.PageSize-1])
+	}
+
+	return listPage, nil
+}
+
+// Search combines a prefix full-text search with trigram word similarity, so that partial and misspelled
+// names and email local parts are found as well.
+func (list *CustomerList) Search(criteria customer.SearchCriteria) ([]customer.ListEntry, error) {
+	wrapWithMsg := "customerList.Search"
+
+	prefixes := make([]string, 0, len(criteria.Words()))
+	for _, word := range criteria.Words() {
+		prefixes = append(prefixes, word+":*")
+	}
+
+	queryTemplate := `SELECT customer_id, email_address, is_email_address_confirmed, given_name, family_name,
+					is_deleted, registered_at, stream_version
+				FROM (
+					SELECT *,
+						ts_rank(%fulltext%, to_tsquery('simple', $2)) AS text_rank,
+						GREATEST(
+							word_similarity($1, given_name),
+							word_similarity($1, family_name),
+							word_similarity($1, split_part(email_address, '@', 1))
+						) AS similarity_rank
+					FROM %tablename%
+					WHERE NOT is_deleted
+						AND (
+							%fulltext% @@ to_tsquery('simple', $2)
+							OR $1 <% given_name
+							OR $1 <% family_name
+							OR $1 <% split_part(email_address, '@', 1)
+						)
+				) AS matches
+				ORDER BY text_rank + similarity_rank DESC, customer_id
+				LIMIT $3`
+
+	query := strings.NewReplacer(
+		"%tablename%", list.tableName,
+		"%fulltext%", customerListFulltextExpression,
+	).Replace(queryTemplate)
+
+	rows, err := list.db.Query(query, criteria.Term(), strings.Join(prefixes, " & "), criteria.MaxResults())
+	if err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	defer rows.Close()
+
+	entries, err := scanCustomerListEntries(rows)
+	if err != nil {
+		return nil, errors.Wrap(err, wrapWithMsg)
+	}
+
+	return entries, nil
+}
+
+/***** local methods for projecting the read model *****/
+
+func (list *CustomerList) project(tx *sql.Tx, id value.CustomerID, events ...es.DomainEvent) error {
+	var err error
+	wrapWithMsg := "customerList.project"
+
+	for _, event := range events {
+		streamVersion := event.Meta().StreamVersion()
+
+		switch actualEvent := event.(type) {
+		case domain.CustomerRegistered:
+			_, err = tx.Exec(
+				`INSERT INTO `+list.tableName+`
+					(customer_id, email_address, given_name, family_name, registered_at, stream_version)
+					VALUES ($1, $2, $3, $4, $5, $6)`,
+				id.String(),
+				actualEvent.EmailAddress().String(),
+				actualEvent.PersonName().GivenName(),
+				actualEvent.PersonName().FamilyName(),
+				actualEvent.Meta().OccurredAt(),
+				streamVersion,
+			)
+		case domain.CustomerEmailAddressConfirmed:
+			err = list.update(tx, id, streamVersion, "is_email_address_confirmed = true")
+		case domain.CustomerEmailAddressChanged:
+			err = list.update(
+				tx,
+				id,
+				streamVersion,
+				"email_address = $3, is_email_address_confirmed = false",
+				actualEvent.EmailAddress().String(),
+			)
+		case domain.CustomerNameChanged:
+			err = list.update(
+				tx,
+				id,
+				streamVersion,
+				"given_name = $3, family_name = $4",
+				actualEvent.PersonName().GivenName(),
+				actualEvent.PersonName().FamilyName(),
+			)
+		case domain.CustomerDeleted:
+			err = list.update(tx, id, streamVersion, "is_deleted = true")
+		default:
+			err = list.update(tx, id, streamVersion, "")
+		}
+
+		if err != nil {
+			return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+	}
+
+	return nil
+}
+
+func (list *CustomerList) update(
+	tx *sql.Tx,
+	id value.CustomerID,
+	streamVersion uint,
+	assignments string,
+	args ...interface{},
+) error {
+
+	if assignments != "" {
+		assignments += ", "
+	}
+
+	query := `UPDATE ` + list.tableName + ` SET ` + assignments + `stream_version = $2 WHERE customer_id = $1`
+
+	_, err := tx.Exec(query, append([]interface{}{id.String(), streamVersion}, args...)...)
+
+	return err
+}
+
+func (list *CustomerList) remove(tx *sql.Tx, id value.CustomerID) error {
+	query := `DELETE FROM ` + list.tableName + ` WHERE customer_id = $1`
+
+	if _, err := tx.Exec(query, id.String()); err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, "customerList.remove")
+	}
+
+	return nil
+}
+
+func scanCustomerListEntries(rows *sql.Rows) ([]customer.ListEntry, error) {
+	entries := []customer.ListEntry{}
+
+	for rows.Next() {
+		entry := customer.ListEntry{}
+
+		err := rows.Scan(
+			&entry.ID,
+			&entry.EmailAddress,
+			&entry.IsEmailAddressConfirmed,
+			&entry.GivenName,
+			&entry.FamilyName,
+			&entry.IsDeleted,
+			&entry.RegisteredAt,
+			&entry.Version,
+		)
+
+		if err != nil {
+			return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, "scanCustomerListEntries")
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, "scanCustomerListEntries")
+	}
+
+	return entries, nil
+}
+
+/***** local functions for the cursors *****/
+
+func encodeCustomerListCursor(criteria customer.ListCriteria, lastEntry customer.ListEntry) string {
+	cursor := customerListCursor{
+		SortBy:         criteria.SortBy,
+		SortDescending: criteria.SortDescending,
+		CustomerID:     lastEntry.ID,
+	}
+
+	switch criteria.SortBy {
+	case customer.ListSortByEmailAddress:
+		cursor.SortValue = lastEntry.EmailAddress
+	case customer.ListSortByFamilyName:
+		cursor.SortValue = lastEntry.FamilyName
+	default:
+		cursor.SortValue = lastEntry.RegisteredAt.Format(time.RFC3339Nano)
+	}
+
+	json, _ := jsoniter.ConfigFastest.Marshal(cursor) // err intentionally ignored - it can't happen with strings
+
+	return base64.RawURLEncoding.EncodeToString(json)
+}
+
+func decodeCustomerListCursor(criteria customer.ListCriteria) (customerListCursor, error) {
+	var cursor customerListCursor
+	wrapWithMsg := "decodeCustomerListCursor"
+
+	json, err := base64.RawURLEncoding.DecodeString(criteria.Cursor)
+	if err == nil {
+		err = jsoniter.ConfigFastest.Unmarshal(json, &cursor)
+	}
+
+	if err != nil {
+		return customerListCursor{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	if cursor.SortBy != criteria.SortBy || cursor.SortDescending != criteria.SortDescending {
+		err = errors.New("cursor was created with a different sorting")
+		return customerListCursor{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	return cursor, nil
+}
@@ -0,0 +1,227 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+// similarNameThreshold is the minimum trigram similarity of the full names of two Customers to flag them.
+const similarNameThreshold = "0.6"
+
+// detectionLockID identifies the advisory lock which makes sure that only one service instance detects at a time.
+const detectionLockID = int64(4711003)
+
+// DuplicateCustomerCandidates detects likely duplicate Customers from the customer_list read model
+// and keeps them in a review table.
+type DuplicateCustomerCandidates struct {
+	db                    *sql.DB
+	tableName             string
+	customerListTableName string
+}
+
+func NewDuplicateCustomerCandidates(
+	db *sql.DB,
+	tableName string,
+	customerListTableName string,
+) *DuplicateCustomerCandidates {
+
+	return &DuplicateCustomerCandidates{
+		db:                    db,
+		tableName:             tableName,
+		customerListTableName: customerListTableName,
+	}
+}
+
+// Detect inserts new candidate pairs and keeps the existing ones, so that reviews are not lost by running it again.
+// If another service instance is detecting at the same time, it does nothing and reports no new candidates.
+func (candidates *DuplicateCustomerCandidates) Detect() (uint, error) {
+	wrapWithMsg := "duplicateCustomerCandidates.Detect"
+
+	emailPlusTagTemplate := `INSERT INTO %tablename% (customer_id, other_customer_id, reason, similarity)
+				SELECT a.customer_id, b.customer_id, $1, 1
+				FROM %customerlist% a
+				JOIN %customerlist% b
+					ON a.customer_id < b.customer_id
+					AND ` + normalizedEmailAddressOf("a") + ` = ` + normalizedEmailAddressOf("b") + `
+				WHERE NOT a.is_deleted AND NOT b.is_deleted
+				ON CONFLICT DO NOTHING`
+
+	// the % operator uses pg_trgm.similarity_threshold and the trigram index on the full name
+	similarNameTemplate := `INSERT INTO %tablename% (customer_id, other_customer_id, reason, similarity)
+				SELECT a.customer_id, b.customer_id, $1,
+					similarity(a.given_name || ' ' || a.family_name, b.given_name || ' ' || b.family_name)
+				FROM %customerlist% a
+				JOIN %customerlist% b
+					ON (b.given_name || ' ' || b.family_name) % (a.given_name || ' ' || a.family_name)
+					AND a.customer_id < b.customer_id
+				WHERE NOT a.is_deleted AND NOT b.is_deleted
+				ON CONFLICT DO NOTHING`
+
+	replacer := strings.NewReplacer("%tablename%", candidates.tableName, "%customerlist%", candidates.customerListTableName)
+
+	tx, err := candidates.db.Begin()
+	if err != nil {
+		return 0, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	var isLocked bool
+
+	if err = tx.QueryRow(`SELECT pg_try_advisory_xact_lock($1)`, detectionLockID).Scan(&isLocked); err != nil {
+		_ = tx.Rollback()
+		return 0, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	if !isLocked {
+		_ = tx.Rollback()
+		return 0, nil
+	}
+
+	if _, err = tx.Exec(`SELECT set_config('pg_trgm.similarity_threshold', $1, true)`, similarNameThreshold); err != nil {
+		_ = tx.Rollback()
+		return 0, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	var numberOfNewCandidates int64
+
+	for _, detection := range []struct {
+		query string
+		args  []interface{}
+	}{
+		{query: replacer.Replace(emailPlusTagTemplate), args: []interface{}{customer.DuplicateReasonEmailPlusTag}},
+		{query: replacer.Replace(similarNameTemplate), args: []interface{}{customer.DuplicateReasonSimilarName}},
+	} {
+		result, err := tx.Exec(detection.query, detection.args...)
+		if err != nil {
+			_ = tx.Rollback()
+			return 0, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			_ = tx.Rollback()
+			return 0, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		numberOfNewCandidates += rowsAffected
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	return uint(numberOfNewCandidates), nil
+}
+
+// RetrieveCandidates only returns candidates where neither Customer is deleted meanwhile.
+func (candidates *DuplicateCustomerCandidates) RetrieveCandidates(includeReviewed bool) ([]customer.DuplicateCandidate, error) {
+	wrapWithMsg := "duplicateCustomerCandidates.RetrieveCandidates"
+
+	queryTemplate := `SELECT c.reason, c.similarity, c.is_not_duplicate, c.detected_at,
+					a.customer_id, a.email_address, a.is_email_address_confirmed, a.given_name, a.family_name,
+					a.is_deleted, a.registered_at, a.stream_version,
+					b.customer_id, b.email_address, b.is_email_address_confirmed, b.given_name, b.family_name,
+					b.is_deleted, b.registered_at, b.stream_version
+				FROM %tablename% c
+				JOIN %customerlist% a ON a.customer_id = c.customer_id
+				JOIN %customerlist% b ON b.customer_id = c.other_customer_id
+				WHERE NOT a.is_deleted AND NOT b.is_deleted AND ($1 OR NOT c.is_not_duplicate)
+				ORDER BY c.detected_at, c.customer_id, c.other_customer_id, c.reason`
+
+	query := strings.NewReplacer(
+		"%tablename%", candidates.tableName,
+		"%customerlist%", candidates.customerListTableName,
+	).Replace(queryTemplate)
+
+	rows, err := candidates.db.Query(query, includeReviewed)
+	if err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	defer rows.Close()
+
+	duplicateCandidates := []customer.DuplicateCandidate{}
+
+	for rows.Next() {
+		candidate := customer.DuplicateCandidate{}
+
+		err = rows.Scan(
+			&candidate.Reason,
+			&candidate.Similarity,
+			&candidate.IsNotDuplicate,
+			&candidate.DetectedAt,
+			&candidate.Customer.ID,
+			&candidate.Customer.EmailAddress,
+			&candidate.Customer.IsEmailAddressConfirmed,
+			&candidate.Customer.GivenName,
+			&candidate.Customer.FamilyName,
+			&candidate.Customer.IsDeleted,
+			&candidate.Customer.RegisteredAt,
+			&candidate.Customer.Version,
+			&candidate.OtherCustomer.ID,
+			&candidate.OtherCustomer.EmailAddress,
+			&candidate.OtherCustomer.IsEmailAddressConfirmed,
+			&candidate.OtherCustomer.GivenName,
+			&candidate.OtherCustomer.FamilyName,
+			&candidate.OtherCustomer.IsDeleted,
+			&candidate.OtherCustomer.RegisteredAt,
+			&candidate.OtherCustomer.Version,
+		)
+
+		if err != nil {
+			return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		duplicateCandidates = append(duplicateCandidates, candidate)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	return duplicateCandidates, nil
+}
+
+// MarkAsNotDuplicate marks the pair for all reasons it was detected for.
+func (candidates *DuplicateCustomerCandidates) MarkAsNotDuplicate(customerID, otherCustomerID value.CustomerID) error {
+	wrapWithMsg := "duplicateCustomerCandidates.MarkAsNotDuplicate"
+
+	first, second := customerID.String(), otherCustomerID.String()
+	if second < first {
+		first, second = second, first
+	}
+
+	query := `UPDATE ` + candidates.tableName + `
+				SET is_not_duplicate = true, reviewed_at = now()
+				WHERE customer_id = $1 AND other_customer_id = $2`
+
+	result, err := candidates.db.Exec(query, first, second)
+	if err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	if rowsAffected == 0 {
+		err = errors.New("no duplicate candidate found for these customers")
+		return shared.MarkAndWrapError(err, shared.ErrNotFound, wrapWithMsg)
+	}
+
+	return nil
+}
+
+// normalizedEmailAddressOf strips a plus-tag from the local part, e.g. john+shop@doe.com -> john@doe.com
+func normalizedEmailAddressOf(tableAlias string) string {
+	return fmt.Sprintf(
+		`lower(regexp_replace(split_part(%[1]s.email_address, '@', 1), '\+.*$', '') || '@' || split_part(%[1]s.email_address, '@', 2))`,
+		tableAlias,
+	)
+}
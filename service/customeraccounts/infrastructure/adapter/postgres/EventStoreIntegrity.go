@@ -0,0 +1,306 @@
+package postgres
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+)
+
+// IntegrityBreak is a place where the stored events don't match their hash chain or the streams table,
+// or where a tombstone was forged.
+type IntegrityBreak struct {
+	StreamID      string
+	StreamVersion uint
+	Problem       string
+}
+
+// EventStoreIntegrity verifies the per-stream hash chain, which es.PostgresEventStore computes when events are appended.
+// The payload hash is computed from the normalized jsonb text, because that's what can be read back, or from the binary payload.
+// The chain hash is an HMAC with the chainKey (see es.ChainHash) and purged streams leave a tombstone with their last chain
+// hash, signed with the tombstoneSigningKey. The database holds neither key, so it can't recompute the chain or forge tombstones.
+type EventStoreIntegrity struct {
+	db                  *sql.DB
+	eventStoreTableName string
+	streamsTableName    string
+	tombstonesTableName string
+	chainKey            []byte
+	tombstoneSigningKey []byte
+}
+
+func NewEventStoreIntegrity(
+	db *sql.DB,
+	eventStoreTableName string,
+	streamsTableName string,
+	tombstonesTableName string,
+	chainKey []byte,
+	tombstoneSigningKey []byte,
+) *EventStoreIntegrity {
+
+	return &EventStoreIntegrity{
+		db:                  db,
+		eventStoreTableName: eventStoreTableName,
+		streamsTableName:    streamsTableName,
+		tombstonesTableName: tombstonesTableName,
+		chainKey:            chainKey,
+		tombstoneSigningKey: tombstoneSigningKey,
+	}
+}
+
+// Verify walks all streams and tombstones and returns every break it finds, together with the number of verified streams.
+// Truncating the newest events of a stream can't be detected by a hash chain, but by the version in the streams table.
+func (integrity *EventStoreIntegrity) Verify() ([]IntegrityBreak, uint, error) {
+	var err error
+	var breaks []IntegrityBreak
+	var streams uint
+	wrapWithMsg := "eventStoreIntegrity.Verify"
+
+	queryTemplate := `SELECT stream_id, stream_version, event_name, occurred_at, payload::text, payload_bytes, 
+       					COALESCE(payload_hash, ''), COALESCE(chain_hash, '') 
+						FROM %name% 
+						ORDER BY stream_id ASC, stream_version ASC`
+	query := strings.Replace(queryTemplate, "%name%", integrity.eventStoreTableName, 1)
+
+	eventRows, err := integrity.db.Query(query)
+	if err != nil {
+		return nil, 0, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	defer eventRows.Close()
+
+	var previousStreamID, previousChainHash string
+	var previousStreamVersion uint
+
+	for eventRows.Next() {
+		var streamID, eventName, payloadHash, storedChainHash string
+		var jsonPayload, binaryPayload []byte
+		var streamVersion uint
+		var occurredAt time.Time
+
+		if err = eventRows.Scan(&streamID, &streamVersion, &eventName, &occurredAt, &jsonPayload, &binaryPayload, &payloadHash, &storedChainHash); err != nil {
+			return nil, 0, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		if streamID != previousStreamID {
+			previousStreamID, previousStreamVersion, previousChainHash = streamID, 0, ""
+			streams++
+		}
+
+		reportBreak := func(problem string) {
+			breaks = append(breaks, IntegrityBreak{StreamID: streamID, StreamVersion: streamVersion, Problem: problem})
+		}
+
+		if streamVersion != previousStreamVersion+1 {
+			reportBreak(fmt.Sprintf("version %d follows version %d", streamVersion, previousStreamVersion))
+		}
+
+		expectedPayloadHash := sha256Hex(string(jsonPayload))
+		if binaryPayload != nil {
+			expectedPayloadHash = sha256Hex(string(binaryPayload))
+		}
+
+		switch {
+		case storedChainHash == "":
+			reportBreak("the event has no chain hash")
+		case payloadHash != expectedPayloadHash:
+			reportBreak("the payload was modified")
+		case !hmac.Equal([]byte(storedChainHash), []byte(es.ChainHash(integrity.chainKey, previousChainHash, payloadHash, streamID, streamVersion, eventName, occurredAt))):
+			reportBreak("the chain hash does not match the previous event")
+		}
+
+		// continue the chain with the stored hash, so that one modified event is reported only once
+		previousStreamVersion, previousChainHash = streamVersion, storedChainHash
+	}
+
+	if err = eventRows.Err(); err != nil {
+		return nil, 0, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	streamBreaks, err := integrity.verifyStreams()
+	if err != nil {
+		return nil, 0, errors.Wrap(err, wrapWithMsg)
+	}
+
+	tombstoneBreaks, err := integrity.verifyTombstones()
+	if err != nil {
+		return nil, 0, errors.Wrap(err, wrapWithMsg)
+	}
+
+	return append(append(breaks, streamBreaks...), tombstoneBreaks...), streams, nil
+}
+
+// verifyStreams cross-checks the streams table with the events and tombstones, so that streams whose newest events
+// were deleted, or which were deleted without a tombstone, are found.
+func (integrity *EventStoreIntegrity) verifyStreams() ([]IntegrityBreak, error) {
+	var err error
+	var breaks []IntegrityBreak
+	wrapWithMsg := "verifyStreams"
+
+	queryTemplate := `SELECT COALESCE(streams.stream_id, events.stream_id),
+							streams.stream_id IS NOT NULL, COALESCE(streams.current_version, 0), COALESCE(streams.deleted, FALSE),
+							COALESCE(events.last_version, 0),
+							EXISTS (SELECT 1 FROM %tombstones% AS tombstones WHERE tombstones.stream_id = COALESCE(streams.stream_id, events.stream_id))
+						FROM %streams% AS streams
+						FULL OUTER JOIN (SELECT stream_id, MAX(stream_version) AS last_version FROM %events% GROUP BY stream_id) AS events
+							ON events.stream_id = streams.stream_id
+						WHERE streams.stream_id IS NULL OR events.stream_id IS NULL OR streams.deleted
+							OR streams.current_version <> events.last_version
+						ORDER BY 1 ASC`
+	query := strings.NewReplacer(
+		"%tombstones%", integrity.tombstonesTableName,
+		"%streams%", integrity.streamsTableName,
+		"%events%", integrity.eventStoreTableName,
+	).Replace(queryTemplate)
+
+	streamRows, err := integrity.db.Query(query)
+	if err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	defer streamRows.Close()
+
+	for streamRows.Next() {
+		var streamID string
+		var isKnown, isDeleted, hasTombstone bool
+		var currentVersion, lastEventVersion uint
+
+		if err = streamRows.Scan(&streamID, &isKnown, &currentVersion, &isDeleted, &lastEventVersion, &hasTombstone); err != nil {
+			return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		var problem string
+
+		switch {
+		case !isKnown:
+			problem = "the stream is missing in the streams table"
+		case isDeleted && lastEventVersion > 0:
+			problem = "the stream is purged, but still has events"
+		case isDeleted && !hasTombstone:
+			problem = "the stream is purged without a tombstone"
+		case isDeleted:
+			continue // purged properly
+		case lastEventVersion == 0:
+			problem = "the events of the stream were deleted without purging it"
+		default:
+			problem = fmt.Sprintf("the last event has version %d, but the stream has version %d", lastEventVersion, currentVersion)
+		}
+
+		breaks = append(breaks, IntegrityBreak{StreamID: streamID, StreamVersion: currentVersion, Problem: problem})
+	}
+
+	if err = streamRows.Err(); err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	return breaks, nil
+}
+
+func (integrity *EventStoreIntegrity) verifyTombstones() ([]IntegrityBreak, error) {
+	var err error
+	var breaks []IntegrityBreak
+	wrapWithMsg := "verifyTombstones"
+
+	queryTemplate := `SELECT stream_id, last_stream_version, last_chain_hash, purged_at, signature FROM %name% ORDER BY id ASC`
+	query := strings.Replace(queryTemplate, "%name%", integrity.tombstonesTableName, 1)
+
+	tombstoneRows, err := integrity.db.Query(query)
+	if err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	defer tombstoneRows.Close()
+
+	for tombstoneRows.Next() {
+		var streamID, lastChainHash, signature string
+		var lastStreamVersion uint
+		var purgedAt time.Time
+
+		if err = tombstoneRows.Scan(&streamID, &lastStreamVersion, &lastChainHash, &purgedAt, &signature); err != nil {
+			return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		expectedSignature := integrity.signTombstone(streamID, lastStreamVersion, lastChainHash, purgedAt)
+
+		if !hmac.Equal([]byte(signature), []byte(expectedSignature)) {
+			breaks = append(
+				breaks,
+				IntegrityBreak{StreamID: streamID, StreamVersion: lastStreamVersion, Problem: "the tombstone signature is invalid"},
+			)
+		}
+	}
+
+	if err = tombstoneRows.Err(); err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	return breaks, nil
+}
+
+// writeTombstone must be called in the same transaction which purges the stream, before the events are deleted.
+func (integrity *EventStoreIntegrity) writeTombstone(tx *sql.Tx, streamID es.StreamID) error {
+	var lastStreamVersion uint
+	var lastChainHash string
+	wrapWithMsg := "writeTombstone"
+
+	queryTemplate := `SELECT stream_version, COALESCE(chain_hash, '') FROM %name% 
+						WHERE stream_id = $1 
+						ORDER BY stream_version DESC 
+						LIMIT 1`
+	query := strings.Replace(queryTemplate, "%name%", integrity.eventStoreTableName, 1)
+
+	err := tx.QueryRow(query, streamID.String()).Scan(&lastStreamVersion, &lastChainHash)
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil // nothing to purge, so nothing to prove
+	case err != nil:
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	purgedAt := time.Now().UTC().Truncate(time.Microsecond) // the precision of Postgres timestamps
+
+	queryTemplate = `INSERT INTO %name% (stream_id, last_stream_version, last_chain_hash, purged_at, signature) 
+						VALUES ($1, $2, $3, $4, $5)`
+	query = strings.Replace(queryTemplate, "%name%", integrity.tombstonesTableName, 1)
+
+	_, err = tx.Exec(
+		query,
+		streamID.String(),
+		lastStreamVersion,
+		lastChainHash,
+		purgedAt,
+		integrity.signTombstone(streamID.String(), lastStreamVersion, lastChainHash, purgedAt),
+	)
+
+	if err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	return nil
+}
+
+func (integrity *EventStoreIntegrity) signTombstone(
+	streamID string,
+	lastStreamVersion uint,
+	lastChainHash string,
+	purgedAt time.Time,
+) string {
+
+	mac := hmac.New(sha256.New, integrity.tombstoneSigningKey)
+	_, _ = fmt.Fprintf(mac, "%s|%d|%s|%s", streamID, lastStreamVersion, lastChainHash, purgedAt.UTC().Format(time.RFC3339Nano))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func sha256Hex(input string) string {
+	sum := sha256.Sum256([]byte(input))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+// expiredWindowsPrunedPerAttempt bounds the work of pruning, while still removing more windows than each attempt can add.
+const expiredWindowsPrunedPerAttempt = 10
+
+// RateLimiter counts the attempts per key in fixed windows, it keeps them in Postgres so that the limits
+// survive restarts and are shared by all service instances.
+type RateLimiter struct {
+	db          *sql.DB
+	tableName   string
+	maxAttempts uint
+	window      time.Duration
+}
+
+func NewRateLimiter(db *sql.DB, tableName string, maxAttempts uint, window time.Duration) *RateLimiter {
+	return &RateLimiter{
+		db:          db,
+		tableName:   tableName,
+		maxAttempts: maxAttempts,
+		window:      window,
+	}
+}
+
+// Allow counts the attempt atomically, so concurrent attempts for the same key can't exceed the limit.
+func (limiter *RateLimiter) Allow(key string) error {
+	var attempts uint
+	wrapWithMsg := "rateLimiter.Allow"
+
+	queryTemplate := `INSERT INTO %tablename% AS windows (limit_key, attempts, expires_at)
+				VALUES ($1, 1, now() + $2 * interval '1 millisecond')
+				ON CONFLICT (limit_key) DO UPDATE SET
+					attempts = CASE WHEN windows.expires_at <= now() THEN 1 ELSE windows.attempts + 1 END,
+					expires_at = CASE WHEN windows.expires_at <= now() THEN EXCLUDED.expires_at ELSE windows.expires_at END
+				RETURNING attempts`
+
+	query := strings.Replace(queryTemplate, "%tablename%", limiter.tableName, 1)
+
+	if err := limiter.db.QueryRow(query, key, limiter.window.Milliseconds()).Scan(&attempts); err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	if err := limiter.pruneExpiredWindows(); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	if attempts > limiter.maxAttempts {
+		err := errors.New("too many attempts, try again later")
+		return shared.MarkAndWrapError(err, shared.ErrRateLimitExceeded, wrapWithMsg)
+	}
+
+	return nil
+}
+
+// pruneExpiredWindows skips windows which other instances are pruning or counting at the same time.
+func (limiter *RateLimiter) pruneExpiredWindows() error {
+	queryTemplate := `DELETE FROM %tablename%
+				WHERE limit_key IN (
+					SELECT limit_key FROM %tablename%
+					WHERE expires_at <= now()
+					LIMIT $1
+					FOR UPDATE SKIP LOCKED
+				)`
+
+	query := strings.Replace(queryTemplate, "%tablename%", limiter.tableName, -1)
+
+	if _, err := limiter.db.Exec(query, expiredWindowsPrunedPerAttempt); err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, "rateLimiter.pruneExpiredWindows")
+	}
+
+	return nil
+}
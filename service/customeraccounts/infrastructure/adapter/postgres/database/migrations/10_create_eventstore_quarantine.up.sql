@@ -0,0 +1,19 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS eventstore_quarantine
+(
+    event_id INTEGER
+        CONSTRAINT eventstore_quarantine_pk
+            PRIMARY KEY,
+    stream_id VARCHAR(255) NOT NULL,
+    stream_version INTEGER NOT NULL,
+    event_name VARCHAR(255) NOT NULL,
+    reason TEXT NOT NULL,
+    first_seen_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_seen_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS eventstore_quarantine_stream_id_idx
+    ON eventstore_quarantine (stream_id);
+
+COMMIT;
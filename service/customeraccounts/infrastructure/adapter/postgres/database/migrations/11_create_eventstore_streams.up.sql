@@ -0,0 +1,29 @@
+BEGIN;
+
+/* one row per stream with its current version, purged streams are kept as deleted */
+
+CREATE TABLE IF NOT EXISTS eventstore_streams
+(
+    stream_id VARCHAR(255)
+        CONSTRAINT eventstore_streams_pk
+            PRIMARY KEY,
+    current_version INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    deleted BOOLEAN DEFAULT FALSE NOT NULL
+);
+
+/* backfill from the existing streams and tombstones, the creation time of purged streams is unknown */
+
+INSERT INTO eventstore_streams (stream_id, current_version, created_at, updated_at)
+SELECT stream_id, MAX(stream_version), MIN(occurred_at), MAX(occurred_at)
+FROM eventstore
+GROUP BY stream_id;
+
+INSERT INTO eventstore_streams (stream_id, current_version, created_at, updated_at, deleted)
+SELECT DISTINCT ON (stream_id) stream_id, last_stream_version, purged_at, purged_at, TRUE
+FROM eventstore_tombstones
+ORDER BY stream_id, purged_at DESC
+ON CONFLICT (stream_id) DO NOTHING;
+
+COMMIT;
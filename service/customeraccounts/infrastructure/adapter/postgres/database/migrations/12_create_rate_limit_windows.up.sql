@@ -0,0 +1,17 @@
+BEGIN;
+
+/* one row per rate limited key (e.g. an email address or a client address) and its current fixed window */
+
+CREATE TABLE IF NOT EXISTS rate_limit_windows
+(
+    limit_key VARCHAR(512)
+        CONSTRAINT rate_limit_windows_pk
+            PRIMARY KEY,
+    attempts INTEGER NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS rate_limit_windows_expires_at_idx
+    ON rate_limit_windows (expires_at);
+
+COMMIT;
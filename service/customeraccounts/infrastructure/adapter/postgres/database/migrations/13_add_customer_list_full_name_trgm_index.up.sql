@@ -0,0 +1,8 @@
+BEGIN;
+
+/* lets the duplicate detection find similar full names with the % operator instead of comparing all pairs */
+
+CREATE INDEX IF NOT EXISTS customer_list_full_name_trgm_idx
+    on customer_list USING GIN ((given_name || ' ' || family_name) gin_trgm_ops);
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS custom_attribute_definitions
+(
+    name VARCHAR(63)
+        CONSTRAINT custom_attribute_definitions_pk
+            PRIMARY KEY,
+    attribute_type VARCHAR(31) NOT NULL,
+    validation_pattern TEXT DEFAULT '' NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL
+);
+
+COMMIT;
@@ -0,0 +1,71 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS customer_list
+(
+    customer_id VARCHAR(255)
+        CONSTRAINT customer_list_pk
+            PRIMARY KEY,
+    email_address VARCHAR(255) NOT NULL,
+    given_name VARCHAR(255) NOT NULL,
+    family_name VARCHAR(255) NOT NULL,
+    is_email_address_confirmed BOOLEAN DEFAULT false NOT NULL,
+    is_deleted BOOLEAN DEFAULT false NOT NULL,
+    registered_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    stream_version INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS customer_list_registered_at_idx
+    on customer_list (registered_at, customer_id);
+
+CREATE INDEX IF NOT EXISTS customer_list_email_address_idx
+    on customer_list (email_address, customer_id);
+
+CREATE INDEX IF NOT EXISTS customer_list_family_name_idx
+    on customer_list (family_name, customer_id);
+
+/* backfill the read model from already existing event streams */
+
+INSERT INTO customer_list
+    (customer_id, email_address, given_name, family_name, registered_at, stream_version)
+SELECT payload ->> 'customerID',
+       payload ->> 'emailAddress',
+       payload ->> 'personGivenName',
+       payload ->> 'personFamilyName',
+       occurred_at,
+       stream_version
+FROM eventstore
+WHERE event_name = 'CustomerRegistered'
+ON CONFLICT DO NOTHING;
+
+UPDATE customer_list
+SET given_name  = latest.payload ->> 'givenName',
+    family_name = latest.payload ->> 'familyName'
+FROM (SELECT DISTINCT ON (stream_id) payload
+      FROM eventstore
+      WHERE event_name = 'CustomerNameChanged'
+      ORDER BY stream_id, stream_version DESC) AS latest
+WHERE customer_list.customer_id = latest.payload ->> 'customerID';
+
+UPDATE customer_list
+SET email_address              = latest.payload ->> 'emailAddress',
+    is_email_address_confirmed = (latest.event_name = 'CustomerEmailAddressConfirmed')
+FROM (SELECT DISTINCT ON (stream_id) event_name, payload
+      FROM eventstore
+      WHERE event_name IN ('CustomerEmailAddressConfirmed', 'CustomerEmailAddressChanged')
+      ORDER BY stream_id, stream_version DESC) AS latest
+WHERE customer_list.customer_id = latest.payload ->> 'customerID';
+
+UPDATE customer_list
+SET is_deleted = true
+FROM eventstore
+WHERE eventstore.event_name = 'CustomerDeleted'
+  AND customer_list.customer_id = eventstore.payload ->> 'customerID';
+
+UPDATE customer_list
+SET stream_version = latest.stream_version
+FROM (SELECT stream_id, MAX(stream_version) AS stream_version
+      FROM eventstore
+      GROUP BY stream_id) AS latest
+WHERE latest.stream_id = 'customer-' || customer_list.customer_id;
+
+COMMIT;
@@ -0,0 +1,20 @@
+BEGIN;
+
+/* needs superuser privileges if the extension does not exist yet - see database/setup/init-db.sh */
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS customer_list_fulltext_idx
+    on customer_list USING GIN (
+        to_tsvector('simple', given_name || ' ' || family_name || ' ' || split_part(email_address, '@', 1))
+    );
+
+CREATE INDEX IF NOT EXISTS customer_list_given_name_trgm_idx
+    on customer_list USING GIN (given_name gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS customer_list_family_name_trgm_idx
+    on customer_list USING GIN (family_name gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS customer_list_email_local_part_trgm_idx
+    on customer_list USING GIN (split_part(email_address, '@', 1) gin_trgm_ops);
+
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS duplicate_customer_candidates
+(
+    customer_id VARCHAR(255) NOT NULL,
+    other_customer_id VARCHAR(255) NOT NULL,
+    reason VARCHAR(31) NOT NULL,
+    similarity REAL NOT NULL,
+    is_not_duplicate BOOLEAN DEFAULT false NOT NULL,
+    detected_at TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL,
+    reviewed_at TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    CONSTRAINT duplicate_customer_candidates_pk
+        PRIMARY KEY (customer_id, other_customer_id, reason),
+    CONSTRAINT duplicate_customer_candidates_ordered_pair
+        CHECK (customer_id < other_customer_id)
+);
+
+CREATE INDEX IF NOT EXISTS duplicate_customer_candidates_other_customer_id_idx
+    on duplicate_customer_candidates (other_customer_id);
+
+COMMIT;
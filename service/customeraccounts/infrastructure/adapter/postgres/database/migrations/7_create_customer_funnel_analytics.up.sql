@@ -0,0 +1,66 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS customer_funnel_registrations
+(
+    customer_id VARCHAR(255)
+        CONSTRAINT customer_funnel_registrations_pk
+            PRIMARY KEY,
+    registered_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    first_confirmed_at TIMESTAMP WITH TIME ZONE DEFAULT NULL
+);
+
+/* one row per counted event instead of one counter row per day, so that concurrent appends don't wait for each other */
+
+CREATE TABLE IF NOT EXISTS customer_funnel_events
+(
+    id BIGSERIAL
+        CONSTRAINT customer_funnel_events_pk
+            PRIMARY KEY,
+    customer_id VARCHAR(255) NOT NULL,
+    metric VARCHAR(31) NOT NULL,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    seconds_to_first_confirmation DOUBLE PRECISION DEFAULT NULL
+);
+
+CREATE INDEX IF NOT EXISTS customer_funnel_events_occurred_at_idx
+    ON customer_funnel_events (occurred_at);
+
+CREATE INDEX IF NOT EXISTS customer_funnel_events_customer_id_idx
+    ON customer_funnel_events (customer_id);
+
+/* backfill from already existing event streams, so that purging a Customer also removes the backfilled rows */
+
+INSERT INTO customer_funnel_registrations (customer_id, registered_at)
+SELECT payload ->> 'customerID', occurred_at
+FROM eventstore
+WHERE event_name = 'CustomerRegistered'
+ON CONFLICT DO NOTHING;
+
+UPDATE customer_funnel_registrations
+SET first_confirmed_at = first_confirmation.occurred_at
+FROM (SELECT payload ->> 'customerID' AS customer_id, MIN(occurred_at) AS occurred_at
+      FROM eventstore
+      WHERE event_name = 'CustomerEmailAddressConfirmed'
+      GROUP BY payload ->> 'customerID') AS first_confirmation
+WHERE customer_funnel_registrations.customer_id = first_confirmation.customer_id;
+
+INSERT INTO customer_funnel_events (customer_id, metric, occurred_at)
+SELECT payload ->> 'customerID',
+       CASE event_name
+           WHEN 'CustomerRegistered' THEN 'registrations'
+           WHEN 'CustomerEmailAddressConfirmed' THEN 'confirmations'
+           WHEN 'CustomerEmailAddressConfirmationFailed' THEN 'confirmation_failures'
+           WHEN 'CustomerDeleted' THEN 'deletions'
+           END,
+       occurred_at
+FROM eventstore
+WHERE event_name IN ('CustomerRegistered', 'CustomerEmailAddressConfirmed',
+                     'CustomerEmailAddressConfirmationFailed', 'CustomerDeleted')
+ORDER BY id;
+
+INSERT INTO customer_funnel_events (customer_id, metric, occurred_at, seconds_to_first_confirmation)
+SELECT customer_id, 'first_confirmations', first_confirmed_at, EXTRACT(EPOCH FROM first_confirmed_at - registered_at)
+FROM customer_funnel_registrations
+WHERE first_confirmed_at IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,43 @@
+BEGIN;
+
+ALTER TABLE eventstore
+    ADD COLUMN IF NOT EXISTS payload_hash CHAR(64) DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS chain_hash CHAR(64) DEFAULT NULL;
+
+CREATE TABLE IF NOT EXISTS eventstore_tombstones
+(
+    id SERIAL
+        CONSTRAINT eventstore_tombstones_pk
+            PRIMARY KEY,
+    stream_id VARCHAR(255) NOT NULL,
+    last_stream_version INTEGER NOT NULL,
+    last_chain_hash CHAR(64) NOT NULL,
+    purged_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    signature CHAR(64) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS eventstore_tombstones_stream_id_idx
+    ON eventstore_tombstones (stream_id);
+
+/* backfill the hash chain for already existing event streams, streams with gaps stay unchained from the gap on */
+
+UPDATE eventstore
+SET payload_hash = encode(sha256(convert_to(payload::text, 'UTF8')), 'hex');
+
+WITH RECURSIVE chain AS (
+    SELECT id, stream_id, stream_version,
+           encode(sha256(convert_to(concat_ws('|', '', payload_hash, stream_id, stream_version, event_name), 'UTF8')), 'hex') AS chain_hash
+    FROM eventstore
+    WHERE stream_version = 1
+    UNION ALL
+    SELECT e.id, e.stream_id, e.stream_version,
+           encode(sha256(convert_to(concat_ws('|', c.chain_hash, e.payload_hash, e.stream_id, e.stream_version, e.event_name), 'UTF8')), 'hex')
+    FROM eventstore e
+             JOIN chain c ON e.stream_id = c.stream_id AND e.stream_version = c.stream_version + 1
+)
+UPDATE eventstore e
+SET chain_hash = chain.chain_hash
+FROM chain
+WHERE e.id = chain.id;
+
+COMMIT;
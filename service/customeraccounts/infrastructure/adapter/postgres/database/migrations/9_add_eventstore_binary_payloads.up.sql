@@ -0,0 +1,13 @@
+BEGIN;
+
+/* payloads are either stored as JSON (payload) or in a binary format like protobuf (payload_bytes) */
+
+ALTER TABLE eventstore
+    ADD COLUMN IF NOT EXISTS payload_bytes BYTEA DEFAULT NULL,
+    ALTER COLUMN payload DROP DEFAULT,
+    ALTER COLUMN payload DROP NOT NULL;
+
+ALTER TABLE eventstore
+    ADD CONSTRAINT eventstore_one_payload_check CHECK ((payload IS NULL) <> (payload_bytes IS NULL));
+
+COMMIT;
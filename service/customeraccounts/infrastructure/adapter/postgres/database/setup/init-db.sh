@@ -10,3 +10,8 @@ psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER" <<-EOSQL
     CREATE DATABASE $GOIDDD_TEST_DATABASE;
     GRANT ALL privileges ON DATABASE $GOIDDD_TEST_DATABASE to $GOIDDD_USERNAME;
 EOSQL
+
+# pg_trgm can only be created by a superuser (before Postgres 13), so it is created here instead of in the migrations
+for database in $GOIDDD_LOCAL_DATABASE $GOIDDD_TEST_DATABASE; do
+    psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER" --dbname "$database" -c "CREATE EXTENSION IF NOT EXISTS pg_trgm;"
+done
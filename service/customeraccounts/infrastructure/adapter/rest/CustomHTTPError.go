@@ -18,14 +18,22 @@ func CustomHTTPError(
 	_ *runtime.ServeMux,
 	marshaler runtime.Marshaler,
 	w http.ResponseWriter,
-	_ *http.Request,
+	r *http.Request,
 	err error,
 ) {
 
 	const fallback = `{"error": "failed to marshal error message"}`
 
+	httpStatus := runtime.HTTPStatusFromCode(status.Code(err))
+
+	// A Customer which does not have the version from If-Match anymore, the response contains its actual version
+	if actualVersion, ok := actualVersionFrom(status.Convert(err)); ok && r.Header.Get("If-Match") != "" {
+		httpStatus = http.StatusPreconditionFailed
+		w.Header().Set("ETag", eTagFor(actualVersion))
+	}
+
 	w.Header().Set("Content-type", marshaler.ContentType())
-	w.WriteHeader(runtime.HTTPStatusFromCode(status.Code(err)))
+	w.WriteHeader(httpStatus)
 
 	jErr := json.NewEncoder(w).Encode(
 		errorBody{
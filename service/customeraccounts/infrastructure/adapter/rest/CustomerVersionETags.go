@@ -0,0 +1,130 @@
+package customerrest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	customergrpc "github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/adapter/grpc"
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const ifMatchMetadataKey = "if-match"
+
+// IncomingHeaderMatcher forwards the If-Match header to ExpectedVersionFromIfMatch.
+func IncomingHeaderMatcher(key string) (string, bool) {
+	if strings.ToLower(key) == ifMatchMetadataKey {
+		return ifMatchMetadataKey, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// ForwardETag returns the version of a Customer as ETag, so that clients can send it back with If-Match.
+func ForwardETag(_ context.Context, w http.ResponseWriter, response proto.Message) error {
+	if view, ok := response.(*customergrpc.RetrieveViewResponse); ok {
+		w.Header().Set("ETag", eTagFor(view.Version))
+	}
+
+	return nil
+}
+
+// ExpectedVersionFromIfMatch is a client interceptor for the gRPC connection of the gateway,
+// which sets the expectedVersion of a command to the version from the If-Match header.
+func ExpectedVersionFromIfMatch(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	ifMatch := md.Get(ifMatchMetadataKey)
+
+	if len(ifMatch) == 0 {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	expectedVersion, err := versionFromIfMatch(ifMatch)
+	if err != nil {
+		return err
+	}
+
+	switch command := req.(type) {
+	case *customergrpc.ConfirmEmailAddressRequest:
+		command.ExpectedVersion = expectedVersion
+	case *customergrpc.ChangeEmailAddressRequest:
+		command.ExpectedVersion = expectedVersion
+	case *customergrpc.ChangeNameRequest:
+		command.ExpectedVersion = expectedVersion
+	case *customergrpc.SetPasswordRequest:
+		command.ExpectedVersion = expectedVersion
+	case *customergrpc.ChangePasswordRequest:
+		command.ExpectedVersion = expectedVersion
+	case *customergrpc.ConfirmMFAEnrollmentRequest:
+		command.ExpectedVersion = expectedVersion
+	case *customergrpc.DisableMFARequest:
+		command.ExpectedVersion = expectedVersion
+	case *customergrpc.SetAttributeRequest:
+		command.ExpectedVersion = expectedVersion
+	case *customergrpc.DeleteRequest:
+		command.ExpectedVersion = expectedVersion
+	default:
+		return status.Error(codes.InvalidArgument, "If-Match is not supported for this request")
+	}
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// versionFromIfMatch accepts * (any version, 0) or one strong ETag as returned by ForwardETag.
+func versionFromIfMatch(ifMatch []string) (uint64, error) {
+	if len(ifMatch) != 1 || strings.Contains(ifMatch[0], ",") {
+		return 0, status.Error(codes.InvalidArgument, "If-Match must contain exactly one ETag")
+	}
+
+	eTag := strings.TrimSpace(ifMatch[0])
+
+	if eTag == "*" {
+		return 0, nil
+	}
+
+	version, err := strconv.ParseUint(strings.Trim(eTag, `"`), 10, 64)
+	if err != nil || version == 0 || eTag != eTagFor(version) {
+		return 0, status.Errorf(codes.InvalidArgument, "If-Match contains the invalid ETag %s", eTag)
+	}
+
+	return version, nil
+}
+
+// actualVersionFrom returns the version of a Customer which did not have the version from If-Match.
+func actualVersionFrom(st *status.Status) (uint64, bool) {
+	for _, detail := range st.Details() {
+		preconditionFailure, ok := detail.(*errdetails.PreconditionFailure)
+		if !ok {
+			continue
+		}
+
+		for _, violation := range preconditionFailure.Violations {
+			if violation.Type == customergrpc.VersionViolationType {
+				version, err := strconv.ParseUint(violation.Description, 10, 64)
+
+				return version, err == nil
+			}
+		}
+	}
+
+	return 0, false
+}
+
+func eTagFor(version uint64) string {
+	return `"` + strconv.FormatUint(version, 10) + `"`
+}
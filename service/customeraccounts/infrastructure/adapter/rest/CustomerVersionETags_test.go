@@ -0,0 +1,101 @@
+package customerrest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	customergrpc "github.com/AntonStoeckl/go-iddd/service/customeraccounts/infrastructure/adapter/grpc"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestExpectedVersionFromIfMatch(t *testing.T) {
+	var invokedWith interface{}
+
+	invoker := func(_ context.Context, _ string, req, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		invokedWith = req
+		return nil
+	}
+
+	withIfMatch := func(ifMatch string) context.Context {
+		return metadata.NewOutgoingContext(context.Background(), metadata.Pairs(ifMatchMetadataKey, ifMatch))
+	}
+
+	Convey("When a command is sent with If-Match: \"3\"", t, func() {
+		req := &customergrpc.ChangeNameRequest{Id: "some-id"}
+		err := ExpectedVersionFromIfMatch(withIfMatch(`"3"`), "ChangeName", req, nil, nil, invoker)
+
+		Convey("Then its expectedVersion should be 3", func() {
+			So(err, ShouldBeNil)
+			So(invokedWith, ShouldEqual, req)
+			So(req.ExpectedVersion, ShouldEqual, 3)
+		})
+	})
+
+	Convey("When a command is sent with If-Match: *", t, func() {
+		req := &customergrpc.DeleteRequest{Id: "some-id"}
+		err := ExpectedVersionFromIfMatch(withIfMatch("*"), "Delete", req, nil, nil, invoker)
+
+		Convey("Then it should not be conditional", func() {
+			So(err, ShouldBeNil)
+			So(req.ExpectedVersion, ShouldEqual, 0)
+		})
+	})
+
+	for _, ifMatch := range []string{`W/"3"`, `3`, `"3", "4"`, `"0"`, `"abc"`} {
+		ifMatch := ifMatch
+
+		Convey("When a command is sent with If-Match: "+ifMatch, t, func() {
+			invokedWith = nil
+			err := ExpectedVersionFromIfMatch(withIfMatch(ifMatch), "ChangeName", &customergrpc.ChangeNameRequest{}, nil, nil, invoker)
+
+			Convey("Then it should fail without being sent", func() {
+				So(status.Code(err), ShouldEqual, codes.InvalidArgument)
+				So(invokedWith, ShouldBeNil)
+			})
+		})
+	}
+
+	Convey("When a request without expectedVersion is sent with If-Match", t, func() {
+		err := ExpectedVersionFromIfMatch(withIfMatch(`"3"`), "VerifyMFACode", &customergrpc.VerifyMFACodeRequest{}, nil, nil, invoker)
+
+		Convey("Then it should fail", func() {
+			So(status.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+	})
+}
+
+func TestCustomHTTPError_WithWrongExpectedVersion(t *testing.T) {
+	grpcErr := customergrpc.MapToGRPCErrors(es.NewWrongExpectedVersionError(es.ExactVersion(3), 5))
+
+	Convey("When a command with If-Match fails because the Customer has another version", t, func() {
+		r := httptest.NewRequest(http.MethodPut, "/v1/customer/some-id/name", nil)
+		r.Header.Set("If-Match", `"3"`)
+		w := httptest.NewRecorder()
+
+		CustomHTTPError(context.Background(), nil, &runtime.JSONPb{}, w, r, grpcErr)
+
+		Convey("Then the response should be 412 with the actual version as ETag", func() {
+			So(w.Code, ShouldEqual, http.StatusPreconditionFailed)
+			So(w.Header().Get("ETag"), ShouldEqual, `"5"`)
+		})
+	})
+
+	Convey("When a command without If-Match fails because of a concurrency conflict", t, func() {
+		r := httptest.NewRequest(http.MethodPut, "/v1/customer/some-id/name", nil)
+		w := httptest.NewRecorder()
+
+		CustomHTTPError(context.Background(), nil, &runtime.JSONPb{}, w, r, grpcErr)
+
+		Convey("Then the response should be 409", func() {
+			So(w.Code, ShouldEqual, http.StatusConflict)
+		})
+	})
+}
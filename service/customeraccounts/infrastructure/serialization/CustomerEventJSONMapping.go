@@ -1,21 +1,10 @@
 package serialization
 
-import "github.com/AntonStoeckl/go-iddd/service/shared/es"
+import (
+	"time"
 
-type CustomerRegisteredForJSON struct {
-	CustomerID       string              `json:"customerID"`
-	EmailAddress     string              `json:"emailAddress"`
-	ConfirmationHash string              `json:"confirmationHash"`
-	PersonGivenName  string              `json:"personGivenName"`
-	PersonFamilyName string              `json:"personFamilyName"`
-	Meta             es.EventMetaForJSON `json:"meta"`
-}
-
-type CustomerEmailAddressConfirmedForJSON struct {
-	CustomerID   string              `json:"customerID"`
-	EmailAddress string              `json:"emailAddress"`
-	Meta         es.EventMetaForJSON `json:"meta"`
-}
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+)
 
 type CustomerEmailAddressConfirmationFailedForJSON struct {
 	CustomerID       string              `json:"customerID"`
@@ -25,23 +14,16 @@ type CustomerEmailAddressConfirmationFailedForJSON struct {
 	Meta             es.EventMetaForJSON `json:"meta"`
 }
 
-type CustomerEmailAddressChangedForJSON struct {
-	CustomerID           string              `json:"customerID"`
-	EmailAddress         string              `json:"emailAddress"`
-	ConfirmationHash     string              `json:"confirmationHash"`
-	PreviousEmailAddress string              `json:"previousEmailAddress"`
-	Meta                 es.EventMetaForJSON `json:"meta"`
-}
-
-type CustomerNameChangedForJSON struct {
-	CustomerID string              `json:"customerID"`
-	GivenName  string              `json:"givenName"`
-	FamilyName string              `json:"familyName"`
-	Meta       es.EventMetaForJSON `json:"meta"`
+type CustomerAccountRecoveryRequestedForJSON struct {
+	CustomerID             string              `json:"customerID"`
+	RecoveryTokenDigest    string              `json:"recoveryTokenDigest"`
+	RecoveryTokenExpiresAt time.Time           `json:"recoveryTokenExpiresAt"`
+	Meta                   es.EventMetaForJSON `json:"meta"`
 }
 
-type CustomerDeletedForJSON struct {
-	CustomerID   string              `json:"customerID"`
-	EmailAddress string              `json:"emailAddress"`
-	Meta         es.EventMetaForJSON `json:"meta"`
+type CustomerMFAEnrollmentStartedForJSON struct {
+	CustomerID          string              `json:"customerID"`
+	EncryptedTOTPSecret string              `json:"encryptedTOTPSecret"`
+	RecoveryCodeHashes  []string            `json:"recoveryCodeHashes"`
+	Meta                es.EventMetaForJSON `json:"meta"`
 }
@@ -0,0 +1,132 @@
+package serialization
+
+//go:generate go run ../../../cmd/eventschemas -dir schemas
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+// CustomerEventSchemasURLPath is where the REST service serves the schema files.
+const CustomerEventSchemasURLPath = "/v1/schemas/events/"
+
+// customerEventSchemaVersions must be increased for an event whose payload changes incompatibly, together with
+// adding an upcaster for the stored payloads. Events which are not listed have version 1.
+// The schema files of older versions must be kept, because consumers might still rely on them.
+var customerEventSchemaVersions = map[string]uint{}
+
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	ID                   string                 `json:"$id,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Type                 interface{}            `json:"type"`
+	Format               string                 `json:"format,omitempty"`
+	MinLength            uint                   `json:"minLength,omitempty"`
+	Const                string                 `json:"const,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties *bool                  `json:"additionalProperties,omitempty"`
+}
+
+// CustomerEventJSONSchemas returns the JSON Schemas of the current payload versions of all Customer events,
+// by file name, e.g. CustomerRegistered.v1.json.
+func CustomerEventJSONSchemas() (map[string][]byte, error) {
+	wrapWithMsg := "CustomerEventJSONSchemas"
+	schemas := make(map[string][]byte)
+
+	registry, err := NewCustomerEventRegistry(nil)
+	if err != nil {
+		return nil, errors.Wrap(err, wrapWithMsg)
+	}
+
+	for _, eventName := range registry.EventNames() {
+		payload, _ := registry.NewPayload(eventName)
+
+		schema, err := buildJSONSchema(reflect.TypeOf(payload).Elem())
+		if err != nil {
+			err = errors.Wrapf(err, "event [%s]", eventName)
+			return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		fileName := customerEventSchemaFileName(eventName)
+		schema.Schema = "http://json-schema.org/draft-07/schema#"
+		schema.ID = CustomerEventSchemasURLPath + fileName
+		schema.Title = eventName
+		schema.Properties["meta"].Properties["eventName"].Const = eventName
+
+		content, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		schemas[fileName] = append(content, '\n')
+	}
+
+	return schemas, nil
+}
+
+func customerEventSchemaFileName(eventName string) string {
+	version, ok := customerEventSchemaVersions[eventName]
+	if !ok {
+		version = 1
+	}
+
+	return fmt.Sprintf("%s.v%d.json", eventName, version)
+}
+
+// buildJSONSchema describes the JSON which jsoniter writes for the payload type. All properties are required,
+// because they are always written, strings must not be empty unless they are tagged with schema:"optional".
+func buildJSONSchema(payloadType reflect.Type) (*jsonSchema, error) {
+	switch {
+	case payloadType == reflect.TypeOf(time.Time{}):
+		return &jsonSchema{Type: "string", Format: "date-time"}, nil
+	case payloadType.Kind() == reflect.String:
+		return &jsonSchema{Type: "string", MinLength: 1}, nil
+	case payloadType.Kind() == reflect.Slice:
+		items, err := buildJSONSchema(payloadType.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return &jsonSchema{Type: []string{"array", "null"}, Items: items}, nil // nil slices are written as null
+	case payloadType.Kind() == reflect.Struct:
+		additionalProperties := false
+		schema := &jsonSchema{
+			Type:                 "object",
+			Properties:           make(map[string]*jsonSchema),
+			AdditionalProperties: &additionalProperties,
+		}
+
+		for i := 0; i < payloadType.NumField(); i++ {
+			field := payloadType.Field(i)
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+
+			if name == "" || name == "-" {
+				return nil, errors.Newf("the field [%s] has no JSON name", field.Name)
+			}
+
+			property, err := buildJSONSchema(field.Type)
+			if err != nil {
+				return nil, errors.Wrapf(err, "field [%s]", field.Name)
+			}
+
+			if field.Tag.Get("schema") == "optional" {
+				property.MinLength = 0
+			}
+
+			schema.Properties[name] = property
+			schema.Required = append(schema.Required, name)
+		}
+
+		return schema, nil
+	default:
+		return nil, errors.Newf("the type [%s] is not supported", payloadType)
+	}
+}
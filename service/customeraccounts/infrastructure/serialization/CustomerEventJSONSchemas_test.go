@@ -0,0 +1,195 @@
+package serialization
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCustomerEventJSONSchemas(t *testing.T) {
+	Convey("When the JSON Schemas of the Customer events are built", t, func() {
+		schemas, err := CustomerEventJSONSchemas()
+		So(err, ShouldBeNil)
+
+		Convey("Then the schema files should be up to date (run go generate otherwise)", func() {
+			for fileName, content := range schemas {
+				onDisk, err := ioutil.ReadFile(filepath.Join("schemas", fileName))
+				So(err, ShouldBeNil)
+				So(string(onDisk), ShouldEqual, string(content))
+			}
+		})
+	})
+}
+
+func TestMarshaledCustomerEventsMatchTheirJSONSchemas(t *testing.T) {
+	customerID := value.GenerateCustomerID()
+	emailAddress := value.RebuildEmailAddress("john@doe.com")
+	confirmationHash := value.GenerateConfirmationHash(emailAddress.String())
+	passwordHash := value.RebuildPasswordHash("$2a$12$someBcryptHash")
+	totpSecret, _ := value.GenerateTOTPSecret()
+	_, recoveryCodes, _ := value.GenerateRecoveryCodes()
+	_, recoveryToken, _ := value.GenerateAccountRecoveryToken(time.Now())
+	failureReason := errors.Mark(errors.New("wrong confirmation hash supplied"), shared.ErrDomainConstraintsViolation)
+
+	events := []es.DomainEvent{
+		domain.BuildCustomerRegistered(customerID, emailAddress, confirmationHash, value.RebuildPersonName("John", "Doe"), 1),
+		domain.BuildCustomerEmailAddressConfirmed(customerID, emailAddress, 2),
+		domain.BuildCustomerEmailAddressConfirmationFailed(customerID, emailAddress, confirmationHash, failureReason, 3),
+		domain.BuildCustomerEmailAddressChanged(customerID, value.RebuildEmailAddress("john.frank@doe.com"), confirmationHash, emailAddress, 4),
+		domain.BuildCustomerNameChanged(customerID, value.RebuildPersonName("John Frank", "Doe"), 5),
+		domain.BuildCustomerAttributeSet(customerID, value.RebuildCustomAttribute("nickname", ""), 6),
+		domain.BuildCustomerPasswordSet(customerID, passwordHash, 7),
+		domain.BuildCustomerPasswordChanged(customerID, passwordHash, 8),
+		domain.BuildCustomerAccountRecoveryRequested(customerID, recoveryToken, 9),
+		domain.BuildCustomerAccountRecovered(customerID, passwordHash, 10),
+		domain.BuildCustomerMFAEnrollmentStarted(customerID, totpSecret, recoveryCodes, 11),
+		domain.BuildCustomerMFAEnrollmentConfirmed(customerID, 12),
+		domain.BuildCustomerMFACodeUsed(customerID, value.RebuildTOTPTimeStep("53333333"), 13),
+		domain.BuildCustomerMFARecoveryCodeUsed(customerID, recoveryCodes.Hashes()[0], 14),
+		domain.BuildCustomerMFADisabled(customerID, 15),
+		domain.BuildCustomerDeleted(customerID, emailAddress, 16),
+	}
+
+	Convey("Given an example of every registered Customer event", t, func() {
+		var eventNames []string
+
+		for _, event := range events {
+			eventNames = append(eventNames, event.Meta().EventName())
+		}
+
+		So(eventNames, ShouldHaveLength, len(testCustomerEventRegistry.EventNames()))
+		So(eventNames, ShouldContain, "CustomerEmailAddressConfirmationFailed")
+
+		for _, eventName := range testCustomerEventRegistry.EventNames() {
+			So(eventNames, ShouldContain, eventName)
+		}
+	})
+
+	for _, event := range events {
+		event := event
+		eventName := event.Meta().EventName()
+
+		Convey(fmt.Sprintf("When %s is marshaled", eventName), t, func() {
+			payload, err := marshalCustomerEvent(event)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should be valid according to its published schema", func() {
+				content, err := ioutil.ReadFile(filepath.Join("schemas", customerEventSchemaFileName(eventName)))
+				So(err, ShouldBeNil)
+
+				var schema jsonSchema
+				So(json.Unmarshal(content, &schema), ShouldBeNil)
+
+				var decoded interface{}
+				So(json.Unmarshal(payload, &decoded), ShouldBeNil)
+
+				So(validateAgainstJSONSchema(&schema, decoded, eventName), ShouldBeNil)
+			})
+		})
+	}
+
+	Convey("When a payload with an additional property is validated", t, func() {
+		content, err := ioutil.ReadFile(filepath.Join("schemas", customerEventSchemaFileName("CustomerDeleted")))
+		So(err, ShouldBeNil)
+
+		var schema jsonSchema
+		So(json.Unmarshal(content, &schema), ShouldBeNil)
+
+		payload := map[string]interface{}{
+			"customerID":   "some-id",
+			"emailAddress": "john@doe.com",
+			"unknown":      "value",
+			"meta":         map[string]interface{}{"eventName": "CustomerDeleted", "occurredAt": "2020-01-01T00:00:00Z"},
+		}
+
+		Convey("Then it should be invalid", func() {
+			So(validateAgainstJSONSchema(&schema, payload, "CustomerDeleted"), ShouldBeError)
+		})
+	})
+}
+
+// validateAgainstJSONSchema supports the keywords which buildJSONSchema uses.
+func validateAgainstJSONSchema(schema *jsonSchema, value interface{}, path string) error {
+	if !matchesJSONSchemaType(schema.Type, value) {
+		return errors.Newf("%s: %v does not have the type %v", path, value, schema.Type)
+	}
+
+	switch actual := value.(type) {
+	case string:
+		if uint(len(actual)) < schema.MinLength {
+			return errors.Newf("%s: is shorter than %d", path, schema.MinLength)
+		}
+
+		if schema.Const != "" && actual != schema.Const {
+			return errors.Newf("%s: is not %s", path, schema.Const)
+		}
+
+		if _, err := time.Parse(time.RFC3339Nano, actual); schema.Format == "date-time" && err != nil {
+			return errors.Newf("%s: is not a date-time", path)
+		}
+	case []interface{}:
+		for i, item := range actual {
+			if err := validateAgainstJSONSchema(schema.Items, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		for _, name := range schema.Required {
+			if _, ok := actual[name]; !ok {
+				return errors.Newf("%s: misses the property %s", path, name)
+			}
+		}
+
+		for name, propertyValue := range actual {
+			property, ok := schema.Properties[name]
+			if !ok {
+				if schema.AdditionalProperties != nil && !*schema.AdditionalProperties {
+					return errors.Newf("%s: has the additional property %s", path, name)
+				}
+
+				continue
+			}
+
+			if err := validateAgainstJSONSchema(property, propertyValue, path+"."+name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func matchesJSONSchemaType(schemaType interface{}, value interface{}) bool {
+	if types, ok := schemaType.([]interface{}); ok {
+		for _, oneType := range types {
+			if matchesJSONSchemaType(oneType, value) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	switch value.(type) {
+	case nil:
+		return schemaType == "null"
+	case string:
+		return schemaType == "string"
+	case []interface{}:
+		return schemaType == "array"
+	case map[string]interface{}:
+		return schemaType == "object"
+	default:
+		return false
+	}
+}
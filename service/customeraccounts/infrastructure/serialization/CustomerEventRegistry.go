@@ -0,0 +1,107 @@
+package serialization
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// strictJSON is jsoniter.ConfigFastest, but fails for unknown fields instead of ignoring them.
+var strictJSON = jsoniter.Config{
+	EscapeHTML:                    false,
+	MarshalFloatWith6Digits:       true,
+	ObjectFieldMustBeSimpleString: true,
+	DisallowUnknownFields:         true,
+}.Froze()
+
+// NewCustomerEventRegistry registers all Customer events with their JSON payloads.
+// Secrets contained in events (e.g. TOTP secrets) are encrypted and decrypted with the supplied SecretCipher.
+// It fails if any Customer event is registered twice or not at all, so it must be called at startup.
+func NewCustomerEventRegistry(secretCipher *SecretCipher) (*es.EventRegistry, error) {
+	wrapWithMsg := "NewCustomerEventRegistry"
+	registry := es.NewEventRegistry(strictJSON)
+
+	if err := registry.Register(generatedCustomerEventRegistrations()...); err != nil {
+		return nil, errors.Wrap(err, wrapWithMsg)
+	}
+
+	// events which can't be generated from CustomerEvents.json, because they contain secrets or failure reasons
+	err := registry.Register(
+		es.EventRegistration{
+			Name:       "CustomerEmailAddressConfirmationFailed",
+			Event:      domain.CustomerEmailAddressConfirmationFailed{},
+			ToPayload:  customerEmailAddressConfirmationFailedToPayload,
+			NewPayload: func() interface{} { return &CustomerEmailAddressConfirmationFailedForJSON{} },
+			Rebuild:    rebuildCustomerEmailAddressConfirmationFailed,
+		},
+		es.EventRegistration{
+			Name:       "CustomerAccountRecoveryRequested",
+			Event:      domain.CustomerAccountRecoveryRequested{},
+			ToPayload:  customerAccountRecoveryRequestedToPayload,
+			NewPayload: func() interface{} { return &CustomerAccountRecoveryRequestedForJSON{} },
+			Rebuild:    rebuildCustomerAccountRecoveryRequested,
+		},
+		es.EventRegistration{
+			Name:       "CustomerMFAEnrollmentStarted",
+			Event:      domain.CustomerMFAEnrollmentStarted{},
+			ToPayload:  customerMFAEnrollmentStartedToPayloadWith(secretCipher),
+			NewPayload: func() interface{} { return &CustomerMFAEnrollmentStartedForJSON{} },
+			Rebuild:    rebuildCustomerMFAEnrollmentStartedWith(secretCipher),
+		},
+	)
+
+	if err != nil {
+		return nil, errors.Wrap(err, wrapWithMsg)
+	}
+
+	if err = registry.AssertRegistered(domain.CustomerEvents()...); err != nil {
+		return nil, errors.Wrap(err, wrapWithMsg)
+	}
+
+	return registry, nil
+}
+
+// NewCustomerEventProtobufRegistry registers all Customer events with their protobuf payloads, see NewCustomerEventRegistry.
+func NewCustomerEventProtobufRegistry(secretCipher *SecretCipher) (*es.EventRegistry, error) {
+	wrapWithMsg := "NewCustomerEventProtobufRegistry"
+	registry := es.NewEventRegistry(protobufCodec{})
+
+	if err := registry.Register(generatedCustomerEventProtoRegistrations()...); err != nil {
+		return nil, errors.Wrap(err, wrapWithMsg)
+	}
+
+	err := registry.Register(
+		es.EventRegistration{
+			Name:       "CustomerEmailAddressConfirmationFailed",
+			Event:      domain.CustomerEmailAddressConfirmationFailed{},
+			ToPayload:  customerEmailAddressConfirmationFailedToProtoPayload,
+			NewPayload: func() interface{} { return &CustomerEmailAddressConfirmationFailedForProto{} },
+			Rebuild:    rebuildCustomerEmailAddressConfirmationFailedFromProto,
+		},
+		es.EventRegistration{
+			Name:       "CustomerAccountRecoveryRequested",
+			Event:      domain.CustomerAccountRecoveryRequested{},
+			ToPayload:  customerAccountRecoveryRequestedToProtoPayload,
+			NewPayload: func() interface{} { return &CustomerAccountRecoveryRequestedForProto{} },
+			Rebuild:    rebuildCustomerAccountRecoveryRequestedFromProto,
+		},
+		es.EventRegistration{
+			Name:       "CustomerMFAEnrollmentStarted",
+			Event:      domain.CustomerMFAEnrollmentStarted{},
+			ToPayload:  customerMFAEnrollmentStartedToProtoPayloadWith(secretCipher),
+			NewPayload: func() interface{} { return &CustomerMFAEnrollmentStartedForProto{} },
+			Rebuild:    rebuildCustomerMFAEnrollmentStartedFromProtoWith(secretCipher),
+		},
+	)
+
+	if err != nil {
+		return nil, errors.Wrap(err, wrapWithMsg)
+	}
+
+	if err = registry.AssertRegistered(domain.CustomerEvents()...); err != nil {
+		return nil, errors.Wrap(err, wrapWithMsg)
+	}
+
+	return registry, nil
+}
@@ -3,6 +3,7 @@ package serialization
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
@@ -12,6 +13,12 @@ import (
 	. "github.com/smartystreets/goconvey/convey"
 )
 
+var testSecretCipher, _ = NewSecretCipher([]byte("0123456789abcdef0123456789abcdef"))
+var testCustomerEventRegistry, _ = NewCustomerEventRegistry(testSecretCipher)
+var marshalCustomerEvent = testCustomerEventRegistry.Marshal
+var unmarshalCustomerEvent = testCustomerEventRegistry.Unmarshal
+var testCustomerEventProtobufRegistry, _ = NewCustomerEventProtobufRegistry(testSecretCipher)
+
 func TestMarshalAndUnmarshalCustomerEvents(t *testing.T) {
 	customerID := value.GenerateCustomerID()
 	emailAddress := value.RebuildEmailAddress("john@doe.com")
@@ -19,6 +26,11 @@ func TestMarshalAndUnmarshalCustomerEvents(t *testing.T) {
 	confirmationHash := value.GenerateConfirmationHash(emailAddress.String())
 	personName := value.RebuildPersonName("John", "Doe")
 	newPersonName := value.RebuildPersonName("John Frank", "Doe")
+	passwordHash := value.RebuildPasswordHash("$2a$12$someBcryptHash")
+	newPasswordHash := value.RebuildPasswordHash("$2a$12$someOtherBcryptHash")
+	totpSecret, _ := value.GenerateTOTPSecret()
+	_, recoveryCodes, _ := value.GenerateRecoveryCodes()
+	_, recoveryToken, _ := value.GenerateAccountRecoveryToken(time.Now())
 	failureReason := "wrong confirmation hash supplied"
 
 	var myEvents []es.DomainEvent
@@ -52,56 +64,135 @@ func TestMarshalAndUnmarshalCustomerEvents(t *testing.T) {
 
 	streamVersion++
 
+	myEvents = append(
+		myEvents,
+		domain.BuildCustomerAttributeSet(customerID, value.RebuildCustomAttribute("vip_level", "3"), streamVersion),
+	)
+
+	streamVersion++
+
+	myEvents = append(
+		myEvents,
+		domain.BuildCustomerPasswordSet(customerID, passwordHash, streamVersion),
+	)
+
+	streamVersion++
+
+	myEvents = append(
+		myEvents,
+		domain.BuildCustomerPasswordChanged(customerID, newPasswordHash, streamVersion),
+	)
+
+	streamVersion++
+
+	myEvents = append(
+		myEvents,
+		domain.BuildCustomerAccountRecoveryRequested(customerID, recoveryToken, streamVersion),
+	)
+
+	streamVersion++
+
+	myEvents = append(
+		myEvents,
+		domain.BuildCustomerAccountRecovered(customerID, passwordHash, streamVersion),
+	)
+
+	streamVersion++
+
+	myEvents = append(
+		myEvents,
+		domain.BuildCustomerMFAEnrollmentStarted(customerID, totpSecret, recoveryCodes, streamVersion),
+	)
+
+	streamVersion++
+
+	myEvents = append(
+		myEvents,
+		domain.BuildCustomerMFAEnrollmentConfirmed(customerID, streamVersion),
+	)
+
+	streamVersion++
+
+	myEvents = append(
+		myEvents,
+		domain.BuildCustomerMFACodeUsed(customerID, value.RebuildTOTPTimeStep("53333333"), streamVersion),
+	)
+
+	streamVersion++
+
+	myEvents = append(
+		myEvents,
+		domain.BuildCustomerMFARecoveryCodeUsed(customerID, recoveryCodes.Hashes()[0], streamVersion),
+	)
+
+	streamVersion++
+
+	myEvents = append(
+		myEvents,
+		domain.BuildCustomerMFADisabled(customerID, streamVersion),
+	)
+
+	streamVersion++
+
 	myEvents = append(
 		myEvents,
 		domain.BuildCustomerDeleted(customerID, emailAddress, streamVersion),
 	)
 
-	for idx, event := range myEvents {
-		originalEvent := event
-		streamVersion = uint(idx + 1)
-		eventName := originalEvent.Meta().EventName()
+	registries := map[string]*es.EventRegistry{"JSON": testCustomerEventRegistry, "protobuf": testCustomerEventProtobufRegistry}
 
-		Convey(fmt.Sprintf("When %s is marshaled and unmarshaled", eventName), t, func() {
-			json, err := MarshalCustomerEvent(originalEvent)
-			So(err, ShouldBeNil)
+	for format, registry := range registries {
+		for idx, event := range myEvents {
+			registry := registry
+			originalEvent := event
+			streamVersion := uint(idx + 1)
+			eventName := originalEvent.Meta().EventName()
 
-			unmarshaledEvent, err := UnmarshalCustomerEvent(originalEvent.Meta().EventName(), json, streamVersion)
-			So(err, ShouldBeNil)
+			Convey(fmt.Sprintf("When %s is marshaled and unmarshaled as %s", eventName, format), t, func() {
+				payload, err := registry.Marshal(originalEvent)
+				So(err, ShouldBeNil)
+
+				unmarshaledEvent, err := registry.Unmarshal(originalEvent.Meta().EventName(), payload, streamVersion)
+				So(err, ShouldBeNil)
 
-			Convey(fmt.Sprintf("Then the unmarshaled %s should resemble the original %s", eventName, eventName), func() {
-				So(unmarshaledEvent, ShouldResemble, originalEvent)
+				Convey(fmt.Sprintf("Then the unmarshaled %s should resemble the original %s", eventName, eventName), func() {
+					So(unmarshaledEvent, ShouldResemble, originalEvent)
+				})
 			})
-		})
+		}
 	}
 
 	// Special treatment for Failure events because the FailureReason()
 	//  is a pointer to an error which does not resemble properly (ShouldResemble uses reflect.DeepEqual)
 
-	Convey("When CustomerEmailAddressConfirmationFailed is marshaled and unmarshaled", t, func() {
-		originalEvent := domain.BuildCustomerEmailAddressConfirmationFailed(
-			customerID, emailAddress, confirmationHash, errors.Mark(errors.New(failureReason), shared.ErrDomainConstraintsViolation), streamVersion,
-		)
+	for format, registry := range registries {
+		registry := registry
 
-		oEventName := originalEvent.Meta().EventName()
+		Convey(fmt.Sprintf("When CustomerEmailAddressConfirmationFailed is marshaled and unmarshaled as %s", format), t, func() {
+			originalEvent := domain.BuildCustomerEmailAddressConfirmationFailed(
+				customerID, emailAddress, confirmationHash, errors.Mark(errors.New(failureReason), shared.ErrDomainConstraintsViolation), streamVersion,
+			)
 
-		json, err := MarshalCustomerEvent(originalEvent)
-		So(err, ShouldBeNil)
+			oEventName := originalEvent.Meta().EventName()
 
-		unmarshaledEvent, err := UnmarshalCustomerEvent(originalEvent.Meta().EventName(), json, streamVersion)
-		So(err, ShouldBeNil)
+			payload, err := registry.Marshal(originalEvent)
+			So(err, ShouldBeNil)
+
+			unmarshaledEvent, err := registry.Unmarshal(originalEvent.Meta().EventName(), payload, streamVersion)
+			So(err, ShouldBeNil)
 
-		uEventName := unmarshaledEvent.Meta().EventName()
+			uEventName := unmarshaledEvent.Meta().EventName()
 
-		Convey(fmt.Sprintf("Then the unmarshaled %s should resemble the original %s", oEventName, uEventName), func() {
-			unmarshaledEvent, ok := unmarshaledEvent.(domain.CustomerEmailAddressConfirmationFailed)
-			So(ok, ShouldBeTrue)
-			So(unmarshaledEvent.CustomerID().Equals(originalEvent.CustomerID()), ShouldBeTrue)
-			So(unmarshaledEvent.EmailAddress().Equals(originalEvent.EmailAddress()), ShouldBeTrue)
-			So(unmarshaledEvent.ConfirmationHash().Equals(originalEvent.ConfirmationHash()), ShouldBeTrue)
-			assertEventMetaResembles(originalEvent, unmarshaledEvent)
+			Convey(fmt.Sprintf("Then the unmarshaled %s should resemble the original %s", oEventName, uEventName), func() {
+				unmarshaledEvent, ok := unmarshaledEvent.(domain.CustomerEmailAddressConfirmationFailed)
+				So(ok, ShouldBeTrue)
+				So(unmarshaledEvent.CustomerID().Equals(originalEvent.CustomerID()), ShouldBeTrue)
+				So(unmarshaledEvent.EmailAddress().Equals(originalEvent.EmailAddress()), ShouldBeTrue)
+				So(unmarshaledEvent.ConfirmationHash().Equals(originalEvent.ConfirmationHash()), ShouldBeTrue)
+				assertEventMetaResembles(originalEvent, unmarshaledEvent)
+			})
 		})
-	})
+	}
 }
 
 func assertEventMetaResembles(originalEvent es.DomainEvent, unmarshaledEvent es.DomainEvent) {
@@ -115,9 +206,40 @@ func assertEventMetaResembles(originalEvent es.DomainEvent, unmarshaledEvent es.
 	So(errors.Is(unmarshaledEvent.FailureReason(), shared.ErrDomainConstraintsViolation), ShouldBeTrue)
 }
 
+func TestMarshalCustomerEvent_WithSecrets(t *testing.T) {
+	Convey("Given CustomerMFAEnrollmentStarted", t, func() {
+		customerID := value.GenerateCustomerID()
+		totpSecret, _ := value.GenerateTOTPSecret()
+		_, recoveryCodes, _ := value.GenerateRecoveryCodes()
+		event := domain.BuildCustomerMFAEnrollmentStarted(customerID, totpSecret, recoveryCodes, 2)
+
+		Convey("When it is marshaled", func() {
+			json, err := marshalCustomerEvent(event)
+			So(err, ShouldBeNil)
+
+			Convey("Then the payload should not contain the plain TOTP secret", func() {
+				So(string(json), ShouldNotContainSubstring, totpSecret.String())
+			})
+
+			Convey("And when it is unmarshaled with a different key", func() {
+				otherSecretCipher, err := NewSecretCipher([]byte("fedcba9876543210fedcba9876543210"))
+				So(err, ShouldBeNil)
+				otherRegistry, err := NewCustomerEventRegistry(otherSecretCipher)
+				So(err, ShouldBeNil)
+
+				_, err = otherRegistry.Unmarshal(event.Meta().EventName(), json, 2)
+
+				Convey("Then it should fail", func() {
+					So(errors.Is(err, shared.ErrUnmarshalingFailed), ShouldBeTrue)
+				})
+			})
+		})
+	})
+}
+
 func TestMarshalCustomerEvent_WithUnknownEvent(t *testing.T) {
 	Convey("When an unknown event is marshaled", t, func() {
-		_, err := MarshalCustomerEvent(SomeEvent{})
+		_, err := marshalCustomerEvent(SomeEvent{})
 
 		Convey("Then it should fail", func() {
 			So(errors.Is(err, shared.ErrMarshalingFailed), ShouldBeTrue)
@@ -127,7 +249,7 @@ func TestMarshalCustomerEvent_WithUnknownEvent(t *testing.T) {
 
 func TestUnmarshalCustomerEvent_WithUnknownEvent(t *testing.T) {
 	Convey("When an unknown event is unmarshaled", t, func() {
-		_, err := UnmarshalCustomerEvent("unknown", []byte{}, 1)
+		_, err := unmarshalCustomerEvent("unknown", []byte{}, 1)
 
 		Convey("Then it should fail", func() {
 			So(errors.Is(err, shared.ErrUnmarshalingFailed), ShouldBeTrue)
@@ -135,6 +257,31 @@ func TestUnmarshalCustomerEvent_WithUnknownEvent(t *testing.T) {
 	})
 }
 
+func TestUnmarshalCustomerEvent_WithCorruptPayload(t *testing.T) {
+	meta := `"meta": {"eventName": "CustomerEmailAddressConfirmed", "occurredAt": "2020-01-01T00:00:00Z"}`
+
+	corruptPayloads := map[string]string{
+		"invalid JSON":       `{"customerID": "64bcf656-da30-4f5a-b0b5-aead60965aa3",`,
+		"an unknown field":   `{"customerID": "some-id", "emailAddress": "john@doe.com", "unknown": "value", ` + meta + `}`,
+		"a missing field":    `{"customerID": "some-id", ` + meta + `}`,
+		"an empty field":     `{"customerID": "some-id", "emailAddress": "", ` + meta + `}`,
+		"a missing meta":     `{"customerID": "some-id", "emailAddress": "john@doe.com"}`,
+		"a wrong event name": `{"customerID": "some-id", "emailAddress": "john@doe.com", "meta": {"eventName": "CustomerDeleted", "occurredAt": "x"}}`,
+	}
+
+	for description, payload := range corruptPayloads {
+		payload := payload
+
+		Convey(fmt.Sprintf("When a payload with %s is unmarshaled", description), t, func() {
+			_, err := unmarshalCustomerEvent("CustomerEmailAddressConfirmed", []byte(payload), 1)
+
+			Convey("Then it should fail", func() {
+				So(errors.Is(err, shared.ErrUnmarshalingFailed), ShouldBeTrue)
+			})
+		})
+	}
+}
+
 /***** a mock event to test marshaling unknown event *****/
 
 type SomeEvent struct{}
@@ -0,0 +1,202 @@
+package serialization
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+)
+
+// exportedEventFields are the only fields which are exported per event, all others are secrets or only meaningful
+// inside this service. New events and fields are not exported until they are added here.
+var exportedEventFields = map[string][]string{
+	"CustomerRegistered":                     {"customerID", "emailAddress", "personGivenName", "personFamilyName"},
+	"CustomerEmailAddressConfirmed":          {"customerID", "emailAddress"},
+	"CustomerEmailAddressConfirmationFailed": {"customerID", "emailAddress", "reason"},
+	"CustomerEmailAddressChanged":            {"customerID", "emailAddress", "previousEmailAddress"},
+	"CustomerNameChanged":                    {"customerID", "givenName", "familyName"},
+	"CustomerAttributeSet":                   {"customerID", "attributeName", "attributeValue"},
+	"CustomerPasswordSet":                    {"customerID"},
+	"CustomerPasswordChanged":                {"customerID"},
+	"CustomerAccountRecoveryRequested":       {"customerID", "recoveryTokenExpiresAt"},
+	"CustomerAccountRecovered":               {"customerID"},
+	"CustomerMFAEnrollmentStarted":           {"customerID"},
+	"CustomerMFAEnrollmentConfirmed":         {"customerID"},
+	"CustomerMFACodeUsed":                    {"customerID", "timeStep"},
+	"CustomerMFARecoveryCodeUsed":            {"customerID"},
+	"CustomerMFADisabled":                    {"customerID"},
+	"CustomerDeleted":                        {"customerID", "emailAddress"},
+}
+
+type CustomerDataExportForJSON struct {
+	ExportedAt string                       `json:"exportedAt"`
+	Customer   CustomerViewForJSON          `json:"customer"`
+	Events     []CustomerEventExportForJSON `json:"events"`
+}
+
+type CustomerViewForJSON struct {
+	ID                      string            `json:"id"`
+	EmailAddress            string            `json:"emailAddress"`
+	IsEmailAddressConfirmed bool              `json:"isEmailAddressConfirmed"`
+	GivenName               string            `json:"givenName"`
+	FamilyName              string            `json:"familyName"`
+	CustomAttributes        map[string]string `json:"customAttributes"`
+	IsMFAEnabled            bool              `json:"isMFAEnabled"`
+	Version                 uint              `json:"version"`
+}
+
+type CustomerEventExportForJSON struct {
+	EventName     string                 `json:"eventName"`
+	OccurredAt    string                 `json:"occurredAt"`
+	StreamVersion uint                   `json:"streamVersion"`
+	Data          map[string]interface{} `json:"data"`
+}
+
+// MarshalCustomerDataExport renders a machine-readable JSON document without internal-only fields.
+// It uses encoding/json instead of jsoniter, because it renders the keys of the event data sorted.
+func MarshalCustomerDataExport(dataExport customer.DataExport) ([]byte, error) {
+	document, err := buildCustomerDataExportForJSON(dataExport)
+	if err != nil {
+		return nil, errors.Wrap(err, "MarshalCustomerDataExport")
+	}
+
+	marshaled, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrMarshalingFailed, "MarshalCustomerDataExport")
+	}
+
+	return marshaled, nil
+}
+
+// BundleCustomerDataExport renders the same data as MarshalCustomerDataExport as a ZIP archive
+// which contains customer.json (the current data) and events.json (the history).
+func BundleCustomerDataExport(dataExport customer.DataExport) ([]byte, error) {
+	wrapWithMsg := "BundleCustomerDataExport"
+
+	document, err := buildCustomerDataExportForJSON(dataExport)
+	if err != nil {
+		return nil, errors.Wrap(err, wrapWithMsg)
+	}
+
+	buffer := &bytes.Buffer{}
+	archive := zip.NewWriter(buffer)
+
+	files := []struct {
+		name    string
+		content interface{}
+	}{
+		{name: "customer.json", content: document.Customer},
+		{name: "events.json", content: document.Events},
+	}
+
+	for _, file := range files {
+		marshaled, err := json.MarshalIndent(file.content, "", "  ")
+		if err != nil {
+			return nil, shared.MarkAndWrapError(err, shared.ErrMarshalingFailed, wrapWithMsg)
+		}
+
+		writer, err := archive.CreateHeader(&zip.FileHeader{Name: file.name, Method: zip.Deflate, Modified: dataExport.ExportedAt})
+		if err == nil {
+			_, err = writer.Write(marshaled)
+		}
+
+		if err != nil {
+			return nil, shared.MarkAndWrapError(err, shared.ErrMarshalingFailed, wrapWithMsg)
+		}
+	}
+
+	if err = archive.Close(); err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrMarshalingFailed, wrapWithMsg)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func buildCustomerDataExportForJSON(dataExport customer.DataExport) (CustomerDataExportForJSON, error) {
+	view := dataExport.View
+
+	document := CustomerDataExportForJSON{
+		ExportedAt: dataExport.ExportedAt.UTC().Format(time.RFC3339),
+		Customer: CustomerViewForJSON{
+			ID:                      view.ID,
+			EmailAddress:            view.EmailAddress,
+			IsEmailAddressConfirmed: view.IsEmailAddressConfirmed,
+			GivenName:               view.GivenName,
+			FamilyName:              view.FamilyName,
+			CustomAttributes:        view.CustomAttributes,
+			IsMFAEnabled:            view.IsMFAEnabled,
+			Version:                 view.Version,
+		},
+		Events: make([]CustomerEventExportForJSON, 0, len(dataExport.EventStream)),
+	}
+
+	// the SecretCipher is only used for CustomerMFAEnrollmentStarted, which is not marshaled for exports
+	registry, err := NewCustomerEventRegistry(nil)
+	if err != nil {
+		return CustomerDataExportForJSON{}, err
+	}
+
+	for _, event := range dataExport.EventStream {
+		data, err := exportCustomerEventData(registry, event)
+		if err != nil {
+			return CustomerDataExportForJSON{}, err
+		}
+
+		document.Events = append(
+			document.Events,
+			CustomerEventExportForJSON{
+				EventName:     event.Meta().EventName(),
+				OccurredAt:    event.Meta().OccurredAt(),
+				StreamVersion: event.Meta().StreamVersion(),
+				Data:          data,
+			},
+		)
+	}
+
+	return document, nil
+}
+
+func exportCustomerEventData(registry *es.EventRegistry, event es.DomainEvent) (map[string]interface{}, error) {
+	var marshaled []byte
+	var err error
+	data := make(map[string]interface{})
+	wrapWithMsg := "exportCustomerEventData"
+
+	fields, known := exportedEventFields[event.Meta().EventName()]
+	if !known {
+		err = errors.Newf("event [%s] has no exported fields", event.Meta().EventName())
+		return nil, shared.MarkAndWrapError(err, shared.ErrMarshalingFailed, wrapWithMsg)
+	}
+
+	switch actualEvent := event.(type) {
+	case domain.CustomerMFAEnrollmentStarted:
+		// contains nothing but secrets besides the customerID, so it is not marshaled at all
+		data["customerID"] = actualEvent.CustomerID().String()
+
+		return data, nil
+	default:
+		if marshaled, err = registry.Marshal(event); err != nil {
+			return nil, err
+		}
+	}
+
+	payload := make(map[string]interface{})
+
+	if err = json.Unmarshal(marshaled, &payload); err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrMarshalingFailed, wrapWithMsg)
+	}
+
+	for _, field := range fields {
+		if fieldValue, ok := payload[field]; ok {
+			data[field] = fieldValue
+		}
+	}
+
+	return data, nil
+}
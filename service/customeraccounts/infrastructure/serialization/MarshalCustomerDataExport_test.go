@@ -0,0 +1,115 @@
+package serialization
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer"
+	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain/customer/value"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMarshalCustomerDataExport(t *testing.T) {
+	Convey("Given a DataExport with events which contain secrets", t, func() {
+		customerID := value.GenerateCustomerID()
+		emailAddress := value.RebuildEmailAddress("john@doe.com")
+		confirmationHash := value.GenerateConfirmationHash(emailAddress.String())
+		passwordHash := value.RebuildPasswordHash("$2a$12$someBcryptHash")
+		totpSecret, _ := value.GenerateTOTPSecret()
+		_, recoveryCodes, _ := value.GenerateRecoveryCodes()
+		_, recoveryToken, _ := value.GenerateAccountRecoveryToken(time.Now())
+
+		eventStream := es.EventStream{
+			domain.BuildCustomerRegistered(customerID, emailAddress, confirmationHash, value.RebuildPersonName("John", "Doe"), 1),
+			domain.BuildCustomerEmailAddressConfirmed(customerID, emailAddress, 2),
+			domain.BuildCustomerPasswordSet(customerID, passwordHash, 3),
+			domain.BuildCustomerAccountRecoveryRequested(customerID, recoveryToken, 4),
+			domain.BuildCustomerMFAEnrollmentStarted(customerID, totpSecret, recoveryCodes, 5),
+			domain.BuildCustomerMFARecoveryCodeUsed(customerID, recoveryCodes.Hashes()[0], 6),
+			domain.BuildCustomerMFACodeUsed(customerID, value.RebuildTOTPTimeStep("53875123"), 7),
+		}
+
+		dataExport := customer.DataExport{
+			View:        customer.BuildViewFrom(eventStream),
+			EventStream: eventStream,
+			ExportedAt:  time.Now(),
+		}
+
+		secrets := []string{
+			confirmationHash.String(),
+			passwordHash.String(),
+			totpSecret.String(),
+			recoveryCodes.Hashes()[0],
+			recoveryToken.Digest(),
+		}
+
+		Convey("When it is marshaled", func() {
+			json, err := MarshalCustomerDataExport(dataExport)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should contain the customer data and all events", func() {
+				So(string(json), ShouldContainSubstring, `"emailAddress": "john@doe.com"`)
+				So(string(json), ShouldContainSubstring, `"timeStep": "53875123"`)
+
+				for _, event := range eventStream {
+					So(string(json), ShouldContainSubstring, `"eventName": "`+event.Meta().EventName()+`"`)
+				}
+			})
+
+			Convey("And it should not contain any internal-only fields", func() {
+				for _, field := range []string{"confirmationHash", "passwordHash", "encryptedTOTPSecret", "recoveryCodeHash", "recoveryCodeHashes", "recoveryTokenDigest", "meta"} {
+					So(string(json), ShouldNotContainSubstring, `"`+field+`"`)
+				}
+
+				for _, secret := range secrets {
+					So(string(json), ShouldNotContainSubstring, secret)
+				}
+			})
+		})
+
+		Convey("When it is bundled", func() {
+			bundle, err := BundleCustomerDataExport(dataExport)
+			So(err, ShouldBeNil)
+
+			archive, err := zip.NewReader(bytes.NewReader(bundle), int64(len(bundle)))
+			So(err, ShouldBeNil)
+
+			Convey("Then it should contain the customer data and the events without secrets", func() {
+				So(archive.File, ShouldHaveLength, 2)
+				So(archive.File[0].Name, ShouldEqual, "customer.json")
+				So(archive.File[1].Name, ShouldEqual, "events.json")
+
+				for _, file := range archive.File {
+					reader, err := file.Open()
+					So(err, ShouldBeNil)
+					content, err := ioutil.ReadAll(reader)
+					So(err, ShouldBeNil)
+
+					for _, secret := range secrets {
+						So(string(content), ShouldNotContainSubstring, secret)
+					}
+				}
+			})
+		})
+	})
+}
+
+func TestExportedEventFields(t *testing.T) {
+	Convey("Given the registry of all Customer events", t, func() {
+		registry, err := NewCustomerEventRegistry(nil)
+		So(err, ShouldBeNil)
+
+		Convey("Then the exported fields should be defined for each registered event and nothing else", func() {
+			for _, eventName := range registry.EventNames() {
+				So(exportedEventFields, ShouldContainKey, eventName)
+			}
+
+			So(exportedEventFields, ShouldHaveLength, len(registry.EventNames()))
+		})
+	})
+}
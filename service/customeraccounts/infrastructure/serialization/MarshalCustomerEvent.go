@@ -2,123 +2,120 @@ package serialization
 
 import (
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
-	"github.com/AntonStoeckl/go-iddd/service/shared"
 	"github.com/AntonStoeckl/go-iddd/service/shared/es"
-	"github.com/cockroachdb/errors"
-	jsoniter "github.com/json-iterator/go"
+	"github.com/golang/protobuf/ptypes"
 )
 
-// MarshalCustomerEvent marshals every known Customer event to json.
-// It intentionally ignores marshaling errors, because they can't happen with the data types we are using.
-// We have a rich test suite which would catch such issues.
-func MarshalCustomerEvent(event es.DomainEvent) ([]byte, error) {
-	var err error
-	var json []byte
-
-	switch actualEvent := event.(type) {
-	case domain.CustomerRegistered:
-		json = marshalCustomerRegistered(actualEvent)
-	case domain.CustomerEmailAddressConfirmed:
-		json = marshalCustomerEmailAddressConfirmed(actualEvent)
-	case domain.CustomerEmailAddressConfirmationFailed:
-		json = marshalCustomerEmailAddressConfirmationFailed(actualEvent)
-	case domain.CustomerEmailAddressChanged:
-		json = marshalCustomerEmailAddressChanged(actualEvent)
-	case domain.CustomerNameChanged:
-		json = marshalCustomerNameChanged(actualEvent)
-	case domain.CustomerDeleted:
-		json = marshalCustomerDeleted(actualEvent)
-	default:
-		err = errors.Wrapf(errors.New("event is unknown"), "marshalCustomerEvent [%s] failed", event.Meta().EventName())
-		return nil, errors.Mark(err, shared.ErrMarshalingFailed)
+func customerEmailAddressConfirmationFailedToPayload(event es.DomainEvent) (interface{}, error) {
+	actualEvent := event.(domain.CustomerEmailAddressConfirmationFailed)
+
+	payload := &CustomerEmailAddressConfirmationFailedForJSON{
+		CustomerID:       actualEvent.CustomerID().String(),
+		EmailAddress:     actualEvent.EmailAddress().String(),
+		ConfirmationHash: actualEvent.ConfirmationHash().String(),
+		Reason:           actualEvent.FailureReason().Error(),
+		Meta:             marshalEventMeta(event),
 	}
 
-	return json, nil
+	return payload, nil
 }
 
-func marshalCustomerRegistered(event domain.CustomerRegistered) []byte {
+func customerAccountRecoveryRequestedToPayload(event es.DomainEvent) (interface{}, error) {
+	actualEvent := event.(domain.CustomerAccountRecoveryRequested)
 
-	data := CustomerRegisteredForJSON{
-		CustomerID:       event.CustomerID().String(),
-		EmailAddress:     event.EmailAddress().String(),
-		ConfirmationHash: event.ConfirmationHash().String(),
-		PersonGivenName:  event.PersonName().GivenName(),
-		PersonFamilyName: event.PersonName().FamilyName(),
-		Meta:             marshalEventMeta(event),
+	payload := &CustomerAccountRecoveryRequestedForJSON{
+		CustomerID:             actualEvent.CustomerID().String(),
+		RecoveryTokenDigest:    actualEvent.RecoveryToken().Digest(),
+		RecoveryTokenExpiresAt: actualEvent.RecoveryToken().ExpiresAt(),
+		Meta:                   marshalEventMeta(event),
 	}
 
-	json, _ := jsoniter.ConfigFastest.Marshal(data) // err intentionally ignored - see top comment
-
-	return json
+	return payload, nil
 }
 
-func marshalCustomerEmailAddressConfirmed(event domain.CustomerEmailAddressConfirmed) []byte {
-	data := CustomerEmailAddressConfirmedForJSON{
-		CustomerID:   event.CustomerID().String(),
-		EmailAddress: event.EmailAddress().String(),
-		Meta:         marshalEventMeta(event),
-	}
+// customerMFAEnrollmentStartedToPayloadWith encrypts the TOTP secret with the supplied SecretCipher.
+func customerMFAEnrollmentStartedToPayloadWith(secretCipher *SecretCipher) func(event es.DomainEvent) (interface{}, error) {
+	return func(event es.DomainEvent) (interface{}, error) {
+		actualEvent := event.(domain.CustomerMFAEnrollmentStarted)
 
-	json, _ := jsoniter.ConfigFastest.Marshal(data) // err intentionally ignored - see top comment
+		encryptedTOTPSecret, err := secretCipher.Encrypt(actualEvent.TOTPSecret().String(), actualEvent.CustomerID().String())
+		if err != nil {
+			return nil, err
+		}
 
-	return json
-}
+		payload := &CustomerMFAEnrollmentStartedForJSON{
+			CustomerID:          actualEvent.CustomerID().String(),
+			EncryptedTOTPSecret: encryptedTOTPSecret,
+			RecoveryCodeHashes:  actualEvent.RecoveryCodes().Hashes(),
+			Meta:                marshalEventMeta(event),
+		}
 
-func marshalCustomerEmailAddressConfirmationFailed(event domain.CustomerEmailAddressConfirmationFailed) []byte {
-	data := CustomerEmailAddressConfirmationFailedForJSON{
-		CustomerID:       event.CustomerID().String(),
-		EmailAddress:     event.EmailAddress().String(),
-		ConfirmationHash: event.ConfirmationHash().String(),
-		Reason:           event.FailureReason().Error(),
-		Meta:             marshalEventMeta(event),
+		return payload, nil
 	}
-
-	json, _ := jsoniter.ConfigFastest.Marshal(data) // err intentionally ignored - see top comment
-
-	return json
 }
 
-func marshalCustomerEmailAddressChanged(event domain.CustomerEmailAddressChanged) []byte {
-	data := CustomerEmailAddressChangedForJSON{
-		CustomerID:           event.CustomerID().String(),
-		EmailAddress:         event.EmailAddress().String(),
-		ConfirmationHash:     event.ConfirmationHash().String(),
-		PreviousEmailAddress: event.PreviousEmailAddress().String(),
-		Meta:                 marshalEventMeta(event),
+func marshalEventMeta(event es.DomainEvent) es.EventMetaForJSON {
+	return es.EventMetaForJSON{
+		EventName:  event.Meta().EventName(),
+		OccurredAt: event.Meta().OccurredAt(),
 	}
+}
+
+func customerEmailAddressConfirmationFailedToProtoPayload(event es.DomainEvent) (interface{}, error) {
+	actualEvent := event.(domain.CustomerEmailAddressConfirmationFailed)
 
-	json, _ := jsoniter.ConfigFastest.Marshal(data) // err intentionally ignored - see top comment
+	payload := &CustomerEmailAddressConfirmationFailedForProto{
+		Meta:             marshalEventMetaToProto(event),
+		CustomerID:       actualEvent.CustomerID().String(),
+		EmailAddress:     actualEvent.EmailAddress().String(),
+		ConfirmationHash: actualEvent.ConfirmationHash().String(),
+		Reason:           actualEvent.FailureReason().Error(),
+	}
 
-	return json
+	return payload, nil
 }
 
-func marshalCustomerNameChanged(event domain.CustomerNameChanged) []byte {
-	data := CustomerNameChangedForJSON{
-		CustomerID: event.CustomerID().String(),
-		GivenName:  event.PersonName().GivenName(),
-		FamilyName: event.PersonName().FamilyName(),
-		Meta:       marshalEventMeta(event),
+func customerAccountRecoveryRequestedToProtoPayload(event es.DomainEvent) (interface{}, error) {
+	actualEvent := event.(domain.CustomerAccountRecoveryRequested)
+
+	expiresAt, err := ptypes.TimestampProto(actualEvent.RecoveryToken().ExpiresAt())
+	if err != nil {
+		return nil, err
 	}
 
-	json, _ := jsoniter.ConfigFastest.Marshal(data) // err intentionally ignored - see top comment
+	payload := &CustomerAccountRecoveryRequestedForProto{
+		Meta:                   marshalEventMetaToProto(event),
+		CustomerID:             actualEvent.CustomerID().String(),
+		RecoveryTokenDigest:    actualEvent.RecoveryToken().Digest(),
+		RecoveryTokenExpiresAt: expiresAt,
+	}
 
-	return json
+	return payload, nil
 }
 
-func marshalCustomerDeleted(event domain.CustomerDeleted) []byte {
-	data := CustomerDeletedForJSON{
-		CustomerID:   event.CustomerID().String(),
-		EmailAddress: event.EmailAddress().String(),
-		Meta:         marshalEventMeta(event),
-	}
+// customerMFAEnrollmentStartedToProtoPayloadWith encrypts the TOTP secret with the supplied SecretCipher.
+func customerMFAEnrollmentStartedToProtoPayloadWith(secretCipher *SecretCipher) func(event es.DomainEvent) (interface{}, error) {
+	return func(event es.DomainEvent) (interface{}, error) {
+		actualEvent := event.(domain.CustomerMFAEnrollmentStarted)
+
+		encryptedTOTPSecret, err := secretCipher.Encrypt(actualEvent.TOTPSecret().String(), actualEvent.CustomerID().String())
+		if err != nil {
+			return nil, err
+		}
 
-	json, _ := jsoniter.ConfigFastest.Marshal(data) // err intentionally ignored - see top comment
+		payload := &CustomerMFAEnrollmentStartedForProto{
+			Meta:                marshalEventMetaToProto(event),
+			CustomerID:          actualEvent.CustomerID().String(),
+			EncryptedTOTPSecret: encryptedTOTPSecret,
+			RecoveryCodeHashes:  actualEvent.RecoveryCodes().Hashes(),
+		}
 
-	return json
+		return payload, nil
+	}
 }
 
-func marshalEventMeta(event es.DomainEvent) es.EventMetaForJSON {
-	return es.EventMetaForJSON{
+func marshalEventMetaToProto(event es.DomainEvent) *EventMetaForProto {
+	return &EventMetaForProto{
 		EventName:  event.Meta().EventName(),
 		OccurredAt: event.Meta().OccurredAt(),
 	}
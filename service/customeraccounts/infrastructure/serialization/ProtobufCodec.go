@@ -0,0 +1,27 @@
+package serialization
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+)
+
+// protobufCodec is the es.PayloadCodec for the *ForProto payloads.
+type protobufCodec struct{}
+
+func (codec protobufCodec) Marshal(payload interface{}) ([]byte, error) {
+	message, ok := payload.(proto.Message)
+	if !ok {
+		return nil, errors.Newf("payload of type %T is not a protobuf message", payload)
+	}
+
+	return proto.Marshal(message)
+}
+
+func (codec protobufCodec) Unmarshal(data []byte, payload interface{}) error {
+	message, ok := payload.(proto.Message)
+	if !ok {
+		return errors.Newf("payload of type %T is not a protobuf message", payload)
+	}
+
+	return proto.Unmarshal(data, message)
+}
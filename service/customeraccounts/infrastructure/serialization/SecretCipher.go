@@ -0,0 +1,84 @@
+package serialization
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+const secretCipherKeyLength = 32
+
+// SecretCipher encrypts secrets inside event payloads with AES-256-GCM.
+// The additional data (e.g. the customerID) binds a ciphertext to the event it belongs to.
+type SecretCipher struct {
+	aead cipher.AEAD
+}
+
+func NewSecretCipher(key []byte) (*SecretCipher, error) {
+	wrapWithMsg := "NewSecretCipher"
+
+	if len(key) != secretCipherKeyLength {
+		err := errors.Newf("key must have %d bytes", secretCipherKeyLength)
+		return nil, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	return &SecretCipher{aead: aead}, nil
+}
+
+func NewSecretCipherFromBase64(encodedKey string) (*SecretCipher, error) {
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, "NewSecretCipherFromBase64")
+	}
+
+	return NewSecretCipher(key)
+}
+
+func (c *SecretCipher) Encrypt(plaintext string, additionalData string) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+
+	if _, err := rand.Read(nonce); err != nil {
+		return "", shared.MarkAndWrapError(err, shared.ErrTechnical, "secretCipher.Encrypt")
+	}
+
+	sealed := c.aead.Seal(nonce, nonce, []byte(plaintext), []byte(additionalData))
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (c *SecretCipher) Decrypt(ciphertext string, additionalData string) (string, error) {
+	wrapWithMsg := "secretCipher.Decrypt"
+
+	sealed, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", shared.MarkAndWrapError(err, shared.ErrUnmarshalingFailed, wrapWithMsg)
+	}
+
+	if len(sealed) < c.aead.NonceSize() {
+		err = errors.New("ciphertext is too short")
+		return "", shared.MarkAndWrapError(err, shared.ErrUnmarshalingFailed, wrapWithMsg)
+	}
+
+	nonce, sealed := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+
+	plaintext, err := c.aead.Open(nil, nonce, sealed, []byte(additionalData))
+	if err != nil {
+		return "", shared.MarkAndWrapError(err, shared.ErrUnmarshalingFailed, wrapWithMsg)
+	}
+
+	return string(plaintext), nil
+}
@@ -2,164 +2,191 @@ package serialization
 
 import (
 	"github.com/AntonStoeckl/go-iddd/service/customeraccounts/hexagon/application/domain"
-	"github.com/AntonStoeckl/go-iddd/service/shared"
 	"github.com/AntonStoeckl/go-iddd/service/shared/es"
 	"github.com/cockroachdb/errors"
-	jsoniter "github.com/json-iterator/go"
+	"github.com/golang/protobuf/ptypes"
 )
 
-// UnmarshalCustomerEvent unmarshals every know Customer event.
-// It intentionally ignores unmarshaling errors, which could only happen if we would store invalid json to the EventStore.
-// We have a rich test suite which would catch such issues.
-func UnmarshalCustomerEvent(
-	name string,
-	payload []byte,
-	streamVersion uint,
-) (es.DomainEvent, error) {
-
-	var event es.DomainEvent
-
-	switch name {
-	case "CustomerRegistered":
-		event = unmarshalCustomerRegisteredFromJSON(payload, streamVersion)
-	case "CustomerEmailAddressConfirmed":
-		event = unmarshalCustomerEmailAddressConfirmedFromJSON(payload, streamVersion)
-	case "CustomerEmailAddressConfirmationFailed":
-		event = unmarshalCustomerEmailAddressConfirmationFailedFromJSON(payload, streamVersion)
-	case "CustomerEmailAddressChanged":
-		event = unmarshalCustomerEmailAddressChangedFromJSON(payload, streamVersion)
-	case "CustomerNameChanged":
-		event = unmarshalCustomerNameChangedFromJSON(payload, streamVersion)
-	case "CustomerDeleted":
-		event = unmarshalCustomerDeletedFromJSON(payload, streamVersion)
-	default:
-		err := errors.Wrapf(errors.New("event is unknown"), "unmarshalCustomerEvent [%s] failed", name)
-		return nil, errors.Mark(err, shared.ErrUnmarshalingFailed)
+type payloadField struct {
+	name  string
+	value string
+}
+
+// assertPayloadFieldsAreSet fails for the first required field which is missing or empty in the payload.
+// Without it a corrupt payload would be rebuilt into an event with empty values.
+func assertPayloadFieldsAreSet(fields ...payloadField) error {
+	for _, field := range fields {
+		if field.value == "" {
+			return errors.Newf("the payload field [%s] is missing or empty", field.name)
+		}
 	}
 
-	return event, nil
+	return nil
 }
 
-func unmarshalCustomerRegisteredFromJSON(
-	data []byte,
-	streamVersion uint,
-) domain.CustomerRegistered {
-
-	unmarshaledData := &CustomerRegisteredForJSON{}
+func rebuildCustomerEmailAddressConfirmationFailed(payload interface{}, streamVersion uint) (es.DomainEvent, error) {
+	unmarshaledData := payload.(*CustomerEmailAddressConfirmationFailedForJSON)
 
-	_ = jsoniter.ConfigFastest.Unmarshal(data, unmarshaledData) // err intentionally ignored - see top comment
+	if err := assertPayloadFieldsAreSet(
+		payloadField{"customerID", unmarshaledData.CustomerID},
+		payloadField{"emailAddress", unmarshaledData.EmailAddress},
+		payloadField{"confirmationHash", unmarshaledData.ConfirmationHash},
+		payloadField{"reason", unmarshaledData.Reason},
+	); err != nil {
+		return nil, err
+	}
 
-	event := domain.RebuildCustomerRegistered(
+	event := domain.RebuildCustomerEmailAddressConfirmationFailed(
 		unmarshaledData.CustomerID,
 		unmarshaledData.EmailAddress,
 		unmarshaledData.ConfirmationHash,
-		unmarshaledData.PersonGivenName,
-		unmarshaledData.PersonFamilyName,
+		unmarshaledData.Reason,
 		unmarshalEventMeta(unmarshaledData.Meta, streamVersion),
 	)
 
-	return event
+	return event, nil
 }
 
-func unmarshalCustomerEmailAddressConfirmedFromJSON(
-	data []byte,
-	streamVersion uint,
-) domain.CustomerEmailAddressConfirmed {
+func rebuildCustomerAccountRecoveryRequested(payload interface{}, streamVersion uint) (es.DomainEvent, error) {
+	unmarshaledData := payload.(*CustomerAccountRecoveryRequestedForJSON)
 
-	unmarshaledData := &CustomerEmailAddressConfirmedForJSON{}
+	if err := assertPayloadFieldsAreSet(
+		payloadField{"customerID", unmarshaledData.CustomerID},
+		payloadField{"recoveryTokenDigest", unmarshaledData.RecoveryTokenDigest},
+	); err != nil {
+		return nil, err
+	}
 
-	_ = jsoniter.ConfigFastest.Unmarshal(data, unmarshaledData) // err intentionally ignored - see top comment
+	if unmarshaledData.RecoveryTokenExpiresAt.IsZero() {
+		return nil, errors.New("the payload field [recoveryTokenExpiresAt] is missing or empty")
+	}
 
-	event := domain.RebuildCustomerEmailAddressConfirmed(
+	event := domain.RebuildCustomerAccountRecoveryRequested(
 		unmarshaledData.CustomerID,
-		unmarshaledData.EmailAddress,
+		unmarshaledData.RecoveryTokenDigest,
+		unmarshaledData.RecoveryTokenExpiresAt,
 		unmarshalEventMeta(unmarshaledData.Meta, streamVersion),
 	)
 
-	return event
+	return event, nil
 }
 
-func unmarshalCustomerEmailAddressConfirmationFailedFromJSON(
-	data []byte,
-	streamVersion uint,
-) domain.CustomerEmailAddressConfirmationFailed {
-
-	unmarshaledData := &CustomerEmailAddressConfirmationFailedForJSON{}
-
-	_ = jsoniter.ConfigFastest.Unmarshal(data, unmarshaledData) // err intentionally ignored - see top comment
+// rebuildCustomerMFAEnrollmentStartedWith decrypts the TOTP secret with the supplied SecretCipher.
+func rebuildCustomerMFAEnrollmentStartedWith(secretCipher *SecretCipher) func(payload interface{}, streamVersion uint) (es.DomainEvent, error) {
+	return func(payload interface{}, streamVersion uint) (es.DomainEvent, error) {
+		unmarshaledData := payload.(*CustomerMFAEnrollmentStartedForJSON)
+
+		if err := assertPayloadFieldsAreSet(
+			payloadField{"customerID", unmarshaledData.CustomerID},
+			payloadField{"encryptedTOTPSecret", unmarshaledData.EncryptedTOTPSecret},
+		); err != nil {
+			return nil, err
+		}
+
+		totpSecret, err := secretCipher.Decrypt(unmarshaledData.EncryptedTOTPSecret, unmarshaledData.CustomerID)
+		if err != nil {
+			return nil, err
+		}
+
+		event := domain.RebuildCustomerMFAEnrollmentStarted(
+			unmarshaledData.CustomerID,
+			totpSecret,
+			unmarshaledData.RecoveryCodeHashes,
+			unmarshalEventMeta(unmarshaledData.Meta, streamVersion),
+		)
+
+		return event, nil
+	}
+}
 
-	event := domain.RebuildCustomerEmailAddressConfirmationFailed(
-		unmarshaledData.CustomerID,
-		unmarshaledData.EmailAddress,
-		unmarshaledData.ConfirmationHash,
-		unmarshaledData.Reason,
-		unmarshalEventMeta(unmarshaledData.Meta, streamVersion),
+func unmarshalEventMeta(meta es.EventMetaForJSON, streamVersion uint) es.EventMeta {
+	return es.RebuildEventMeta(
+		meta.EventName,
+		meta.OccurredAt,
+		streamVersion,
 	)
-
-	return event
 }
 
-func unmarshalCustomerEmailAddressChangedFromJSON(
-	data []byte,
-	streamVersion uint,
-) domain.CustomerEmailAddressChanged {
-
-	unmarshaledData := &CustomerEmailAddressChangedForJSON{}
+func rebuildCustomerEmailAddressConfirmationFailedFromProto(payload interface{}, streamVersion uint) (es.DomainEvent, error) {
+	unmarshaledData := payload.(*CustomerEmailAddressConfirmationFailedForProto)
 
-	_ = jsoniter.ConfigFastest.Unmarshal(data, unmarshaledData) // err intentionally ignored - see top comment
+	if err := assertPayloadFieldsAreSet(
+		payloadField{"customerID", unmarshaledData.CustomerID},
+		payloadField{"emailAddress", unmarshaledData.EmailAddress},
+		payloadField{"confirmationHash", unmarshaledData.ConfirmationHash},
+		payloadField{"reason", unmarshaledData.Reason},
+	); err != nil {
+		return nil, err
+	}
 
-	event := domain.RebuildCustomerEmailAddressChanged(
+	event := domain.RebuildCustomerEmailAddressConfirmationFailed(
 		unmarshaledData.CustomerID,
 		unmarshaledData.EmailAddress,
 		unmarshaledData.ConfirmationHash,
-		unmarshaledData.PreviousEmailAddress,
-		unmarshalEventMeta(unmarshaledData.Meta, streamVersion),
+		unmarshaledData.Reason,
+		unmarshalEventMetaFromProto(unmarshaledData.Meta, streamVersion),
 	)
 
-	return event
+	return event, nil
 }
 
-func unmarshalCustomerNameChangedFromJSON(
-	data []byte,
-	streamVersion uint,
-) domain.CustomerNameChanged {
+func rebuildCustomerAccountRecoveryRequestedFromProto(payload interface{}, streamVersion uint) (es.DomainEvent, error) {
+	unmarshaledData := payload.(*CustomerAccountRecoveryRequestedForProto)
 
-	unmarshaledData := &CustomerNameChangedForJSON{}
+	if err := assertPayloadFieldsAreSet(
+		payloadField{"customerID", unmarshaledData.CustomerID},
+		payloadField{"recoveryTokenDigest", unmarshaledData.RecoveryTokenDigest},
+	); err != nil {
+		return nil, err
+	}
 
-	_ = jsoniter.ConfigFastest.Unmarshal(data, unmarshaledData) // err intentionally ignored - see top comment
+	expiresAt, err := ptypes.Timestamp(unmarshaledData.RecoveryTokenExpiresAt)
+	if err != nil {
+		return nil, err
+	}
 
-	event := domain.RebuildCustomerNameChanged(
+	event := domain.RebuildCustomerAccountRecoveryRequested(
 		unmarshaledData.CustomerID,
-		unmarshaledData.GivenName,
-		unmarshaledData.FamilyName,
-		unmarshalEventMeta(unmarshaledData.Meta, streamVersion),
+		unmarshaledData.RecoveryTokenDigest,
+		expiresAt,
+		unmarshalEventMetaFromProto(unmarshaledData.Meta, streamVersion),
 	)
 
-	return event
+	return event, nil
 }
 
-func unmarshalCustomerDeletedFromJSON(
-	data []byte,
-	streamVersion uint,
-) domain.CustomerDeleted {
-
-	unmarshaledData := &CustomerDeletedForJSON{}
-
-	_ = jsoniter.ConfigFastest.Unmarshal(data, unmarshaledData) // err intentionally ignored - see top comment
-
-	event := domain.RebuildCustomerDeleted(
-		unmarshaledData.CustomerID,
-		unmarshaledData.EmailAddress,
-		unmarshalEventMeta(unmarshaledData.Meta, streamVersion),
-	)
-
-	return event
+// rebuildCustomerMFAEnrollmentStartedFromProtoWith decrypts the TOTP secret with the supplied SecretCipher.
+func rebuildCustomerMFAEnrollmentStartedFromProtoWith(secretCipher *SecretCipher) func(payload interface{}, streamVersion uint) (es.DomainEvent, error) {
+	return func(payload interface{}, streamVersion uint) (es.DomainEvent, error) {
+		unmarshaledData := payload.(*CustomerMFAEnrollmentStartedForProto)
+
+		if err := assertPayloadFieldsAreSet(
+			payloadField{"customerID", unmarshaledData.CustomerID},
+			payloadField{"encryptedTOTPSecret", unmarshaledData.EncryptedTOTPSecret},
+		); err != nil {
+			return nil, err
+		}
+
+		totpSecret, err := secretCipher.Decrypt(unmarshaledData.EncryptedTOTPSecret, unmarshaledData.CustomerID)
+		if err != nil {
+			return nil, err
+		}
+
+		event := domain.RebuildCustomerMFAEnrollmentStarted(
+			unmarshaledData.CustomerID,
+			totpSecret,
+			unmarshaledData.RecoveryCodeHashes,
+			unmarshalEventMetaFromProto(unmarshaledData.Meta, streamVersion),
+		)
+
+		return event, nil
+	}
 }
 
-func unmarshalEventMeta(meta es.EventMetaForJSON, streamVersion uint) es.EventMeta {
+func unmarshalEventMetaFromProto(meta *EventMetaForProto, streamVersion uint) es.EventMeta {
 	return es.RebuildEventMeta(
-		meta.EventName,
-		meta.OccurredAt,
+		meta.GetEventName(),
+		meta.GetOccurredAt(),
 		streamVersion,
 	)
 }
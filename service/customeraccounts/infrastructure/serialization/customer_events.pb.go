@@ -0,0 +1,261 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: customer_events.proto
+
+package serialization
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type CustomerEmailAddressConfirmationFailedForProto struct {
+	Meta                 *EventMetaForProto `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	CustomerID           string             `protobuf:"bytes,2,opt,name=customerID,proto3" json:"customerID,omitempty"`
+	EmailAddress         string             `protobuf:"bytes,3,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	ConfirmationHash     string             `protobuf:"bytes,4,opt,name=confirmationHash,proto3" json:"confirmationHash,omitempty"`
+	Reason               string             `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CustomerEmailAddressConfirmationFailedForProto) Reset() {
+	*m = CustomerEmailAddressConfirmationFailedForProto{}
+}
+func (m *CustomerEmailAddressConfirmationFailedForProto) String() string {
+	return proto.CompactTextString(m)
+}
+func (*CustomerEmailAddressConfirmationFailedForProto) ProtoMessage() {}
+func (*CustomerEmailAddressConfirmationFailedForProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72ae4d8c9026e522, []int{0}
+}
+
+func (m *CustomerEmailAddressConfirmationFailedForProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomerEmailAddressConfirmationFailedForProto.Unmarshal(m, b)
+}
+func (m *CustomerEmailAddressConfirmationFailedForProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomerEmailAddressConfirmationFailedForProto.Marshal(b, m, deterministic)
+}
+func (m *CustomerEmailAddressConfirmationFailedForProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomerEmailAddressConfirmationFailedForProto.Merge(m, src)
+}
+func (m *CustomerEmailAddressConfirmationFailedForProto) XXX_Size() int {
+	return xxx_messageInfo_CustomerEmailAddressConfirmationFailedForProto.Size(m)
+}
+func (m *CustomerEmailAddressConfirmationFailedForProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomerEmailAddressConfirmationFailedForProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomerEmailAddressConfirmationFailedForProto proto.InternalMessageInfo
+
+func (m *CustomerEmailAddressConfirmationFailedForProto) GetMeta() *EventMetaForProto {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *CustomerEmailAddressConfirmationFailedForProto) GetCustomerID() string {
+	if m != nil {
+		return m.CustomerID
+	}
+	return ""
+}
+
+func (m *CustomerEmailAddressConfirmationFailedForProto) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
+}
+
+func (m *CustomerEmailAddressConfirmationFailedForProto) GetConfirmationHash() string {
+	if m != nil {
+		return m.ConfirmationHash
+	}
+	return ""
+}
+
+func (m *CustomerEmailAddressConfirmationFailedForProto) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type CustomerAccountRecoveryRequestedForProto struct {
+	Meta                   *EventMetaForProto   `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	CustomerID             string               `protobuf:"bytes,2,opt,name=customerID,proto3" json:"customerID,omitempty"`
+	RecoveryTokenDigest    string               `protobuf:"bytes,3,opt,name=recoveryTokenDigest,proto3" json:"recoveryTokenDigest,omitempty"`
+	RecoveryTokenExpiresAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=recoveryTokenExpiresAt,proto3" json:"recoveryTokenExpiresAt,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}             `json:"-"`
+	XXX_unrecognized       []byte               `json:"-"`
+	XXX_sizecache          int32                `json:"-"`
+}
+
+func (m *CustomerAccountRecoveryRequestedForProto) Reset() {
+	*m = CustomerAccountRecoveryRequestedForProto{}
+}
+func (m *CustomerAccountRecoveryRequestedForProto) String() string { return proto.CompactTextString(m) }
+func (*CustomerAccountRecoveryRequestedForProto) ProtoMessage()    {}
+func (*CustomerAccountRecoveryRequestedForProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72ae4d8c9026e522, []int{1}
+}
+
+func (m *CustomerAccountRecoveryRequestedForProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomerAccountRecoveryRequestedForProto.Unmarshal(m, b)
+}
+func (m *CustomerAccountRecoveryRequestedForProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomerAccountRecoveryRequestedForProto.Marshal(b, m, deterministic)
+}
+func (m *CustomerAccountRecoveryRequestedForProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomerAccountRecoveryRequestedForProto.Merge(m, src)
+}
+func (m *CustomerAccountRecoveryRequestedForProto) XXX_Size() int {
+	return xxx_messageInfo_CustomerAccountRecoveryRequestedForProto.Size(m)
+}
+func (m *CustomerAccountRecoveryRequestedForProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomerAccountRecoveryRequestedForProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomerAccountRecoveryRequestedForProto proto.InternalMessageInfo
+
+func (m *CustomerAccountRecoveryRequestedForProto) GetMeta() *EventMetaForProto {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *CustomerAccountRecoveryRequestedForProto) GetCustomerID() string {
+	if m != nil {
+		return m.CustomerID
+	}
+	return ""
+}
+
+func (m *CustomerAccountRecoveryRequestedForProto) GetRecoveryTokenDigest() string {
+	if m != nil {
+		return m.RecoveryTokenDigest
+	}
+	return ""
+}
+
+func (m *CustomerAccountRecoveryRequestedForProto) GetRecoveryTokenExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.RecoveryTokenExpiresAt
+	}
+	return nil
+}
+
+type CustomerMFAEnrollmentStartedForProto struct {
+	Meta                 *EventMetaForProto `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	CustomerID           string             `protobuf:"bytes,2,opt,name=customerID,proto3" json:"customerID,omitempty"`
+	EncryptedTOTPSecret  string             `protobuf:"bytes,3,opt,name=encryptedTOTPSecret,proto3" json:"encryptedTOTPSecret,omitempty"`
+	RecoveryCodeHashes   []string           `protobuf:"bytes,4,rep,name=recoveryCodeHashes,proto3" json:"recoveryCodeHashes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CustomerMFAEnrollmentStartedForProto) Reset()         { *m = CustomerMFAEnrollmentStartedForProto{} }
+func (m *CustomerMFAEnrollmentStartedForProto) String() string { return proto.CompactTextString(m) }
+func (*CustomerMFAEnrollmentStartedForProto) ProtoMessage()    {}
+func (*CustomerMFAEnrollmentStartedForProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72ae4d8c9026e522, []int{2}
+}
+
+func (m *CustomerMFAEnrollmentStartedForProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomerMFAEnrollmentStartedForProto.Unmarshal(m, b)
+}
+func (m *CustomerMFAEnrollmentStartedForProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomerMFAEnrollmentStartedForProto.Marshal(b, m, deterministic)
+}
+func (m *CustomerMFAEnrollmentStartedForProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomerMFAEnrollmentStartedForProto.Merge(m, src)
+}
+func (m *CustomerMFAEnrollmentStartedForProto) XXX_Size() int {
+	return xxx_messageInfo_CustomerMFAEnrollmentStartedForProto.Size(m)
+}
+func (m *CustomerMFAEnrollmentStartedForProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomerMFAEnrollmentStartedForProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomerMFAEnrollmentStartedForProto proto.InternalMessageInfo
+
+func (m *CustomerMFAEnrollmentStartedForProto) GetMeta() *EventMetaForProto {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *CustomerMFAEnrollmentStartedForProto) GetCustomerID() string {
+	if m != nil {
+		return m.CustomerID
+	}
+	return ""
+}
+
+func (m *CustomerMFAEnrollmentStartedForProto) GetEncryptedTOTPSecret() string {
+	if m != nil {
+		return m.EncryptedTOTPSecret
+	}
+	return ""
+}
+
+func (m *CustomerMFAEnrollmentStartedForProto) GetRecoveryCodeHashes() []string {
+	if m != nil {
+		return m.RecoveryCodeHashes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CustomerEmailAddressConfirmationFailedForProto)(nil), "customerevents.CustomerEmailAddressConfirmationFailedForProto")
+	proto.RegisterType((*CustomerAccountRecoveryRequestedForProto)(nil), "customerevents.CustomerAccountRecoveryRequestedForProto")
+	proto.RegisterType((*CustomerMFAEnrollmentStartedForProto)(nil), "customerevents.CustomerMFAEnrollmentStartedForProto")
+}
+
+func init() { proto.RegisterFile("customer_events.proto", fileDescriptor_72ae4d8c9026e522) }
+
+var fileDescriptor_72ae4d8c9026e522 = []byte{
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x52, 0xdd, 0x6e, 0xda, 0x30,
+	0x18, 0x55, 0x80, 0x21, 0x61, 0xf6, 0x83, 0x3c, 0x0d, 0x45, 0x5c, 0x6c, 0x2c, 0xda, 0x45, 0xb4,
+	0x0b, 0x33, 0x31, 0xed, 0x01, 0x32, 0x08, 0xda, 0x2e, 0xd0, 0x50, 0xc8, 0xd5, 0x6e, 0x90, 0x49,
+	0x3e, 0xa8, 0xd5, 0xc4, 0x4e, 0x6d, 0x07, 0x95, 0xbe, 0x40, 0x1f, 0xb3, 0x8f, 0xd0, 0x57, 0xa8,
+	0x70, 0xe2, 0x0a, 0x54, 0x7a, 0xd9, 0x5e, 0x45, 0x39, 0xe7, 0x7c, 0xf6, 0x77, 0xce, 0x31, 0xfa,
+	0x94, 0x94, 0x4a, 0x8b, 0x1c, 0xe4, 0x0a, 0x76, 0xc0, 0xb5, 0x22, 0x85, 0x14, 0x5a, 0xe0, 0xf7,
+	0x16, 0xae, 0xd0, 0xc1, 0x97, 0xad, 0x10, 0xdb, 0x0c, 0x46, 0x86, 0x5d, 0x97, 0x9b, 0x91, 0x66,
+	0x39, 0x28, 0x4d, 0xf3, 0xa2, 0x1a, 0x18, 0xf4, 0x8c, 0x70, 0x95, 0x83, 0xa6, 0x15, 0xe2, 0xdd,
+	0x3b, 0x88, 0x4c, 0xea, 0x53, 0xc2, 0x9c, 0xb2, 0x2c, 0x48, 0x53, 0x09, 0x4a, 0x4d, 0x04, 0xdf,
+	0x30, 0x99, 0x53, 0xcd, 0x04, 0x9f, 0x51, 0x96, 0x41, 0x3a, 0x13, 0x72, 0x61, 0x6e, 0xfd, 0x85,
+	0x5a, 0x87, 0x03, 0x5c, 0x67, 0xe8, 0xf8, 0xdd, 0xf1, 0x57, 0x72, 0xba, 0x04, 0x09, 0x0f, 0x9f,
+	0x39, 0x68, 0x6a, 0x07, 0x22, 0x23, 0xc7, 0x9f, 0x11, 0xb2, 0xca, 0xbf, 0x53, 0xb7, 0x31, 0x74,
+	0xfc, 0x4e, 0x74, 0x84, 0x60, 0x0f, 0xbd, 0x85, 0xa3, 0x05, 0xdc, 0xa6, 0x51, 0x9c, 0x60, 0xf8,
+	0x3b, 0xea, 0x25, 0x47, 0x8b, 0xfd, 0xa1, 0xea, 0xc2, 0x6d, 0x19, 0xdd, 0x13, 0x1c, 0xf7, 0x51,
+	0x5b, 0x02, 0x55, 0x82, 0xbb, 0x6f, 0x8c, 0xa2, 0xfe, 0xf3, 0x6e, 0x1b, 0xc8, 0xb7, 0x8e, 0x83,
+	0x24, 0x11, 0x25, 0xd7, 0x11, 0x24, 0x62, 0x07, 0x72, 0x1f, 0xc1, 0x55, 0x09, 0x4a, 0xbf, 0xbc,
+	0xd7, 0x1f, 0xe8, 0xa3, 0xac, 0xef, 0x8c, 0xc5, 0x25, 0xf0, 0x29, 0xdb, 0x82, 0xd2, 0xb5, 0xe5,
+	0x73, 0x14, 0x8e, 0x50, 0xff, 0x04, 0x0e, 0xaf, 0x0b, 0x26, 0x41, 0x05, 0xda, 0xf8, 0xef, 0x8e,
+	0x07, 0xa4, 0xea, 0x9e, 0xd8, 0xee, 0x49, 0x6c, 0xbb, 0x8f, 0x9e, 0x99, 0xf4, 0xee, 0x1c, 0xf4,
+	0xcd, 0x26, 0x31, 0x9f, 0x05, 0x21, 0x97, 0x22, 0xcb, 0x72, 0xe0, 0x7a, 0xa9, 0xa9, 0x7c, 0x9d,
+	0x14, 0x80, 0x27, 0x72, 0x5f, 0x68, 0x48, 0xe3, 0x7f, 0xf1, 0x62, 0x09, 0x89, 0x84, 0xc7, 0x14,
+	0xce, 0x50, 0x98, 0x20, 0x6c, 0xbd, 0x4c, 0x44, 0x0a, 0x87, 0x9e, 0x41, 0xb9, 0xad, 0x61, 0xd3,
+	0xef, 0x44, 0x67, 0x98, 0xdf, 0x1f, 0xfe, 0xbf, 0x53, 0x20, 0x19, 0xcd, 0xd8, 0x8d, 0x79, 0x18,
+	0xeb, 0xb6, 0x89, 0xe7, 0xe7, 0xc3, 0x00, 0x49, 0x81, 0x63, 0x13, 0x51, 0x03, 0x00, 0x00,
+}
@@ -0,0 +1,86 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: event_meta.proto
+
+package serialization
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type EventMetaForProto struct {
+	EventName            string   `protobuf:"bytes,1,opt,name=eventName,proto3" json:"eventName,omitempty"`
+	OccurredAt           string   `protobuf:"bytes,2,opt,name=occurredAt,proto3" json:"occurredAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventMetaForProto) Reset()         { *m = EventMetaForProto{} }
+func (m *EventMetaForProto) String() string { return proto.CompactTextString(m) }
+func (*EventMetaForProto) ProtoMessage()    {}
+func (*EventMetaForProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf0a09390c230e02, []int{0}
+}
+
+func (m *EventMetaForProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventMetaForProto.Unmarshal(m, b)
+}
+func (m *EventMetaForProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventMetaForProto.Marshal(b, m, deterministic)
+}
+func (m *EventMetaForProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMetaForProto.Merge(m, src)
+}
+func (m *EventMetaForProto) XXX_Size() int {
+	return xxx_messageInfo_EventMetaForProto.Size(m)
+}
+func (m *EventMetaForProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMetaForProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMetaForProto proto.InternalMessageInfo
+
+func (m *EventMetaForProto) GetEventName() string {
+	if m != nil {
+		return m.EventName
+	}
+	return ""
+}
+
+func (m *EventMetaForProto) GetOccurredAt() string {
+	if m != nil {
+		return m.OccurredAt
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventMetaForProto)(nil), "customerevents.EventMetaForProto")
+}
+
+func init() { proto.RegisterFile("event_meta.proto", fileDescriptor_bf0a09390c230e02) }
+
+var fileDescriptor_bf0a09390c230e02 = []byte{
+	// 128 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x48, 0x2d, 0x4b, 0xcd,
+	0x2b, 0x89, 0xcf, 0x4d, 0x2d, 0x49, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x4b, 0x2e,
+	0x2d, 0x2e, 0xc9, 0xcf, 0x4d, 0x2d, 0x02, 0xcb, 0x14, 0x2b, 0x05, 0x72, 0x09, 0xba, 0x82, 0x58,
+	0xbe, 0xa9, 0x25, 0x89, 0x6e, 0xf9, 0x45, 0x01, 0x60, 0x45, 0x32, 0x5c, 0x9c, 0x60, 0x69, 0xbf,
+	0xc4, 0xdc, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x84, 0x80, 0x90, 0x1c, 0x17, 0x57,
+	0x7e, 0x72, 0x72, 0x69, 0x51, 0x51, 0x6a, 0x8a, 0x63, 0x89, 0x04, 0x13, 0x58, 0x1a, 0x49, 0xc4,
+	0x89, 0x3f, 0x8a, 0xb7, 0x38, 0xb5, 0x28, 0x33, 0x31, 0x27, 0xb3, 0x2a, 0xb1, 0x24, 0x33, 0x3f,
+	0x2f, 0x89, 0x0d, 0x6c, 0xb5, 0x31, 0x60, 0x00, 0xdb, 0x22, 0x0b, 0x49, 0x8e, 0x00, 0x00, 0x00,
+}
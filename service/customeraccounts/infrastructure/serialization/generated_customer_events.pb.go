@@ -0,0 +1,833 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: generated_customer_events.proto
+
+package serialization
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type CustomerRegisteredForProto struct {
+	Meta                 *EventMetaForProto `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	CustomerID           string             `protobuf:"bytes,2,opt,name=customerID,proto3" json:"customerID,omitempty"`
+	EmailAddress         string             `protobuf:"bytes,3,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	ConfirmationHash     string             `protobuf:"bytes,4,opt,name=confirmationHash,proto3" json:"confirmationHash,omitempty"`
+	PersonGivenName      string             `protobuf:"bytes,5,opt,name=personGivenName,proto3" json:"personGivenName,omitempty"`
+	PersonFamilyName     string             `protobuf:"bytes,6,opt,name=personFamilyName,proto3" json:"personFamilyName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CustomerRegisteredForProto) Reset()         { *m = CustomerRegisteredForProto{} }
+func (m *CustomerRegisteredForProto) String() string { return proto.CompactTextString(m) }
+func (*CustomerRegisteredForProto) ProtoMessage()    {}
+func (*CustomerRegisteredForProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72355003b2279c5c, []int{0}
+}
+
+func (m *CustomerRegisteredForProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomerRegisteredForProto.Unmarshal(m, b)
+}
+func (m *CustomerRegisteredForProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomerRegisteredForProto.Marshal(b, m, deterministic)
+}
+func (m *CustomerRegisteredForProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomerRegisteredForProto.Merge(m, src)
+}
+func (m *CustomerRegisteredForProto) XXX_Size() int {
+	return xxx_messageInfo_CustomerRegisteredForProto.Size(m)
+}
+func (m *CustomerRegisteredForProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomerRegisteredForProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomerRegisteredForProto proto.InternalMessageInfo
+
+func (m *CustomerRegisteredForProto) GetMeta() *EventMetaForProto {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *CustomerRegisteredForProto) GetCustomerID() string {
+	if m != nil {
+		return m.CustomerID
+	}
+	return ""
+}
+
+func (m *CustomerRegisteredForProto) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
+}
+
+func (m *CustomerRegisteredForProto) GetConfirmationHash() string {
+	if m != nil {
+		return m.ConfirmationHash
+	}
+	return ""
+}
+
+func (m *CustomerRegisteredForProto) GetPersonGivenName() string {
+	if m != nil {
+		return m.PersonGivenName
+	}
+	return ""
+}
+
+func (m *CustomerRegisteredForProto) GetPersonFamilyName() string {
+	if m != nil {
+		return m.PersonFamilyName
+	}
+	return ""
+}
+
+type CustomerEmailAddressConfirmedForProto struct {
+	Meta                 *EventMetaForProto `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	CustomerID           string             `protobuf:"bytes,2,opt,name=customerID,proto3" json:"customerID,omitempty"`
+	EmailAddress         string             `protobuf:"bytes,3,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CustomerEmailAddressConfirmedForProto) Reset()         { *m = CustomerEmailAddressConfirmedForProto{} }
+func (m *CustomerEmailAddressConfirmedForProto) String() string { return proto.CompactTextString(m) }
+func (*CustomerEmailAddressConfirmedForProto) ProtoMessage()    {}
+func (*CustomerEmailAddressConfirmedForProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72355003b2279c5c, []int{1}
+}
+
+func (m *CustomerEmailAddressConfirmedForProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomerEmailAddressConfirmedForProto.Unmarshal(m, b)
+}
+func (m *CustomerEmailAddressConfirmedForProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomerEmailAddressConfirmedForProto.Marshal(b, m, deterministic)
+}
+func (m *CustomerEmailAddressConfirmedForProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomerEmailAddressConfirmedForProto.Merge(m, src)
+}
+func (m *CustomerEmailAddressConfirmedForProto) XXX_Size() int {
+	return xxx_messageInfo_CustomerEmailAddressConfirmedForProto.Size(m)
+}
+func (m *CustomerEmailAddressConfirmedForProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomerEmailAddressConfirmedForProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomerEmailAddressConfirmedForProto proto.InternalMessageInfo
+
+func (m *CustomerEmailAddressConfirmedForProto) GetMeta() *EventMetaForProto {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *CustomerEmailAddressConfirmedForProto) GetCustomerID() string {
+	if m != nil {
+		return m.CustomerID
+	}
+	return ""
+}
+
+func (m *CustomerEmailAddressConfirmedForProto) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
+}
+
+type CustomerEmailAddressChangedForProto struct {
+	Meta                 *EventMetaForProto `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	CustomerID           string             `protobuf:"bytes,2,opt,name=customerID,proto3" json:"customerID,omitempty"`
+	EmailAddress         string             `protobuf:"bytes,3,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	ConfirmationHash     string             `protobuf:"bytes,4,opt,name=confirmationHash,proto3" json:"confirmationHash,omitempty"`
+	PreviousEmailAddress string             `protobuf:"bytes,5,opt,name=previousEmailAddress,proto3" json:"previousEmailAddress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CustomerEmailAddressChangedForProto) Reset()         { *m = CustomerEmailAddressChangedForProto{} }
+func (m *CustomerEmailAddressChangedForProto) String() string { return proto.CompactTextString(m) }
+func (*CustomerEmailAddressChangedForProto) ProtoMessage()    {}
+func (*CustomerEmailAddressChangedForProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72355003b2279c5c, []int{2}
+}
+
+func (m *CustomerEmailAddressChangedForProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomerEmailAddressChangedForProto.Unmarshal(m, b)
+}
+func (m *CustomerEmailAddressChangedForProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomerEmailAddressChangedForProto.Marshal(b, m, deterministic)
+}
+func (m *CustomerEmailAddressChangedForProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomerEmailAddressChangedForProto.Merge(m, src)
+}
+func (m *CustomerEmailAddressChangedForProto) XXX_Size() int {
+	return xxx_messageInfo_CustomerEmailAddressChangedForProto.Size(m)
+}
+func (m *CustomerEmailAddressChangedForProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomerEmailAddressChangedForProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomerEmailAddressChangedForProto proto.InternalMessageInfo
+
+func (m *CustomerEmailAddressChangedForProto) GetMeta() *EventMetaForProto {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *CustomerEmailAddressChangedForProto) GetCustomerID() string {
+	if m != nil {
+		return m.CustomerID
+	}
+	return ""
+}
+
+func (m *CustomerEmailAddressChangedForProto) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
+}
+
+func (m *CustomerEmailAddressChangedForProto) GetConfirmationHash() string {
+	if m != nil {
+		return m.ConfirmationHash
+	}
+	return ""
+}
+
+func (m *CustomerEmailAddressChangedForProto) GetPreviousEmailAddress() string {
+	if m != nil {
+		return m.PreviousEmailAddress
+	}
+	return ""
+}
+
+type CustomerNameChangedForProto struct {
+	Meta                 *EventMetaForProto `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	CustomerID           string             `protobuf:"bytes,2,opt,name=customerID,proto3" json:"customerID,omitempty"`
+	GivenName            string             `protobuf:"bytes,3,opt,name=givenName,proto3" json:"givenName,omitempty"`
+	FamilyName           string             `protobuf:"bytes,4,opt,name=familyName,proto3" json:"familyName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CustomerNameChangedForProto) Reset()         { *m = CustomerNameChangedForProto{} }
+func (m *CustomerNameChangedForProto) String() string { return proto.CompactTextString(m) }
+func (*CustomerNameChangedForProto) ProtoMessage()    {}
+func (*CustomerNameChangedForProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72355003b2279c5c, []int{3}
+}
+
+func (m *CustomerNameChangedForProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomerNameChangedForProto.Unmarshal(m, b)
+}
+func (m *CustomerNameChangedForProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomerNameChangedForProto.Marshal(b, m, deterministic)
+}
+func (m *CustomerNameChangedForProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomerNameChangedForProto.Merge(m, src)
+}
+func (m *CustomerNameChangedForProto) XXX_Size() int {
+	return xxx_messageInfo_CustomerNameChangedForProto.Size(m)
+}
+func (m *CustomerNameChangedForProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomerNameChangedForProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomerNameChangedForProto proto.InternalMessageInfo
+
+func (m *CustomerNameChangedForProto) GetMeta() *EventMetaForProto {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *CustomerNameChangedForProto) GetCustomerID() string {
+	if m != nil {
+		return m.CustomerID
+	}
+	return ""
+}
+
+func (m *CustomerNameChangedForProto) GetGivenName() string {
+	if m != nil {
+		return m.GivenName
+	}
+	return ""
+}
+
+func (m *CustomerNameChangedForProto) GetFamilyName() string {
+	if m != nil {
+		return m.FamilyName
+	}
+	return ""
+}
+
+type CustomerAttributeSetForProto struct {
+	Meta                 *EventMetaForProto `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	CustomerID           string             `protobuf:"bytes,2,opt,name=customerID,proto3" json:"customerID,omitempty"`
+	AttributeName        string             `protobuf:"bytes,3,opt,name=attributeName,proto3" json:"attributeName,omitempty"`
+	AttributeValue       string             `protobuf:"bytes,4,opt,name=attributeValue,proto3" json:"attributeValue,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CustomerAttributeSetForProto) Reset()         { *m = CustomerAttributeSetForProto{} }
+func (m *CustomerAttributeSetForProto) String() string { return proto.CompactTextString(m) }
+func (*CustomerAttributeSetForProto) ProtoMessage()    {}
+func (*CustomerAttributeSetForProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72355003b2279c5c, []int{4}
+}
+
+func (m *CustomerAttributeSetForProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomerAttributeSetForProto.Unmarshal(m, b)
+}
+func (m *CustomerAttributeSetForProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomerAttributeSetForProto.Marshal(b, m, deterministic)
+}
+func (m *CustomerAttributeSetForProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomerAttributeSetForProto.Merge(m, src)
+}
+func (m *CustomerAttributeSetForProto) XXX_Size() int {
+	return xxx_messageInfo_CustomerAttributeSetForProto.Size(m)
+}
+func (m *CustomerAttributeSetForProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomerAttributeSetForProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomerAttributeSetForProto proto.InternalMessageInfo
+
+func (m *CustomerAttributeSetForProto) GetMeta() *EventMetaForProto {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *CustomerAttributeSetForProto) GetCustomerID() string {
+	if m != nil {
+		return m.CustomerID
+	}
+	return ""
+}
+
+func (m *CustomerAttributeSetForProto) GetAttributeName() string {
+	if m != nil {
+		return m.AttributeName
+	}
+	return ""
+}
+
+func (m *CustomerAttributeSetForProto) GetAttributeValue() string {
+	if m != nil {
+		return m.AttributeValue
+	}
+	return ""
+}
+
+type CustomerPasswordSetForProto struct {
+	Meta                 *EventMetaForProto `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	CustomerID           string             `protobuf:"bytes,2,opt,name=customerID,proto3" json:"customerID,omitempty"`
+	PasswordHash         string             `protobuf:"bytes,3,opt,name=passwordHash,proto3" json:"passwordHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CustomerPasswordSetForProto) Reset()         { *m = CustomerPasswordSetForProto{} }
+func (m *CustomerPasswordSetForProto) String() string { return proto.CompactTextString(m) }
+func (*CustomerPasswordSetForProto) ProtoMessage()    {}
+func (*CustomerPasswordSetForProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72355003b2279c5c, []int{5}
+}
+
+func (m *CustomerPasswordSetForProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomerPasswordSetForProto.Unmarshal(m, b)
+}
+func (m *CustomerPasswordSetForProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomerPasswordSetForProto.Marshal(b, m, deterministic)
+}
+func (m *CustomerPasswordSetForProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomerPasswordSetForProto.Merge(m, src)
+}
+func (m *CustomerPasswordSetForProto) XXX_Size() int {
+	return xxx_messageInfo_CustomerPasswordSetForProto.Size(m)
+}
+func (m *CustomerPasswordSetForProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomerPasswordSetForProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomerPasswordSetForProto proto.InternalMessageInfo
+
+func (m *CustomerPasswordSetForProto) GetMeta() *EventMetaForProto {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *CustomerPasswordSetForProto) GetCustomerID() string {
+	if m != nil {
+		return m.CustomerID
+	}
+	return ""
+}
+
+func (m *CustomerPasswordSetForProto) GetPasswordHash() string {
+	if m != nil {
+		return m.PasswordHash
+	}
+	return ""
+}
+
+type CustomerPasswordChangedForProto struct {
+	Meta                 *EventMetaForProto `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	CustomerID           string             `protobuf:"bytes,2,opt,name=customerID,proto3" json:"customerID,omitempty"`
+	PasswordHash         string             `protobuf:"bytes,3,opt,name=passwordHash,proto3" json:"passwordHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CustomerPasswordChangedForProto) Reset()         { *m = CustomerPasswordChangedForProto{} }
+func (m *CustomerPasswordChangedForProto) String() string { return proto.CompactTextString(m) }
+func (*CustomerPasswordChangedForProto) ProtoMessage()    {}
+func (*CustomerPasswordChangedForProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72355003b2279c5c, []int{6}
+}
+
+func (m *CustomerPasswordChangedForProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomerPasswordChangedForProto.Unmarshal(m, b)
+}
+func (m *CustomerPasswordChangedForProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomerPasswordChangedForProto.Marshal(b, m, deterministic)
+}
+func (m *CustomerPasswordChangedForProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomerPasswordChangedForProto.Merge(m, src)
+}
+func (m *CustomerPasswordChangedForProto) XXX_Size() int {
+	return xxx_messageInfo_CustomerPasswordChangedForProto.Size(m)
+}
+func (m *CustomerPasswordChangedForProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomerPasswordChangedForProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomerPasswordChangedForProto proto.InternalMessageInfo
+
+func (m *CustomerPasswordChangedForProto) GetMeta() *EventMetaForProto {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *CustomerPasswordChangedForProto) GetCustomerID() string {
+	if m != nil {
+		return m.CustomerID
+	}
+	return ""
+}
+
+func (m *CustomerPasswordChangedForProto) GetPasswordHash() string {
+	if m != nil {
+		return m.PasswordHash
+	}
+	return ""
+}
+
+type CustomerAccountRecoveredForProto struct {
+	Meta                 *EventMetaForProto `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	CustomerID           string             `protobuf:"bytes,2,opt,name=customerID,proto3" json:"customerID,omitempty"`
+	PasswordHash         string             `protobuf:"bytes,3,opt,name=passwordHash,proto3" json:"passwordHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CustomerAccountRecoveredForProto) Reset()         { *m = CustomerAccountRecoveredForProto{} }
+func (m *CustomerAccountRecoveredForProto) String() string { return proto.CompactTextString(m) }
+func (*CustomerAccountRecoveredForProto) ProtoMessage()    {}
+func (*CustomerAccountRecoveredForProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72355003b2279c5c, []int{7}
+}
+
+func (m *CustomerAccountRecoveredForProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomerAccountRecoveredForProto.Unmarshal(m, b)
+}
+func (m *CustomerAccountRecoveredForProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomerAccountRecoveredForProto.Marshal(b, m, deterministic)
+}
+func (m *CustomerAccountRecoveredForProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomerAccountRecoveredForProto.Merge(m, src)
+}
+func (m *CustomerAccountRecoveredForProto) XXX_Size() int {
+	return xxx_messageInfo_CustomerAccountRecoveredForProto.Size(m)
+}
+func (m *CustomerAccountRecoveredForProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomerAccountRecoveredForProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomerAccountRecoveredForProto proto.InternalMessageInfo
+
+func (m *CustomerAccountRecoveredForProto) GetMeta() *EventMetaForProto {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *CustomerAccountRecoveredForProto) GetCustomerID() string {
+	if m != nil {
+		return m.CustomerID
+	}
+	return ""
+}
+
+func (m *CustomerAccountRecoveredForProto) GetPasswordHash() string {
+	if m != nil {
+		return m.PasswordHash
+	}
+	return ""
+}
+
+type CustomerMFAEnrollmentConfirmedForProto struct {
+	Meta                 *EventMetaForProto `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	CustomerID           string             `protobuf:"bytes,2,opt,name=customerID,proto3" json:"customerID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CustomerMFAEnrollmentConfirmedForProto) Reset() {
+	*m = CustomerMFAEnrollmentConfirmedForProto{}
+}
+func (m *CustomerMFAEnrollmentConfirmedForProto) String() string { return proto.CompactTextString(m) }
+func (*CustomerMFAEnrollmentConfirmedForProto) ProtoMessage()    {}
+func (*CustomerMFAEnrollmentConfirmedForProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72355003b2279c5c, []int{8}
+}
+
+func (m *CustomerMFAEnrollmentConfirmedForProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomerMFAEnrollmentConfirmedForProto.Unmarshal(m, b)
+}
+func (m *CustomerMFAEnrollmentConfirmedForProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomerMFAEnrollmentConfirmedForProto.Marshal(b, m, deterministic)
+}
+func (m *CustomerMFAEnrollmentConfirmedForProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomerMFAEnrollmentConfirmedForProto.Merge(m, src)
+}
+func (m *CustomerMFAEnrollmentConfirmedForProto) XXX_Size() int {
+	return xxx_messageInfo_CustomerMFAEnrollmentConfirmedForProto.Size(m)
+}
+func (m *CustomerMFAEnrollmentConfirmedForProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomerMFAEnrollmentConfirmedForProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomerMFAEnrollmentConfirmedForProto proto.InternalMessageInfo
+
+func (m *CustomerMFAEnrollmentConfirmedForProto) GetMeta() *EventMetaForProto {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *CustomerMFAEnrollmentConfirmedForProto) GetCustomerID() string {
+	if m != nil {
+		return m.CustomerID
+	}
+	return ""
+}
+
+type CustomerMFACodeUsedForProto struct {
+	Meta                 *EventMetaForProto `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	CustomerID           string             `protobuf:"bytes,2,opt,name=customerID,proto3" json:"customerID,omitempty"`
+	TimeStep             string             `protobuf:"bytes,3,opt,name=timeStep,proto3" json:"timeStep,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CustomerMFACodeUsedForProto) Reset()         { *m = CustomerMFACodeUsedForProto{} }
+func (m *CustomerMFACodeUsedForProto) String() string { return proto.CompactTextString(m) }
+func (*CustomerMFACodeUsedForProto) ProtoMessage()    {}
+func (*CustomerMFACodeUsedForProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72355003b2279c5c, []int{9}
+}
+
+func (m *CustomerMFACodeUsedForProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomerMFACodeUsedForProto.Unmarshal(m, b)
+}
+func (m *CustomerMFACodeUsedForProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomerMFACodeUsedForProto.Marshal(b, m, deterministic)
+}
+func (m *CustomerMFACodeUsedForProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomerMFACodeUsedForProto.Merge(m, src)
+}
+func (m *CustomerMFACodeUsedForProto) XXX_Size() int {
+	return xxx_messageInfo_CustomerMFACodeUsedForProto.Size(m)
+}
+func (m *CustomerMFACodeUsedForProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomerMFACodeUsedForProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomerMFACodeUsedForProto proto.InternalMessageInfo
+
+func (m *CustomerMFACodeUsedForProto) GetMeta() *EventMetaForProto {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *CustomerMFACodeUsedForProto) GetCustomerID() string {
+	if m != nil {
+		return m.CustomerID
+	}
+	return ""
+}
+
+func (m *CustomerMFACodeUsedForProto) GetTimeStep() string {
+	if m != nil {
+		return m.TimeStep
+	}
+	return ""
+}
+
+type CustomerMFARecoveryCodeUsedForProto struct {
+	Meta                 *EventMetaForProto `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	CustomerID           string             `protobuf:"bytes,2,opt,name=customerID,proto3" json:"customerID,omitempty"`
+	RecoveryCodeHash     string             `protobuf:"bytes,3,opt,name=recoveryCodeHash,proto3" json:"recoveryCodeHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CustomerMFARecoveryCodeUsedForProto) Reset()         { *m = CustomerMFARecoveryCodeUsedForProto{} }
+func (m *CustomerMFARecoveryCodeUsedForProto) String() string { return proto.CompactTextString(m) }
+func (*CustomerMFARecoveryCodeUsedForProto) ProtoMessage()    {}
+func (*CustomerMFARecoveryCodeUsedForProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72355003b2279c5c, []int{10}
+}
+
+func (m *CustomerMFARecoveryCodeUsedForProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomerMFARecoveryCodeUsedForProto.Unmarshal(m, b)
+}
+func (m *CustomerMFARecoveryCodeUsedForProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomerMFARecoveryCodeUsedForProto.Marshal(b, m, deterministic)
+}
+func (m *CustomerMFARecoveryCodeUsedForProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomerMFARecoveryCodeUsedForProto.Merge(m, src)
+}
+func (m *CustomerMFARecoveryCodeUsedForProto) XXX_Size() int {
+	return xxx_messageInfo_CustomerMFARecoveryCodeUsedForProto.Size(m)
+}
+func (m *CustomerMFARecoveryCodeUsedForProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomerMFARecoveryCodeUsedForProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomerMFARecoveryCodeUsedForProto proto.InternalMessageInfo
+
+func (m *CustomerMFARecoveryCodeUsedForProto) GetMeta() *EventMetaForProto {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *CustomerMFARecoveryCodeUsedForProto) GetCustomerID() string {
+	if m != nil {
+		return m.CustomerID
+	}
+	return ""
+}
+
+func (m *CustomerMFARecoveryCodeUsedForProto) GetRecoveryCodeHash() string {
+	if m != nil {
+		return m.RecoveryCodeHash
+	}
+	return ""
+}
+
+type CustomerMFADisabledForProto struct {
+	Meta                 *EventMetaForProto `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	CustomerID           string             `protobuf:"bytes,2,opt,name=customerID,proto3" json:"customerID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CustomerMFADisabledForProto) Reset()         { *m = CustomerMFADisabledForProto{} }
+func (m *CustomerMFADisabledForProto) String() string { return proto.CompactTextString(m) }
+func (*CustomerMFADisabledForProto) ProtoMessage()    {}
+func (*CustomerMFADisabledForProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72355003b2279c5c, []int{11}
+}
+
+func (m *CustomerMFADisabledForProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomerMFADisabledForProto.Unmarshal(m, b)
+}
+func (m *CustomerMFADisabledForProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomerMFADisabledForProto.Marshal(b, m, deterministic)
+}
+func (m *CustomerMFADisabledForProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomerMFADisabledForProto.Merge(m, src)
+}
+func (m *CustomerMFADisabledForProto) XXX_Size() int {
+	return xxx_messageInfo_CustomerMFADisabledForProto.Size(m)
+}
+func (m *CustomerMFADisabledForProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomerMFADisabledForProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomerMFADisabledForProto proto.InternalMessageInfo
+
+func (m *CustomerMFADisabledForProto) GetMeta() *EventMetaForProto {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *CustomerMFADisabledForProto) GetCustomerID() string {
+	if m != nil {
+		return m.CustomerID
+	}
+	return ""
+}
+
+type CustomerDeletedForProto struct {
+	Meta                 *EventMetaForProto `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	CustomerID           string             `protobuf:"bytes,2,opt,name=customerID,proto3" json:"customerID,omitempty"`
+	EmailAddress         string             `protobuf:"bytes,3,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CustomerDeletedForProto) Reset()         { *m = CustomerDeletedForProto{} }
+func (m *CustomerDeletedForProto) String() string { return proto.CompactTextString(m) }
+func (*CustomerDeletedForProto) ProtoMessage()    {}
+func (*CustomerDeletedForProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72355003b2279c5c, []int{12}
+}
+
+func (m *CustomerDeletedForProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CustomerDeletedForProto.Unmarshal(m, b)
+}
+func (m *CustomerDeletedForProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CustomerDeletedForProto.Marshal(b, m, deterministic)
+}
+func (m *CustomerDeletedForProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomerDeletedForProto.Merge(m, src)
+}
+func (m *CustomerDeletedForProto) XXX_Size() int {
+	return xxx_messageInfo_CustomerDeletedForProto.Size(m)
+}
+func (m *CustomerDeletedForProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomerDeletedForProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomerDeletedForProto proto.InternalMessageInfo
+
+func (m *CustomerDeletedForProto) GetMeta() *EventMetaForProto {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *CustomerDeletedForProto) GetCustomerID() string {
+	if m != nil {
+		return m.CustomerID
+	}
+	return ""
+}
+
+func (m *CustomerDeletedForProto) GetEmailAddress() string {
+	if m != nil {
+		return m.EmailAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*CustomerRegisteredForProto)(nil), "customerevents.CustomerRegisteredForProto")
+	proto.RegisterType((*CustomerEmailAddressConfirmedForProto)(nil), "customerevents.CustomerEmailAddressConfirmedForProto")
+	proto.RegisterType((*CustomerEmailAddressChangedForProto)(nil), "customerevents.CustomerEmailAddressChangedForProto")
+	proto.RegisterType((*CustomerNameChangedForProto)(nil), "customerevents.CustomerNameChangedForProto")
+	proto.RegisterType((*CustomerAttributeSetForProto)(nil), "customerevents.CustomerAttributeSetForProto")
+	proto.RegisterType((*CustomerPasswordSetForProto)(nil), "customerevents.CustomerPasswordSetForProto")
+	proto.RegisterType((*CustomerPasswordChangedForProto)(nil), "customerevents.CustomerPasswordChangedForProto")
+	proto.RegisterType((*CustomerAccountRecoveredForProto)(nil), "customerevents.CustomerAccountRecoveredForProto")
+	proto.RegisterType((*CustomerMFAEnrollmentConfirmedForProto)(nil), "customerevents.CustomerMFAEnrollmentConfirmedForProto")
+	proto.RegisterType((*CustomerMFACodeUsedForProto)(nil), "customerevents.CustomerMFACodeUsedForProto")
+	proto.RegisterType((*CustomerMFARecoveryCodeUsedForProto)(nil), "customerevents.CustomerMFARecoveryCodeUsedForProto")
+	proto.RegisterType((*CustomerMFADisabledForProto)(nil), "customerevents.CustomerMFADisabledForProto")
+	proto.RegisterType((*CustomerDeletedForProto)(nil), "customerevents.CustomerDeletedForProto")
+}
+
+func init() { proto.RegisterFile("generated_customer_events.proto", fileDescriptor_72355003b2279c5c) }
+
+var fileDescriptor_72355003b2279c5c = []byte{
+	// 526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xcb, 0x6e, 0x13, 0x31,
+	0x14, 0x86, 0xe5, 0x50, 0x2a, 0x7a, 0xe8, 0x25, 0x1a, 0x21, 0x11, 0x85, 0x8a, 0x06, 0x03, 0x55,
+	0xd4, 0x45, 0x16, 0x45, 0x3c, 0x40, 0xc8, 0x05, 0x58, 0x04, 0x55, 0xa9, 0x60, 0xc1, 0x26, 0x72,
+	0x32, 0xa7, 0xa9, 0xa5, 0x19, 0x7b, 0x64, 0x7b, 0x82, 0xca, 0x06, 0x1e, 0x81, 0x05, 0x0b, 0x90,
+	0x60, 0xc3, 0x8a, 0x35, 0xaf, 0xc0, 0x8b, 0xa1, 0xf1, 0x8c, 0x13, 0x27, 0xad, 0xd4, 0x55, 0x46,
+	0x65, 0x35, 0xca, 0x97, 0x7f, 0xce, 0xf9, 0xcf, 0x25, 0x76, 0xe0, 0x60, 0x8a, 0x02, 0x15, 0x33,
+	0x18, 0x8e, 0x26, 0xa9, 0x36, 0x32, 0x46, 0x35, 0xc2, 0x19, 0x0a, 0xa3, 0x5b, 0x89, 0x92, 0x46,
+	0x06, 0xbb, 0x0e, 0xe7, 0xb4, 0x5e, 0xb5, 0xcf, 0x51, 0x8c, 0x86, 0xe5, 0x0a, 0xfa, 0xbd, 0x02,
+	0xf5, 0x4e, 0x21, 0x1a, 0xe2, 0x94, 0x6b, 0x83, 0x0a, 0xc3, 0xbe, 0x54, 0x27, 0x36, 0xc0, 0x73,
+	0xd8, 0xc8, 0xc4, 0x35, 0xd2, 0x20, 0xcd, 0xbb, 0xc7, 0x8f, 0x5a, 0xcb, 0xf1, 0x5a, 0xbd, 0xec,
+	0x31, 0x40, 0xc3, 0xdc, 0x0b, 0x43, 0x2b, 0x0f, 0x1e, 0x02, 0x38, 0xe5, 0xeb, 0x6e, 0xad, 0xd2,
+	0x20, 0xcd, 0xad, 0xa1, 0x47, 0x02, 0x0a, 0xdb, 0x18, 0x33, 0x1e, 0xb5, 0xc3, 0x50, 0xa1, 0xd6,
+	0xb5, 0x5b, 0x56, 0xb1, 0xc4, 0x82, 0x23, 0xa8, 0x4e, 0xa4, 0x38, 0xe3, 0x2a, 0x66, 0x86, 0x4b,
+	0xf1, 0x8a, 0xe9, 0xf3, 0xda, 0x86, 0xd5, 0x5d, 0xe2, 0x41, 0x13, 0xf6, 0x12, 0x54, 0x5a, 0x8a,
+	0x97, 0x7c, 0x86, 0xe2, 0x0d, 0x8b, 0xb1, 0x76, 0xdb, 0x4a, 0x57, 0x71, 0x16, 0x35, 0x47, 0x7d,
+	0x16, 0xf3, 0xe8, 0xc2, 0x4a, 0x37, 0xf3, 0xa8, 0xab, 0x9c, 0xfe, 0x22, 0xf0, 0xd4, 0xf5, 0xa6,
+	0xe7, 0x59, 0xeb, 0xe4, 0xe9, 0x6f, 0x44, 0x9b, 0xe8, 0xe7, 0x0a, 0x3c, 0xbe, 0xd2, 0xe4, 0x39,
+	0x13, 0xd3, 0xff, 0x6f, 0x92, 0xc7, 0x70, 0x2f, 0x51, 0x38, 0xe3, 0x32, 0xd5, 0x7e, 0x35, 0xc5,
+	0x38, 0xaf, 0xfc, 0x8e, 0xfe, 0x21, 0xf0, 0xc0, 0xb5, 0x20, 0x1b, 0x5c, 0x49, 0xa5, 0xef, 0xc3,
+	0xd6, 0x74, 0xbe, 0x6e, 0x79, 0xdd, 0x0b, 0x90, 0xbd, 0x7d, 0xb6, 0x58, 0xb1, 0xbc, 0x5c, 0x8f,
+	0xd0, 0xbf, 0x04, 0xf6, 0x9d, 0xe9, 0xb6, 0x31, 0x8a, 0x8f, 0x53, 0x83, 0xa7, 0x68, 0xd6, 0xed,
+	0xfa, 0x09, 0xec, 0x30, 0x97, 0xce, 0x73, 0xbe, 0x0c, 0x83, 0x43, 0xd8, 0x9d, 0x83, 0x77, 0x2c,
+	0x4a, 0x5d, 0x05, 0x2b, 0x94, 0x7e, 0xf3, 0x5a, 0x7f, 0xc2, 0xb4, 0xfe, 0x20, 0x55, 0x58, 0x42,
+	0x11, 0x14, 0xb6, 0x93, 0x22, 0x9b, 0xdd, 0xa6, 0x62, 0xeb, 0x7c, 0x46, 0x7f, 0x10, 0x38, 0x58,
+	0xb5, 0x56, 0xde, 0x8f, 0xe2, 0x5a, 0x7b, 0x3f, 0x09, 0x34, 0xe6, 0xf3, 0x9f, 0x4c, 0x64, 0x2a,
+	0xcc, 0x10, 0x27, 0x72, 0x56, 0xd2, 0xf1, 0x7b, 0xad, 0xbf, 0x4f, 0x70, 0xe8, 0xec, 0x0d, 0xfa,
+	0xed, 0x9e, 0x50, 0x32, 0x8a, 0x62, 0x14, 0xa6, 0xac, 0xc3, 0x8f, 0x7e, 0xf1, 0x56, 0x6b, 0xd0,
+	0x6f, 0x77, 0x64, 0x88, 0x6f, 0xf5, 0xfa, 0x7b, 0x53, 0x87, 0x3b, 0x86, 0xc7, 0x78, 0x6a, 0x30,
+	0x29, 0xfa, 0x32, 0xff, 0x4c, 0x7f, 0x93, 0xc5, 0x59, 0x3b, 0xe8, 0xb7, 0x8b, 0x79, 0x5d, 0x94,
+	0x65, 0xed, 0x08, 0xaa, 0xca, 0x4b, 0xe9, 0x8d, 0xee, 0x12, 0xa7, 0x66, 0xa9, 0x79, 0x5d, 0xae,
+	0xd9, 0x38, 0x5a, 0xff, 0xcc, 0xbe, 0x12, 0xb8, 0xef, 0xd2, 0x76, 0x31, 0x42, 0x73, 0x23, 0x2e,
+	0xa0, 0x17, 0x7b, 0xef, 0x77, 0x34, 0x2a, 0xce, 0x22, 0xfe, 0xd1, 0xde, 0x34, 0xe3, 0x4d, 0xfb,
+	0xe7, 0xe7, 0xd9, 0xbf, 0x01, 0x00, 0x8c, 0x0e, 0x99, 0xa7, 0x41, 0x09, 0x00, 0x00,
+}
@@ -7,6 +7,9 @@ var (
 	ErrNotFound       = errors.New("not found")
 	ErrDuplicate      = errors.New("duplicate")
 
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrRateLimitExceeded  = errors.New("rate limit exceeded")
+
 	ErrDomainConstraintsViolation = errors.New("domain constraints violation")
 
 	ErrMaxRetriesExceeded  = errors.New("max retries exceeded")
@@ -0,0 +1,39 @@
+package es
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// ChainHashTimeFormat is how the occurred_at of an event is formatted for its chain hash.
+const ChainHashTimeFormat = "2006-01-02T15:04:05.000000Z"
+
+// ChainHash computes the HMAC-SHA256 of "previousChainHash|payloadHash|streamID|streamVersion|eventName|occurredAt"
+// with the chainKey. It is computed by the service, so that the key is never sent to the database.
+func ChainHash(
+	chainKey []byte,
+	previousChainHash string,
+	payloadHash string,
+	streamID string,
+	streamVersion uint,
+	eventName string,
+	occurredAt time.Time,
+) string {
+
+	mac := hmac.New(sha256.New, chainKey)
+	_, _ = fmt.Fprintf(
+		mac,
+		"%s|%s|%s|%d|%s|%s",
+		previousChainHash,
+		payloadHash,
+		streamID,
+		streamVersion,
+		eventName,
+		occurredAt.UTC().Format(ChainHashTimeFormat),
+	)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,36 @@
+package es_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestChainHash(t *testing.T) {
+	Convey("Given an event which follows a previous event", t, func() {
+		chainKey := []byte("test-event-chain-key-with-32-bytes")
+		occurredAt := time.Date(2020, 5, 17, 14, 30, 15, 123456000, time.FixedZone("CEST", 2*60*60))
+
+		Convey("When its chain hash is computed", func() {
+			chainHash := es.ChainHash(chainKey, "previous", "payload", "customer-123", 2, "CustomerRegistered", occurredAt)
+
+			Convey("It should be the HMAC of all fields with occurredAt in UTC", func() {
+				mac := hmac.New(sha256.New, chainKey)
+				_, _ = mac.Write([]byte("previous|payload|customer-123|2|CustomerRegistered|2020-05-17T12:30:15.123456Z"))
+
+				So(chainHash, ShouldEqual, hex.EncodeToString(mac.Sum(nil)))
+			})
+
+			Convey("It should depend on the chain key", func() {
+				otherChainHash := es.ChainHash([]byte("other"), "previous", "payload", "customer-123", 2, "CustomerRegistered", occurredAt)
+
+				So(chainHash, ShouldNotEqual, otherChainHash)
+			})
+		})
+	})
+}
@@ -0,0 +1,38 @@
+package es
+
+import (
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+const maxEventCountSpan = 92 * 24 * time.Hour
+
+// EventCount is the number of events with the same name which occurred on the same day (UTC).
+type EventCount struct {
+	Day       time.Time
+	EventName string
+	Count     uint
+}
+
+// ValidateEventCountFilter requires a time range of at most 92 days, so that counting can't scan the whole event store.
+func ValidateEventCountFilter(filter EventFilter) error {
+	wrapWithMsg := "ValidateEventCountFilter"
+
+	if filter.OccurredFrom.IsZero() || filter.OccurredUntil.IsZero() {
+		err := errors.New("occurredFrom and occurredUntil are required")
+		return shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	if err := filter.validate(); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	if filter.OccurredUntil.Sub(filter.OccurredFrom) > maxEventCountSpan {
+		err := errors.Newf("the time range must not exceed [%s]", maxEventCountSpan)
+		return shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package es_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestValidateEventCountFilter(t *testing.T) {
+	from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	Convey("When an EventFilter for counting has a time range of 92 days", t, func() {
+		err := es.ValidateEventCountFilter(es.EventFilter{OccurredFrom: from, OccurredUntil: from.AddDate(0, 0, 92)})
+
+		Convey("Then it should be valid", func() {
+			So(err, ShouldBeNil)
+		})
+	})
+
+	Convey("When an EventFilter for counting has a longer time range", t, func() {
+		err := es.ValidateEventCountFilter(es.EventFilter{OccurredFrom: from, OccurredUntil: from.AddDate(0, 0, 93)})
+
+		Convey("Then it should fail", func() {
+			So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+		})
+	})
+
+	Convey("When an EventFilter for counting has an open time range", t, func() {
+		err := es.ValidateEventCountFilter(es.EventFilter{OccurredFrom: from})
+
+		Convey("Then it should fail", func() {
+			So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,61 @@
+package es
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+// EventFilter selects stored events, empty strings and zero times mean "don't filter".
+// OccurredFrom is inclusive, OccurredUntil is exclusive.
+type EventFilter struct {
+	EventName      string
+	StreamID       string
+	StreamIDPrefix string
+	OccurredFrom   time.Time
+	OccurredUntil  time.Time
+}
+
+func (filter EventFilter) validate() error {
+	if !filter.OccurredFrom.IsZero() && !filter.OccurredUntil.IsZero() && !filter.OccurredFrom.Before(filter.OccurredUntil) {
+		err := errors.New("occurredFrom must be before occurredUntil")
+		return shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, "eventFilter.validate")
+	}
+
+	return nil
+}
+
+// conditions appends the arguments of the filter and returns the SQL conditions which use them,
+// so that Postgres can use the indexes on event_name, stream_id and occurred_at.
+func (filter EventFilter) conditions(args []interface{}) ([]string, []interface{}) {
+	var conditions []string
+
+	addCondition := func(template string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(template, len(args)))
+	}
+
+	if filter.EventName != "" {
+		addCondition("event_name = $%d", filter.EventName)
+	}
+
+	if filter.StreamID != "" {
+		addCondition("stream_id = $%d", filter.StreamID)
+	}
+
+	if filter.StreamIDPrefix != "" {
+		addCondition("starts_with(stream_id, $%d)", filter.StreamIDPrefix)
+	}
+
+	if !filter.OccurredFrom.IsZero() {
+		addCondition("occurred_at >= $%d", filter.OccurredFrom)
+	}
+
+	if !filter.OccurredUntil.IsZero() {
+		addCondition("occurred_at < $%d", filter.OccurredUntil)
+	}
+
+	return conditions, args
+}
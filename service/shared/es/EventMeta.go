@@ -21,12 +21,8 @@ func BuildEventMeta(
 	streamVersion uint,
 ) EventMeta {
 
-	eventType := reflect.TypeOf(event).String()
-	eventTypeParts := strings.Split(eventType, ".")
-	eventName := eventTypeParts[len(eventTypeParts)-1]
-
 	meta := EventMeta{
-		eventName:     eventName,
+		eventName:     eventNameOf(event),
 		occurredAt:    time.Now().Format(metaTimestampFormat),
 		streamVersion: streamVersion,
 	}
@@ -34,6 +30,13 @@ func BuildEventMeta(
 	return meta
 }
 
+func eventNameOf(event DomainEvent) string {
+	eventType := reflect.TypeOf(event).String()
+	eventTypeParts := strings.Split(eventType, ".")
+
+	return eventTypeParts[len(eventTypeParts)-1]
+}
+
 func RebuildEventMeta(
 	eventName string,
 	occurredAt string,
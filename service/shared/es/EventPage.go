@@ -0,0 +1,7 @@
+package es
+
+// EventPage is one page of the result of an EventQuery, NextAfterPosition is 0 for the last page.
+type EventPage struct {
+	Events            []StoredEventMetadata
+	NextAfterPosition uint
+}
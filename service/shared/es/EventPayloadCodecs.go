@@ -0,0 +1,97 @@
+package es
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+// EventPayloadFormat is the format in which the event store writes new payloads.
+type EventPayloadFormat string
+
+const (
+	// JSONPayloads are stored in the jsonb column payload.
+	JSONPayloads EventPayloadFormat = "json"
+	// BinaryPayloads, e.g. protobuf, are stored in the bytea column payload_bytes.
+	BinaryPayloads EventPayloadFormat = "binary"
+)
+
+// EventPayloadCodecs marshal and unmarshal the payloads of the event store.
+// Each event is read in the format it was stored in, so JSON and binary payloads can exist side by side,
+// e.g. while ConvertEventPayloads is running. Exports always contain JSON payloads.
+type EventPayloadCodecs struct {
+	WriteFormat     EventPayloadFormat
+	MarshalJSON     MarshalDomainEvent
+	UnmarshalJSON   UnmarshalDomainEvent
+	MarshalBinary   MarshalDomainEvent
+	UnmarshalBinary UnmarshalDomainEvent
+}
+
+// marshal returns the payload for the column of the WriteFormat, the other one is nil (NULL).
+func (codecs EventPayloadCodecs) marshal(event DomainEvent) (jsonPayload interface{}, binaryPayload interface{}, err error) {
+	wrapWithMsg := "eventPayloadCodecs.marshal"
+
+	switch codecs.WriteFormat {
+	case JSONPayloads:
+		jsonPayload, err = codecs.MarshalJSON(event)
+	case BinaryPayloads:
+		binaryPayload, err = codecs.MarshalBinary(event)
+	default:
+		err = errors.Newf("unknown payload format [%s]", codecs.WriteFormat)
+		return nil, nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	if err != nil {
+		return nil, nil, shared.MarkAndWrapError(err, shared.ErrMarshalingFailed, wrapWithMsg)
+	}
+
+	return jsonPayload, binaryPayload, nil
+}
+
+// unmarshal uses the codec of the column which is not NULL.
+func (codecs EventPayloadCodecs) unmarshal(
+	eventName string,
+	jsonPayload []byte,
+	binaryPayload []byte,
+	streamVersion uint,
+) (DomainEvent, error) {
+
+	var event DomainEvent
+	var err error
+
+	if binaryPayload != nil {
+		event, err = codecs.UnmarshalBinary(eventName, binaryPayload, streamVersion)
+	} else {
+		event, err = codecs.UnmarshalJSON(eventName, jsonPayload, streamVersion)
+	}
+
+	if err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrUnmarshalingFailed, "eventPayloadCodecs.unmarshal")
+	}
+
+	return event, nil
+}
+
+// toJSON converts binary payloads to JSON and returns JSON payloads as they are.
+func (codecs EventPayloadCodecs) toJSON(
+	eventName string,
+	jsonPayload []byte,
+	binaryPayload []byte,
+	streamVersion uint,
+) ([]byte, error) {
+
+	if binaryPayload == nil {
+		return jsonPayload, nil
+	}
+
+	event, err := codecs.unmarshal(eventName, jsonPayload, binaryPayload, streamVersion)
+	if err != nil {
+		return nil, errors.Wrap(err, "eventPayloadCodecs.toJSON")
+	}
+
+	converted, err := codecs.MarshalJSON(event)
+	if err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrMarshalingFailed, "eventPayloadCodecs.toJSON")
+	}
+
+	return converted, nil
+}
@@ -0,0 +1,108 @@
+package es
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+)
+
+// CorruptEventPolicy decides what loading a stream does with an event which can't be unmarshaled.
+type CorruptEventPolicy int
+
+const (
+	// FailOnCorruptEvents returns the error, marked as shared.ErrUnmarshalingFailed.
+	FailOnCorruptEvents CorruptEventPolicy = iota
+	// SkipCorruptEvents leaves the event out of the stream.
+	SkipCorruptEvents
+	// QuarantineCorruptEvents records the event in the quarantine and leaves it out of the stream.
+	QuarantineCorruptEvents
+)
+
+// CorruptEvent is a stored event which can't be unmarshaled.
+type CorruptEvent struct {
+	EventID       uint
+	StreamID      string
+	StreamVersion uint
+	EventName     string
+	Reason        string
+}
+
+// EventQuarantine records corrupt events, each event once with the time it was first and last seen.
+type EventQuarantine struct {
+	db                  *sql.DB
+	quarantineTableName string
+}
+
+func NewEventQuarantine(db *sql.DB, quarantineTableName string) *EventQuarantine {
+	return &EventQuarantine{
+		db:                  db,
+		quarantineTableName: quarantineTableName,
+	}
+}
+
+// RetrieveCorruptEvents returns all quarantined events, ordered by stream and version.
+func (quarantine *EventQuarantine) RetrieveCorruptEvents() ([]CorruptEvent, error) {
+	var err error
+	var corruptEvents []CorruptEvent
+	wrapWithMsg := "eventQuarantine.RetrieveCorruptEvents"
+
+	queryTemplate := `SELECT event_id, stream_id, stream_version, event_name, reason FROM %name% 
+						ORDER BY stream_id ASC, stream_version ASC`
+	query := strings.Replace(queryTemplate, "%name%", quarantine.quarantineTableName, 1)
+
+	eventRows, err := quarantine.db.Query(query)
+	if err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	defer eventRows.Close()
+
+	for eventRows.Next() {
+		var corruptEvent CorruptEvent
+
+		err = eventRows.Scan(
+			&corruptEvent.EventID,
+			&corruptEvent.StreamID,
+			&corruptEvent.StreamVersion,
+			&corruptEvent.EventName,
+			&corruptEvent.Reason,
+		)
+
+		if err != nil {
+			return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		corruptEvents = append(corruptEvents, corruptEvent)
+	}
+
+	if err = eventRows.Err(); err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	return corruptEvents, nil
+}
+
+func (quarantine *EventQuarantine) add(corruptEvent CorruptEvent) error {
+	queryTemplate := `INSERT INTO %name% (event_id, stream_id, stream_version, event_name, reason, first_seen_at, last_seen_at)
+						VALUES ($1, $2, $3, $4, $5, $6, $6)
+						ON CONFLICT (event_id) DO UPDATE SET reason = EXCLUDED.reason, last_seen_at = EXCLUDED.last_seen_at`
+	query := strings.Replace(queryTemplate, "%name%", quarantine.quarantineTableName, 1)
+
+	_, err := quarantine.db.Exec(
+		query,
+		corruptEvent.EventID,
+		corruptEvent.StreamID,
+		corruptEvent.StreamVersion,
+		corruptEvent.EventName,
+		corruptEvent.Reason,
+		time.Now(),
+	)
+
+	if err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, "eventQuarantine.add")
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package es
+
+import (
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	defaultEventQueryPageSize = uint(100)
+	maxEventQueryPageSize     = uint(1000)
+)
+
+// EventQuery pages through the events which match the filter in position order.
+// The next page starts after the last position of the previous one, the first after 0.
+type EventQuery struct {
+	EventFilter
+	AfterPosition uint
+	PageSize      uint
+}
+
+// BuildEventQuery applies the default page size and validates the result.
+func BuildEventQuery(input EventQuery) (EventQuery, error) {
+	wrapWithMsg := "BuildEventQuery"
+	query := input
+
+	switch {
+	case query.PageSize == 0:
+		query.PageSize = defaultEventQueryPageSize
+	case query.PageSize > maxEventQueryPageSize:
+		err := errors.Newf("page size must not exceed [%d]", maxEventQueryPageSize)
+		return EventQuery{}, shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	if err := query.EventFilter.validate(); err != nil {
+		return EventQuery{}, errors.Wrap(err, wrapWithMsg)
+	}
+
+	return query, nil
+}
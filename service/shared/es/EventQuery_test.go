@@ -0,0 +1,50 @@
+package es_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBuildEventQuery(t *testing.T) {
+	Convey("When an EventQuery is built without page size", t, func() {
+		query, err := es.BuildEventQuery(es.EventQuery{EventFilter: es.EventFilter{EventName: "CustomerDeleted"}})
+
+		Convey("Then it should apply the default page size", func() {
+			So(err, ShouldBeNil)
+			So(query.PageSize, ShouldEqual, 100)
+			So(query.EventName, ShouldEqual, "CustomerDeleted")
+		})
+	})
+
+	Convey("When an EventQuery is built with a too big page size", t, func() {
+		_, err := es.BuildEventQuery(es.EventQuery{PageSize: 1001})
+
+		Convey("Then it should fail", func() {
+			So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+		})
+	})
+
+	Convey("When an EventQuery is built with an empty time range", t, func() {
+		now := time.Now()
+		_, err := es.BuildEventQuery(
+			es.EventQuery{EventFilter: es.EventFilter{OccurredFrom: now, OccurredUntil: now}},
+		)
+
+		Convey("Then it should fail", func() {
+			So(errors.Is(err, shared.ErrInputIsInvalid), ShouldBeTrue)
+		})
+	})
+
+	Convey("When an EventQuery is built with an open time range", t, func() {
+		_, err := es.BuildEventQuery(es.EventQuery{EventFilter: es.EventFilter{OccurredFrom: time.Now()}})
+
+		Convey("Then it should succeed", func() {
+			So(err, ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,159 @@
+package es
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+// PayloadCodec encodes the payloads of all events in a registry, e.g. as JSON.
+type PayloadCodec interface {
+	Marshal(payload interface{}) ([]byte, error)
+	Unmarshal(data []byte, payload interface{}) error
+}
+
+// EventRegistration tells an EventRegistry how to store one event type.
+// Event is a zero value of the event type, its type name must match Name.
+// ToPayload converts the event to the payload struct which is encoded with the PayloadCodec,
+// NewPayload returns a pointer to an empty payload struct to decode into, and Rebuild converts it back to the event.
+type EventRegistration struct {
+	Name       string
+	Event      DomainEvent
+	ToPayload  func(event DomainEvent) (interface{}, error)
+	NewPayload func() interface{}
+	Rebuild    func(payload interface{}, streamVersion uint) (DomainEvent, error)
+}
+
+type EventRegistry struct {
+	codec         PayloadCodec
+	registrations map[string]EventRegistration
+}
+
+func NewEventRegistry(codec PayloadCodec) *EventRegistry {
+	return &EventRegistry{
+		codec:         codec,
+		registrations: make(map[string]EventRegistration),
+	}
+}
+
+// Register fails for incomplete registrations and for events which are already registered.
+func (registry *EventRegistry) Register(registrations ...EventRegistration) error {
+	wrapWithMsg := "eventRegistry.Register"
+
+	for _, registration := range registrations {
+		if registration.Event == nil || registration.ToPayload == nil || registration.NewPayload == nil || registration.Rebuild == nil {
+			err := errors.Newf("the registration of event [%s] is incomplete", registration.Name)
+			return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		if name := eventNameOf(registration.Event); name != registration.Name {
+			err := errors.Newf("event [%s] is registered with the name [%s]", name, registration.Name)
+			return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		if _, ok := registry.registrations[registration.Name]; ok {
+			err := errors.Newf("event [%s] is already registered", registration.Name)
+			return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		registry.registrations[registration.Name] = registration
+	}
+
+	return nil
+}
+
+// AssertRegistered fails if any of the events is not registered, it should be called at startup with all known events.
+func (registry *EventRegistry) AssertRegistered(events ...DomainEvent) error {
+	for _, event := range events {
+		registration, ok := registry.registrations[eventNameOf(event)]
+
+		if !ok || reflect.TypeOf(registration.Event) != reflect.TypeOf(event) {
+			err := errors.Newf("event [%s] is not registered", eventNameOf(event))
+			return shared.MarkAndWrapError(err, shared.ErrTechnical, "eventRegistry.AssertRegistered")
+		}
+	}
+
+	return nil
+}
+
+// EventNames returns the names of all registered events, sorted.
+func (registry *EventRegistry) EventNames() []string {
+	names := make([]string, 0, len(registry.registrations))
+
+	for name := range registry.registrations {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// NewPayload returns an empty payload of the event, e.g. to describe its structure.
+func (registry *EventRegistry) NewPayload(name string) (interface{}, bool) {
+	registration, ok := registry.registrations[name]
+	if !ok {
+		return nil, false
+	}
+
+	return registration.NewPayload(), true
+}
+
+// Marshal satisfies MarshalDomainEvent.
+func (registry *EventRegistry) Marshal(event DomainEvent) ([]byte, error) {
+	wrapWithMsg := "eventRegistry.Marshal"
+	registration, ok := registry.registrations[event.Meta().EventName()]
+
+	if !ok || reflect.TypeOf(registration.Event) != reflect.TypeOf(event) {
+		err := errors.Newf("event [%s] is unknown", event.Meta().EventName())
+		return nil, shared.MarkAndWrapError(err, shared.ErrMarshalingFailed, wrapWithMsg)
+	}
+
+	payload, err := registration.ToPayload(event)
+	if err != nil {
+		err = errors.Wrapf(err, "event [%s]", registration.Name)
+		return nil, shared.MarkAndWrapError(err, shared.ErrMarshalingFailed, wrapWithMsg)
+	}
+
+	data, err := registry.codec.Marshal(payload)
+	if err != nil {
+		err = errors.Wrapf(err, "event [%s]", registration.Name)
+		return nil, shared.MarkAndWrapError(err, shared.ErrMarshalingFailed, wrapWithMsg)
+	}
+
+	return data, nil
+}
+
+// Unmarshal satisfies UnmarshalDomainEvent.
+// It fails if the codec or Rebuild fail and if the rebuilt event does not carry a meta for this event.
+func (registry *EventRegistry) Unmarshal(name string, payload []byte, streamVersion uint) (DomainEvent, error) {
+	wrapWithMsg := "eventRegistry.Unmarshal"
+	registration, ok := registry.registrations[name]
+
+	if !ok {
+		err := errors.Newf("event [%s] is unknown", name)
+		return nil, shared.MarkAndWrapError(err, shared.ErrUnmarshalingFailed, wrapWithMsg)
+	}
+
+	decoded := registration.NewPayload()
+
+	if err := registry.codec.Unmarshal(payload, decoded); err != nil {
+		err = errors.Wrapf(err, "event [%s]", name)
+		return nil, shared.MarkAndWrapError(err, shared.ErrUnmarshalingFailed, wrapWithMsg)
+	}
+
+	event, err := registration.Rebuild(decoded, streamVersion)
+	if err != nil {
+		err = errors.Wrapf(err, "event [%s]", name)
+		return nil, shared.MarkAndWrapError(err, shared.ErrUnmarshalingFailed, wrapWithMsg)
+	}
+
+	if event.Meta().EventName() != name || event.Meta().OccurredAt() == "" {
+		err = errors.Newf("event [%s] has the invalid meta [%s] [%s]", name, event.Meta().EventName(), event.Meta().OccurredAt())
+		return nil, shared.MarkAndWrapError(err, shared.ErrUnmarshalingFailed, wrapWithMsg)
+	}
+
+	return event, nil
+}
@@ -0,0 +1,161 @@
+package es_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEventRegistry(t *testing.T) {
+	Convey("Given an EventRegistry with JSON payloads", t, func() {
+		registry := es.NewEventRegistry(jsonCodec{})
+
+		Convey("When an event is registered", func() {
+			err := registry.Register(someEventRegistration("SomeEvent"))
+			So(err, ShouldBeNil)
+
+			Convey("Then it can be marshaled and unmarshaled", func() {
+				event := SomeEvent{meta: es.BuildEventMeta(SomeEvent{}, 3), Value: "some value"}
+
+				payload, err := registry.Marshal(event)
+				So(err, ShouldBeNil)
+
+				unmarshaled, err := registry.Unmarshal("SomeEvent", payload, 3)
+				So(err, ShouldBeNil)
+				So(unmarshaled, ShouldResemble, event)
+			})
+
+			Convey("Then its name and an empty payload should be available", func() {
+				So(registry.EventNames(), ShouldResemble, []string{"SomeEvent"})
+
+				payload, ok := registry.NewPayload("SomeEvent")
+				So(ok, ShouldBeTrue)
+				So(payload, ShouldResemble, &SomeEventForJSON{})
+
+				_, ok = registry.NewPayload("OtherEvent")
+				So(ok, ShouldBeFalse)
+			})
+
+			Convey("Then it should be asserted as registered", func() {
+				So(registry.AssertRegistered(SomeEvent{}), ShouldBeNil)
+			})
+
+			Convey("Then other events should not be asserted as registered", func() {
+				err := registry.AssertRegistered(SomeEvent{}, OtherEvent{})
+				So(errors.Is(err, shared.ErrTechnical), ShouldBeTrue)
+			})
+
+			Convey("Then it can't be registered again", func() {
+				err := registry.Register(someEventRegistration("SomeEvent"))
+				So(errors.Is(err, shared.ErrTechnical), ShouldBeTrue)
+			})
+
+			Convey("Then unknown events can't be marshaled", func() {
+				_, err := registry.Marshal(OtherEvent{})
+				So(errors.Is(err, shared.ErrMarshalingFailed), ShouldBeTrue)
+			})
+
+			Convey("Then unknown events can't be unmarshaled", func() {
+				_, err := registry.Unmarshal("OtherEvent", []byte("{}"), 1)
+				So(errors.Is(err, shared.ErrUnmarshalingFailed), ShouldBeTrue)
+			})
+
+			Convey("Then invalid payloads can't be unmarshaled", func() {
+				_, err := registry.Unmarshal("SomeEvent", []byte("{"), 1)
+				So(errors.Is(err, shared.ErrUnmarshalingFailed), ShouldBeTrue)
+			})
+
+			Convey("Then payloads without a meta can't be unmarshaled", func() {
+				_, err := registry.Unmarshal("SomeEvent", []byte(`{"Value": "some value"}`), 1)
+				So(errors.Is(err, shared.ErrUnmarshalingFailed), ShouldBeTrue)
+			})
+		})
+
+		Convey("When an event is registered with a different name", func() {
+			err := registry.Register(someEventRegistration("OtherEvent"))
+
+			Convey("Then it should fail", func() {
+				So(errors.Is(err, shared.ErrTechnical), ShouldBeTrue)
+			})
+		})
+
+		Convey("When an incomplete registration is registered", func() {
+			registration := someEventRegistration("SomeEvent")
+			registration.Rebuild = nil
+			err := registry.Register(registration)
+
+			Convey("Then it should fail", func() {
+				So(errors.Is(err, shared.ErrTechnical), ShouldBeTrue)
+			})
+		})
+	})
+}
+
+/***** test events and a JSON codec *****/
+
+type jsonCodec struct{}
+
+func (codec jsonCodec) Marshal(payload interface{}) ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+func (codec jsonCodec) Unmarshal(data []byte, payload interface{}) error {
+	return json.Unmarshal(data, payload)
+}
+
+type SomeEvent struct {
+	meta  es.EventMeta
+	Value string
+}
+
+type SomeEventForJSON struct {
+	OccurredAt string `json:"occurredAt"`
+	Value      string `json:"value"`
+}
+
+func (event SomeEvent) Meta() es.EventMeta {
+	return event.meta
+}
+
+func (event SomeEvent) IsFailureEvent() bool {
+	return false
+}
+
+func (event SomeEvent) FailureReason() error {
+	return nil
+}
+
+type OtherEvent struct{}
+
+func (event OtherEvent) Meta() es.EventMeta {
+	return es.BuildEventMeta(event, 1)
+}
+
+func (event OtherEvent) IsFailureEvent() bool {
+	return false
+}
+
+func (event OtherEvent) FailureReason() error {
+	return nil
+}
+
+func someEventRegistration(name string) es.EventRegistration {
+	return es.EventRegistration{
+		Name:  name,
+		Event: SomeEvent{},
+		ToPayload: func(event es.DomainEvent) (interface{}, error) {
+			return &SomeEventForJSON{OccurredAt: event.Meta().OccurredAt(), Value: event.(SomeEvent).Value}, nil
+		},
+		NewPayload: func() interface{} { return &SomeEventForJSON{} },
+		Rebuild: func(payload interface{}, streamVersion uint) (es.DomainEvent, error) {
+			actualPayload := payload.(*SomeEventForJSON)
+			meta := es.RebuildEventMeta("SomeEvent", actualPayload.OccurredAt, streamVersion)
+
+			return SomeEvent{meta: meta, Value: actualPayload.Value}, nil
+		},
+	}
+}
@@ -0,0 +1,14 @@
+package es
+
+import (
+	"database/sql"
+)
+
+// EventStoreHooks are called by the PostgresEventStore inside its transactions, so that an aggregate type can keep
+// its own tables (e.g. uniqueness constraints or projections) consistent with its events. All hooks are optional,
+// an error of a hook rolls back the transaction and keeps its marks.
+type EventStoreHooks struct {
+	BeforeAppend func(tx *sql.Tx, streamID StreamID, events []DomainEvent) error
+	AfterAppend  func(tx *sql.Tx, streamID StreamID, events []DomainEvent) error
+	BeforePurge  func(tx *sql.Tx, streamID StreamID) error
+}
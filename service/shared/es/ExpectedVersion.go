@@ -0,0 +1,41 @@
+package es
+
+import (
+	"fmt"
+)
+
+// ExpectedVersion is the version a stream must have for an append to succeed.
+type ExpectedVersion struct {
+	isAny   bool
+	version uint
+}
+
+// AnyVersion appends regardless of the version of the stream, the appended events must still continue it.
+func AnyVersion() ExpectedVersion {
+	return ExpectedVersion{isAny: true}
+}
+
+// NoStream appends only if the stream does not exist yet.
+func NoStream() ExpectedVersion {
+	return ExpectedVersion{}
+}
+
+// ExactVersion appends only if the stream has the given version, ExactVersion(0) is the same as NoStream.
+func ExactVersion(version uint) ExpectedVersion {
+	return ExpectedVersion{version: version}
+}
+
+func (expected ExpectedVersion) IsSatisfiedBy(actualVersion uint) bool {
+	return expected.isAny || expected.version == actualVersion
+}
+
+func (expected ExpectedVersion) String() string {
+	switch {
+	case expected.isAny:
+		return "any"
+	case expected.version == 0:
+		return "no stream"
+	default:
+		return fmt.Sprintf("%d", expected.version)
+	}
+}
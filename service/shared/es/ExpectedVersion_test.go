@@ -0,0 +1,59 @@
+package es_test
+
+import (
+	"testing"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/AntonStoeckl/go-iddd/service/shared/es"
+	"github.com/cockroachdb/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestExpectedVersion_IsSatisfiedBy(t *testing.T) {
+	Convey("Given AnyVersion", t, func() {
+		expected := es.AnyVersion()
+
+		Convey("It should be satisfied by every version", func() {
+			So(expected.IsSatisfiedBy(0), ShouldBeTrue)
+			So(expected.IsSatisfiedBy(7), ShouldBeTrue)
+			So(expected.String(), ShouldEqual, "any")
+		})
+	})
+
+	Convey("Given NoStream", t, func() {
+		expected := es.NoStream()
+
+		Convey("It should only be satisfied by a stream without events", func() {
+			So(expected.IsSatisfiedBy(0), ShouldBeTrue)
+			So(expected.IsSatisfiedBy(1), ShouldBeFalse)
+			So(expected.String(), ShouldEqual, "no stream")
+		})
+	})
+
+	Convey("Given ExactVersion 3", t, func() {
+		expected := es.ExactVersion(3)
+
+		Convey("It should only be satisfied by version 3", func() {
+			So(expected.IsSatisfiedBy(3), ShouldBeTrue)
+			So(expected.IsSatisfiedBy(2), ShouldBeFalse)
+			So(expected.IsSatisfiedBy(4), ShouldBeFalse)
+			So(expected.String(), ShouldEqual, "3")
+		})
+	})
+}
+
+func TestNewWrongExpectedVersionError(t *testing.T) {
+	Convey("When a WrongExpectedVersionError is wrapped", t, func() {
+		err := es.NewWrongExpectedVersionError(es.ExactVersion(3), 5)
+		err = shared.MarkAndWrapError(err, shared.ErrTechnical, "someFunc")
+
+		Convey("Then it should be a concurrency conflict which reports the actual version", func() {
+			So(errors.Is(err, shared.ErrConcurrencyConflict), ShouldBeTrue)
+			So(err.Error(), ShouldContainSubstring, "expected version 3, actual version 5")
+
+			var wrongExpectedVersion *es.WrongExpectedVersionError
+			So(errors.As(err, &wrongExpectedVersion), ShouldBeTrue)
+			So(wrongExpectedVersion.ActualVersion, ShouldEqual, 5)
+		})
+	})
+}
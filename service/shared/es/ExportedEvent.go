@@ -0,0 +1,10 @@
+package es
+
+// ExportedEvent is a stored event with its JSON payload, as it is needed for exports and backups.
+type ExportedEvent struct {
+	StreamID      string
+	StreamVersion uint
+	EventName     string
+	OccurredAt    string
+	Payload       []byte
+}
@@ -0,0 +1,1037 @@
+package es
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+	"github.com/lib/pq"
+)
+
+// PostgresEventStore stores the event streams of one aggregate type. Streams of different aggregate types can share
+// the same table, each aggregate type uses its own store with its own hooks.
+// Each event gets a payload hash, which is computed by Postgres from the stored payload, and a per-stream chain hash,
+// which is an HMAC with the chainKey. The chain hash is computed by the store, so the key never reaches the database.
+// The current version of each stream is kept in the streams table, which serializes appends to the same stream.
+type PostgresEventStore struct {
+	db                  *sql.DB
+	eventStoreTableName string
+	streamsTableName    string
+	chainKey            []byte
+	payloadCodecs       EventPayloadCodecs
+	eventQuarantine     *EventQuarantine
+	corruptEventPolicy  CorruptEventPolicy
+	hooks               EventStoreHooks
+	statements          *preparedStatements
+}
+
+func NewPostgresEventStore(
+	db *sql.DB,
+	eventStoreTableName string,
+	streamsTableName string,
+	chainKey []byte,
+	payloadCodecs EventPayloadCodecs,
+	eventQuarantine *EventQuarantine,
+	hooks EventStoreHooks,
+) *PostgresEventStore {
+
+	return &PostgresEventStore{
+		db:                  db,
+		eventStoreTableName: eventStoreTableName,
+		streamsTableName:    streamsTableName,
+		chainKey:            chainKey,
+		payloadCodecs:       payloadCodecs,
+		eventQuarantine:     eventQuarantine,
+		corruptEventPolicy:  FailOnCorruptEvents,
+		hooks:               hooks,
+		statements:          newPreparedStatements(db),
+	}
+}
+
+// WithCorruptEventPolicy returns a copy of the store which loads events with the given policy, so that
+// each consumer can choose it. Consumers which make decisions based on the stream must use FailOnCorruptEvents.
+func (s *PostgresEventStore) WithCorruptEventPolicy(policy CorruptEventPolicy) *PostgresEventStore {
+	store := *s
+	store.corruptEventPolicy = policy
+
+	return &store
+}
+
+func (s *PostgresEventStore) LoadEventStream(streamID StreamID, fromVersion uint, maxEvents uint) (EventStream, error) {
+	var err error
+	wrapWithMsg := "postgresEventStore.LoadEventStream"
+
+	queryTemplate := `SELECT id, stream_id, event_name, payload, payload_bytes, stream_version FROM %name%
+						WHERE stream_id = $1 AND stream_version >= $2
+						ORDER BY stream_version ASC
+						LIMIT $3`
+	query := strings.Replace(queryTemplate, "%name%", s.eventStoreTableName, 1)
+
+	eventRows, err := s.db.Query(query, streamID.String(), fromVersion, maxEvents)
+	if err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	storedEvents, err := s.unmarshalStoredEvents(eventRows)
+	if err != nil {
+		return nil, errors.Wrap(err, wrapWithMsg)
+	}
+
+	eventStream := make(EventStream, 0, len(storedEvents))
+
+	for _, storedEvent := range storedEvents {
+		eventStream = append(eventStream, storedEvent.Event)
+	}
+
+	return eventStream, nil
+}
+
+// AppendEventsToStream fails with a WrongExpectedVersionError, marked as shared.ErrConcurrencyConflict, if the stream
+// does not have the expected version or if the events don't continue it. The events must not have gaps.
+func (s *PostgresEventStore) AppendEventsToStream(streamID StreamID, expectedVersion ExpectedVersion, events ...DomainEvent) error {
+	var err error
+	wrapWithMsg := "postgresEventStore.AppendEventsToStream"
+
+	if len(events) == 0 {
+		err = errors.New("no events given")
+		return shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+	}
+
+	for i, event := range events[1:] {
+		if event.Meta().StreamVersion() != events[i].Meta().StreamVersion()+1 {
+			err = errors.Newf("event [%s] with version %d does not follow version %d", event.Meta().EventName(), event.Meta().StreamVersion(), events[i].Meta().StreamVersion())
+			return shared.MarkAndWrapError(err, shared.ErrInputIsInvalid, wrapWithMsg)
+		}
+	}
+
+	columns, err := s.newEventColumns(events)
+	if err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	if err = s.assertStreamVersion(tx, streamID, expectedVersion, events[0].Meta().StreamVersion()); err != nil {
+		_ = tx.Rollback()
+
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	if s.hooks.BeforeAppend != nil {
+		if err = s.hooks.BeforeAppend(tx, streamID, events); err != nil {
+			_ = tx.Rollback()
+
+			return errors.Wrap(err, wrapWithMsg)
+		}
+	}
+
+	if err = s.appendEventsToStream(tx, streamID, columns); err != nil {
+		_ = tx.Rollback()
+
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	if err = s.updateStreamVersion(tx, streamID, events[len(events)-1].Meta().StreamVersion()); err != nil {
+		_ = tx.Rollback()
+
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	if s.hooks.AfterAppend != nil {
+		if err = s.hooks.AfterAppend(tx, streamID, events); err != nil {
+			_ = tx.Rollback()
+
+			return errors.Wrap(err, wrapWithMsg)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	return nil
+}
+
+func (s *PostgresEventStore) PurgeEventStream(streamID StreamID) error {
+	var err error
+	wrapWithMsg := "postgresEventStore.PurgeEventStream"
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	// this locks the stream, so that no events can be appended while it is purged
+	queryTemplate := `UPDATE %name% SET deleted = TRUE, updated_at = now() WHERE stream_id = $1`
+	query := strings.Replace(queryTemplate, "%name%", s.streamsTableName, 1)
+
+	if _, err = tx.Exec(query, streamID.String()); err != nil {
+		_ = tx.Rollback()
+
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	if s.hooks.BeforePurge != nil {
+		if err = s.hooks.BeforePurge(tx, streamID); err != nil {
+			_ = tx.Rollback()
+
+			return errors.Wrap(err, wrapWithMsg)
+		}
+	}
+
+	queryTemplate = `DELETE FROM %name% WHERE stream_id = $1`
+	query = strings.Replace(queryTemplate, "%name%", s.eventStoreTableName, 1)
+
+	if _, err = tx.Exec(query, streamID.String()); err != nil {
+		_ = tx.Rollback()
+
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	return nil
+}
+
+// Subscribe calls handle for all events after the position whose stream IDs start with streamIDPrefix,
+// ordered by position, and then polls for new events until the context is done.
+// Events of transactions which commit out of position order can be missed, so handlers should be idempotent and
+// subscribers should restart from a position a bit before the last handled one.
+func (s *PostgresEventStore) Subscribe(
+	ctx context.Context,
+	streamIDPrefix string,
+	afterPosition uint,
+	pollInterval time.Duration,
+	handle func(storedEvent StoredEvent) error,
+) error {
+
+	wrapWithMsg := "postgresEventStore.Subscribe"
+
+	queryTemplate := `SELECT id, stream_id, event_name, payload, payload_bytes, stream_version FROM %name%
+						WHERE id > $1 AND starts_with(stream_id, $2)
+						ORDER BY id ASC
+						LIMIT 100`
+	query := strings.Replace(queryTemplate, "%name%", s.eventStoreTableName, 1)
+
+	for {
+		eventRows, err := s.db.QueryContext(ctx, query, afterPosition, streamIDPrefix)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		storedEvents, err := s.unmarshalStoredEvents(eventRows)
+		if err != nil {
+			return errors.Wrap(err, wrapWithMsg)
+		}
+
+		for _, storedEvent := range storedEvents {
+			if err = handle(storedEvent); err != nil {
+				return errors.Wrap(err, wrapWithMsg)
+			}
+
+			afterPosition = storedEvent.Position
+		}
+
+		if len(storedEvents) > 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// ExportEventStreams calls forEachStream with all stored events of one stream at a time, ordered by stream and version.
+// Binary payloads are converted to JSON, so that exports don't depend on the configured payload codec.
+func (s *PostgresEventStore) ExportEventStreams(forEachStream func(events []ExportedEvent) error) error {
+	wrapWithMsg := "postgresEventStore.ExportEventStreams"
+
+	queryTemplate := `SELECT stream_id, stream_version, event_name, occurred_at, payload, payload_bytes FROM %name%
+						ORDER BY stream_id ASC, stream_version ASC`
+	query := strings.Replace(queryTemplate, "%name%", s.eventStoreTableName, 1)
+
+	eventRows, err := s.db.Query(query)
+	if err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	defer eventRows.Close()
+
+	var stream []ExportedEvent
+
+	for eventRows.Next() {
+		var event ExportedEvent
+		var occurredAt time.Time
+		var jsonPayload, binaryPayload []byte
+
+		if err = eventRows.Scan(&event.StreamID, &event.StreamVersion, &event.EventName, &occurredAt, &jsonPayload, &binaryPayload); err != nil {
+			return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		event.Payload, err = s.payloadCodecs.toJSON(event.EventName, jsonPayload, binaryPayload, event.StreamVersion)
+		if err != nil {
+			return errors.Wrap(err, wrapWithMsg)
+		}
+
+		event.OccurredAt = occurredAt.UTC().Format(time.RFC3339Nano)
+
+		if len(stream) > 0 && stream[0].StreamID != event.StreamID {
+			if err = forEachStream(stream); err != nil {
+				return errors.Wrap(err, wrapWithMsg)
+			}
+
+			stream = nil
+		}
+
+		stream = append(stream, event)
+	}
+
+	if err = eventRows.Err(); err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	if len(stream) > 0 {
+		if err = forEachStream(stream); err != nil {
+			return errors.Wrap(err, wrapWithMsg)
+		}
+	}
+
+	return nil
+}
+
+// ConvertEventPayloads rewrites all streams with payloads which are not stored in the WriteFormat of the payload codecs.
+// Each stream is converted in its own transaction and gets a new hash chain, so the event store should be verified first.
+// It returns the number of converted streams.
+func (s *PostgresEventStore) ConvertEventPayloads() (uint, error) {
+	var err error
+	var converted uint
+	wrapWithMsg := "postgresEventStore.ConvertEventPayloads"
+
+	var condition string
+
+	switch s.payloadCodecs.WriteFormat {
+	case JSONPayloads:
+		condition = "payload IS NULL"
+	case BinaryPayloads:
+		condition = "payload_bytes IS NULL"
+	default:
+		err = errors.Newf("unknown payload format [%s]", s.payloadCodecs.WriteFormat)
+		return 0, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	streamIDs, err := s.retrieveStreamIDs(condition)
+	if err != nil {
+		return 0, errors.Wrap(err, wrapWithMsg)
+	}
+
+	for _, streamID := range streamIDs {
+		if err = s.convertEventStreamPayloads(NewStreamID(streamID)); err != nil {
+			return converted, errors.Wrapf(err, "%s: stream [%s]", wrapWithMsg, streamID)
+		}
+
+		converted++
+	}
+
+	return converted, nil
+}
+
+// RechainEventStreams recomputes the payload and chain hashes of all streams with the chain key, e.g. after the key
+// was changed. Each stream is rechained in its own transaction, so the event store should be verified first.
+// It returns the number of rechained streams.
+func (s *PostgresEventStore) RechainEventStreams() (uint, error) {
+	var err error
+	var rechained uint
+	wrapWithMsg := "postgresEventStore.RechainEventStreams"
+
+	streamIDs, err := s.retrieveStreamIDs("TRUE")
+	if err != nil {
+		return 0, errors.Wrap(err, wrapWithMsg)
+	}
+
+	for _, streamID := range streamIDs {
+		if err = s.rechainEventStream(NewStreamID(streamID)); err != nil {
+			return rechained, errors.Wrapf(err, "%s: stream [%s]", wrapWithMsg, streamID)
+		}
+
+		rechained++
+	}
+
+	return rechained, nil
+}
+
+// CheckEventStreams loads all streams with the CorruptEventPolicy of the store and returns the number of streams.
+// With QuarantineCorruptEvents it fills the quarantine with all corrupt events.
+func (s *PostgresEventStore) CheckEventStreams() (uint, error) {
+	var err error
+	var streams uint
+	wrapWithMsg := "postgresEventStore.CheckEventStreams"
+
+	streamIDs, err := s.retrieveStreamIDs("TRUE")
+	if err != nil {
+		return 0, errors.Wrap(err, wrapWithMsg)
+	}
+
+	for _, streamID := range streamIDs {
+		if _, err = s.LoadEventStream(NewStreamID(streamID), 0, math.MaxUint32); err != nil {
+			return streams, errors.Wrap(err, wrapWithMsg)
+		}
+
+		streams++
+	}
+
+	return streams, nil
+}
+
+// RetrieveStreamMetadata fails with shared.ErrNotFound if the stream never had events, purged streams are found.
+func (s *PostgresEventStore) RetrieveStreamMetadata(streamID StreamID) (StreamMetadata, error) {
+	wrapWithMsg := "postgresEventStore.RetrieveStreamMetadata"
+
+	queryTemplate := `SELECT stream_id, current_version, created_at, updated_at, deleted FROM %name% WHERE stream_id = $1`
+	query := strings.Replace(queryTemplate, "%name%", s.streamsTableName, 1)
+
+	metadata, err := s.scanStreamMetadata(s.db.QueryRow(query, streamID.String()))
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		err = errors.Newf("stream [%s] not found", streamID.String())
+		return StreamMetadata{}, shared.MarkAndWrapError(err, shared.ErrNotFound, wrapWithMsg)
+	case err != nil:
+		return StreamMetadata{}, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	return metadata, nil
+}
+
+// ListStreams returns the metadata of at most maxStreams streams whose IDs start with streamIDPrefix,
+// ordered by stream ID. The next page starts after the last stream ID of the previous one, the first after "".
+func (s *PostgresEventStore) ListStreams(streamIDPrefix string, afterStreamID string, maxStreams uint) ([]StreamMetadata, error) {
+	var err error
+	var streams []StreamMetadata
+	wrapWithMsg := "postgresEventStore.ListStreams"
+
+	queryTemplate := `SELECT stream_id, current_version, created_at, updated_at, deleted FROM %name%
+						WHERE stream_id > $1 AND starts_with(stream_id, $2)
+						ORDER BY stream_id ASC
+						LIMIT $3`
+	query := strings.Replace(queryTemplate, "%name%", s.streamsTableName, 1)
+
+	streamRows, err := s.db.Query(query, afterStreamID, streamIDPrefix, maxStreams)
+	if err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	defer streamRows.Close()
+
+	for streamRows.Next() {
+		var metadata StreamMetadata
+
+		if metadata, err = s.scanStreamMetadata(streamRows); err != nil {
+			return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		streams = append(streams, metadata)
+	}
+
+	if err = streamRows.Err(); err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	return streams, nil
+}
+
+// QueryEvents returns one page of the events which match the query, ordered by position.
+func (s *PostgresEventStore) QueryEvents(query EventQuery) (EventPage, error) {
+	var err error
+	wrapWithMsg := "postgresEventStore.QueryEvents"
+
+	if query, err = BuildEventQuery(query); err != nil {
+		return EventPage{}, errors.Wrap(err, wrapWithMsg)
+	}
+
+	conditions, args := query.conditions([]interface{}{query.AfterPosition})
+	args = append(args, query.PageSize+1) // one more to find out if there is a next page
+
+	queryTemplate := `SELECT id, stream_id, stream_version, event_name, occurred_at FROM %name%
+						WHERE %conditions%
+						ORDER BY id ASC
+						LIMIT $%limit%`
+	sqlQuery := strings.Replace(queryTemplate, "%name%", s.eventStoreTableName, 1)
+	sqlQuery = strings.Replace(sqlQuery, "%conditions%", strings.Join(append([]string{"id > $1"}, conditions...), " AND "), 1)
+	sqlQuery = strings.Replace(sqlQuery, "%limit%", fmt.Sprint(len(args)), 1)
+
+	eventRows, err := s.db.Query(sqlQuery, args...)
+	if err != nil {
+		return EventPage{}, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	defer eventRows.Close()
+
+	var page EventPage
+
+	for eventRows.Next() {
+		var event StoredEventMetadata
+		var streamID string
+
+		if err = eventRows.Scan(&event.Position, &streamID, &event.StreamVersion, &event.EventName, &event.OccurredAt); err != nil {
+			return EventPage{}, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		event.StreamID = NewStreamID(streamID)
+		page.Events = append(page.Events, event)
+	}
+
+	if err = eventRows.Err(); err != nil {
+		return EventPage{}, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	if uint(len(page.Events)) > query.PageSize {
+		page.Events = page.Events[:query.PageSize]
+		page.NextAfterPosition = page.Events[query.PageSize-1].Position
+	}
+
+	return page, nil
+}
+
+// CountEventsPerDay counts the events which match the filter per day (UTC) and event name, ordered by both.
+func (s *PostgresEventStore) CountEventsPerDay(filter EventFilter) ([]EventCount, error) {
+	var err error
+	var counts []EventCount
+	wrapWithMsg := "postgresEventStore.CountEventsPerDay"
+
+	if err = ValidateEventCountFilter(filter); err != nil {
+		return nil, errors.Wrap(err, wrapWithMsg)
+	}
+
+	conditions, args := filter.conditions(nil)
+
+	queryTemplate := `SELECT date_trunc('day', occurred_at AT TIME ZONE 'UTC') AS day, event_name, COUNT(*) FROM %name%
+						WHERE %conditions%
+						GROUP BY day, event_name
+						ORDER BY day ASC, event_name ASC`
+	sqlQuery := strings.Replace(queryTemplate, "%name%", s.eventStoreTableName, 1)
+	sqlQuery = strings.Replace(sqlQuery, "%conditions%", strings.Join(append([]string{"TRUE"}, conditions...), " AND "), 1)
+
+	countRows, err := s.db.Query(sqlQuery, args...)
+	if err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	defer countRows.Close()
+
+	for countRows.Next() {
+		var count EventCount
+
+		if err = countRows.Scan(&count.Day, &count.EventName, &count.Count); err != nil {
+			return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		count.Day = count.Day.UTC()
+		counts = append(counts, count)
+	}
+
+	if err = countRows.Err(); err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	return counts, nil
+}
+
+func (s *PostgresEventStore) IsEmpty() (bool, error) {
+	var hasEvents bool
+
+	queryTemplate := `SELECT EXISTS (SELECT 1 FROM %name%)`
+	query := strings.Replace(queryTemplate, "%name%", s.eventStoreTableName, 1)
+
+	if err := s.db.QueryRow(query).Scan(&hasEvents); err != nil {
+		return false, shared.MarkAndWrapError(err, shared.ErrTechnical, "postgresEventStore.IsEmpty")
+	}
+
+	return !hasEvents, nil
+}
+
+/***** local methods for reading from and writing to the event store *****/
+
+func (s *PostgresEventStore) scanStreamMetadata(row interface {
+	Scan(dest ...interface{}) error
+}) (StreamMetadata, error) {
+	var streamID string
+	var metadata StreamMetadata
+
+	err := row.Scan(&streamID, &metadata.CurrentVersion, &metadata.CreatedAt, &metadata.UpdatedAt, &metadata.IsDeleted)
+	if err != nil {
+		return StreamMetadata{}, err
+	}
+
+	metadata.StreamID = NewStreamID(streamID)
+
+	return metadata, nil
+}
+
+// unmarshalStoredEvents reads rows of (id, stream_id, event_name, payload, payload_bytes, stream_version)
+// and applies the CorruptEventPolicy.
+func (s *PostgresEventStore) unmarshalStoredEvents(eventRows *sql.Rows) ([]StoredEvent, error) {
+	var err error
+	var storedEvents []StoredEvent
+	var corruptEvents []CorruptEvent
+	wrapWithMsg := "unmarshalStoredEvents"
+
+	defer eventRows.Close()
+
+	for eventRows.Next() {
+		var eventID, streamVersion uint
+		var streamID, eventName string
+		var jsonPayload, binaryPayload []byte
+		var domainEvent DomainEvent
+
+		if err = eventRows.Scan(&eventID, &streamID, &eventName, &jsonPayload, &binaryPayload, &streamVersion); err != nil {
+			return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		if domainEvent, err = s.payloadCodecs.unmarshal(eventName, jsonPayload, binaryPayload, streamVersion); err != nil {
+			if s.corruptEventPolicy == FailOnCorruptEvents {
+				err = errors.Wrapf(err, "stream [%s] version [%d] event id [%d]", streamID, streamVersion, eventID)
+				return nil, shared.MarkAndWrapError(err, shared.ErrUnmarshalingFailed, wrapWithMsg)
+			}
+
+			corruptEvents = append(corruptEvents, CorruptEvent{
+				EventID:       eventID,
+				StreamID:      streamID,
+				StreamVersion: streamVersion,
+				EventName:     eventName,
+				Reason:        err.Error(),
+			})
+
+			continue
+		}
+
+		storedEvents = append(storedEvents, StoredEvent{Position: eventID, StreamID: NewStreamID(streamID), Event: domainEvent})
+	}
+
+	if err = eventRows.Err(); err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	if s.corruptEventPolicy == QuarantineCorruptEvents {
+		for _, corruptEvent := range corruptEvents {
+			if err = s.eventQuarantine.add(corruptEvent); err != nil {
+				return nil, errors.Wrap(err, wrapWithMsg)
+			}
+		}
+	}
+
+	return storedEvents, nil
+}
+
+func (s *PostgresEventStore) assertStreamVersion(
+	tx *sql.Tx,
+	streamID StreamID,
+	expectedVersion ExpectedVersion,
+	firstNewVersion uint,
+) error {
+
+	var actualVersion uint
+	var isDeleted bool
+	wrapWithMsg := "assertStreamVersion"
+
+	// FOR UPDATE makes concurrent appends to the same stream wait for each other, so that the later one
+	// sees the version which the earlier one has written. The first appends of a new stream can still
+	// race, but then the unique index on the events fails one of them.
+	queryTemplate := `SELECT current_version, deleted FROM %name% WHERE stream_id = $1 FOR UPDATE`
+	query := strings.Replace(queryTemplate, "%name%", s.streamsTableName, 1)
+
+	stmt, err := s.statements.inTx(tx, query)
+	if err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	err = stmt.QueryRow(streamID.String()).Scan(&actualVersion, &isDeleted)
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		actualVersion = 0
+	case err != nil:
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	case isDeleted:
+		actualVersion = 0 // a purged stream has no events anymore, so it can be started again
+	}
+
+	if !expectedVersion.IsSatisfiedBy(actualVersion) || firstNewVersion != actualVersion+1 {
+		err := NewWrongExpectedVersionError(expectedVersion, actualVersion)
+		return errors.Wrapf(err, "%s: stream [%s]", wrapWithMsg, streamID.String())
+	}
+
+	return nil
+}
+
+// appendEventsToStream inserts all events with one statement. The payload hashes are computed by Postgres from the
+// normalized jsonb text or the binary payload, then the new events are chained to the ones before them.
+func (s *PostgresEventStore) appendEventsToStream(tx *sql.Tx, streamID StreamID, columns newEventColumns) error {
+	wrapWithMsg := "appendEventsToStream"
+
+	queryTemplate := `WITH new_events AS (
+							SELECT stream_version, event_name, occurred_at, payload::jsonb AS payload, decode(payload_bytes, 'hex') AS payload_bytes
+							FROM unnest($2::integer[], $3::varchar[], $4::timestamptz[], $5::text[], $6::text[])
+								AS new_event(stream_version, event_name, occurred_at, payload, payload_bytes)
+						)
+						INSERT INTO %name% (stream_id, stream_version, event_name, occurred_at, payload, payload_bytes, payload_hash)
+						SELECT $1::varchar, stream_version, event_name, occurred_at, payload, payload_bytes, %payloadhash%
+						FROM new_events`
+	query := strings.Replace(queryTemplate, "%name%", s.eventStoreTableName, 1)
+	query = strings.Replace(query, "%payloadhash%", payloadHashSQL("new_events.payload", "new_events.payload_bytes"), 1)
+
+	stmt, err := s.statements.inTx(tx, query)
+	if err != nil {
+		return errors.Wrap(s.mapEventStorePostgresErrors(err), wrapWithMsg)
+	}
+
+	_, err = stmt.Exec(
+		streamID.String(),
+		pq.Array(columns.streamVersions),
+		pq.Array(columns.eventNames),
+		pq.Array(columns.occurredAts),
+		pq.GenericArray{A: columns.jsonPayloads},
+		pq.GenericArray{A: columns.binaryPayloads},
+	)
+
+	if err != nil {
+		return errors.Wrap(s.mapEventStorePostgresErrors(err), wrapWithMsg)
+	}
+
+	if err = s.chainEventStream(tx, streamID, uint(columns.streamVersions[0])); err != nil {
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	return nil
+}
+
+// updateStreamVersion creates the metadata of a new stream or updates it, a purged stream is started again.
+func (s *PostgresEventStore) updateStreamVersion(tx *sql.Tx, streamID StreamID, currentVersion uint) error {
+	queryTemplate := `INSERT INTO %name% AS stream (stream_id, current_version, created_at, updated_at, deleted)
+						VALUES ($1, $2, now(), now(), FALSE)
+						ON CONFLICT (stream_id) DO UPDATE SET
+							current_version = EXCLUDED.current_version,
+							created_at = CASE WHEN stream.deleted THEN EXCLUDED.created_at ELSE stream.created_at END,
+							updated_at = EXCLUDED.updated_at,
+							deleted = FALSE`
+	query := strings.Replace(queryTemplate, "%name%", s.streamsTableName, 1)
+
+	stmt, err := s.statements.inTx(tx, query)
+	if err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, "updateStreamVersion")
+	}
+
+	if _, err = stmt.Exec(streamID.String(), currentVersion); err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, "updateStreamVersion")
+	}
+
+	return nil
+}
+
+// newEventColumns marshals the events before the transaction is started, so that it is not held open meanwhile.
+func (s *PostgresEventStore) newEventColumns(events []DomainEvent) (newEventColumns, error) {
+	columns := newEventColumns{
+		streamVersions: make([]int64, 0, len(events)),
+		eventNames:     make([]string, 0, len(events)),
+		occurredAts:    make([]string, 0, len(events)),
+		jsonPayloads:   make([]interface{}, 0, len(events)),
+		binaryPayloads: make([]interface{}, 0, len(events)),
+	}
+
+	for _, event := range events {
+		jsonPayload, binaryPayload, err := s.payloadCodecs.marshal(event)
+		if err != nil {
+			return newEventColumns{}, errors.Wrap(err, "newEventColumns")
+		}
+
+		// text[] can't carry arbitrary bytes, so binary payloads are sent hex encoded and decoded by Postgres
+		if binaryPayload != nil {
+			binaryPayload = hex.EncodeToString(binaryPayload.([]byte))
+		}
+
+		columns.streamVersions = append(columns.streamVersions, int64(event.Meta().StreamVersion()))
+		columns.eventNames = append(columns.eventNames, event.Meta().EventName())
+		columns.occurredAts = append(columns.occurredAts, event.Meta().OccurredAt())
+		columns.jsonPayloads = append(columns.jsonPayloads, jsonPayload)
+		columns.binaryPayloads = append(columns.binaryPayloads, binaryPayload)
+	}
+
+	return columns, nil
+}
+
+// retrieveStreamIDs returns the IDs of all streams with at least one event which matches the SQL condition.
+func (s *PostgresEventStore) retrieveStreamIDs(condition string) ([]string, error) {
+	var err error
+	var streamIDs []string
+	wrapWithMsg := "retrieveStreamIDs"
+
+	queryTemplate := `SELECT DISTINCT stream_id FROM %name% WHERE %condition% ORDER BY stream_id ASC`
+	query := strings.Replace(queryTemplate, "%name%", s.eventStoreTableName, 1)
+	query = strings.Replace(query, "%condition%", condition, 1)
+
+	streamRows, err := s.db.Query(query)
+	if err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	defer streamRows.Close()
+
+	for streamRows.Next() {
+		var streamID string
+
+		if err = streamRows.Scan(&streamID); err != nil {
+			return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		streamIDs = append(streamIDs, streamID)
+	}
+
+	if err = streamRows.Err(); err != nil {
+		return nil, shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	return streamIDs, nil
+}
+
+// convertEventStreamPayloads rewrites all events of the stream and then chains them again.
+func (s *PostgresEventStore) convertEventStreamPayloads(streamID StreamID) error {
+	var err error
+	wrapWithMsg := "convertEventStreamPayloads"
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	queryTemplate := `SELECT event_name, payload, payload_bytes, stream_version FROM %name%
+						WHERE stream_id = $1
+						ORDER BY stream_version ASC
+						FOR UPDATE`
+	query := strings.Replace(queryTemplate, "%name%", s.eventStoreTableName, 1)
+
+	eventRows, err := tx.Query(query, streamID.String())
+	if err != nil {
+		_ = tx.Rollback()
+
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	var events EventStream
+
+	for eventRows.Next() {
+		var eventName string
+		var jsonPayload, binaryPayload []byte
+		var streamVersion uint
+		var event DomainEvent
+
+		if err = eventRows.Scan(&eventName, &jsonPayload, &binaryPayload, &streamVersion); err != nil {
+			_ = eventRows.Close()
+			_ = tx.Rollback()
+
+			return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		if event, err = s.payloadCodecs.unmarshal(eventName, jsonPayload, binaryPayload, streamVersion); err != nil {
+			_ = eventRows.Close()
+			_ = tx.Rollback()
+
+			return errors.Wrap(err, wrapWithMsg)
+		}
+
+		events = append(events, event)
+	}
+
+	if err = eventRows.Err(); err != nil {
+		_ = tx.Rollback()
+
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	_ = eventRows.Close()
+
+	queryTemplate = `UPDATE %name% SET payload = $3::jsonb, payload_bytes = $4::bytea, payload_hash = %payloadhash%
+						WHERE stream_id = $1 AND stream_version = $2`
+	query = strings.Replace(queryTemplate, "%name%", s.eventStoreTableName, 1)
+	query = strings.Replace(query, "%payloadhash%", payloadHashSQL("$3", "$4"), 1)
+
+	for _, event := range events {
+		var jsonPayload, binaryPayload interface{}
+
+		if jsonPayload, binaryPayload, err = s.payloadCodecs.marshal(event); err != nil {
+			_ = tx.Rollback()
+
+			return errors.Wrap(err, wrapWithMsg)
+		}
+
+		_, err = tx.Exec(query, streamID.String(), event.Meta().StreamVersion(), jsonPayload, binaryPayload)
+		if err != nil {
+			_ = tx.Rollback()
+
+			return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+	}
+
+	if err = s.chainEventStream(tx, streamID, 1); err != nil {
+		_ = tx.Rollback()
+
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	return nil
+}
+
+// rechainEventStream recomputes the payload hashes of the stream and then chains them again.
+func (s *PostgresEventStore) rechainEventStream(streamID StreamID) error {
+	var err error
+	wrapWithMsg := "rechainEventStream"
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	queryTemplate := `UPDATE %name% SET payload_hash = %payloadhash% WHERE stream_id = $1`
+	query := strings.Replace(queryTemplate, "%name%", s.eventStoreTableName, 1)
+	query = strings.Replace(query, "%payloadhash%", payloadHashSQL("payload", "payload_bytes"), 1)
+
+	if _, err = tx.Exec(query, streamID.String()); err != nil {
+		_ = tx.Rollback()
+
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	if err = s.chainEventStream(tx, streamID, 1); err != nil {
+		_ = tx.Rollback()
+
+		return errors.Wrap(err, wrapWithMsg)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	return nil
+}
+
+// chainEventStream computes the chain hashes of the events from fromVersion on with ChainHash, each one from the
+// stored payload hash and the chain hash of the event before it. The stream must be locked by the transaction.
+// Streams with gaps stay unchained from the gap on, so that verifying still reports them.
+func (s *PostgresEventStore) chainEventStream(tx *sql.Tx, streamID StreamID, fromVersion uint) error {
+	var err error
+	var previousChainHash string
+	var streamVersions []int64
+	var chainHashes []string
+	wrapWithMsg := "chainEventStream"
+
+	queryTemplate := `SELECT stream_version, event_name, occurred_at, payload_hash, COALESCE(chain_hash, '') FROM %name%
+						WHERE stream_id = $1 AND stream_version >= $2
+						ORDER BY stream_version ASC`
+	query := strings.Replace(queryTemplate, "%name%", s.eventStoreTableName, 1)
+
+	stmt, err := s.statements.inTx(tx, query)
+	if err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	eventRows, err := stmt.Query(streamID.String(), int64(fromVersion)-1)
+	if err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	nextStreamVersion := fromVersion
+
+	for eventRows.Next() {
+		var streamVersion uint
+		var eventName, payloadHash, chainHash string
+		var occurredAt time.Time
+
+		if err = eventRows.Scan(&streamVersion, &eventName, &occurredAt, &payloadHash, &chainHash); err != nil {
+			_ = eventRows.Close()
+
+			return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+		}
+
+		if streamVersion+1 == fromVersion {
+			previousChainHash = chainHash
+			continue
+		}
+
+		if streamVersion != nextStreamVersion {
+			break
+		}
+
+		previousChainHash = ChainHash(s.chainKey, previousChainHash, payloadHash, streamID.String(), streamVersion, eventName, occurredAt)
+		streamVersions = append(streamVersions, int64(streamVersion))
+		chainHashes = append(chainHashes, previousChainHash)
+		nextStreamVersion++
+	}
+
+	if err = eventRows.Err(); err != nil {
+		_ = eventRows.Close()
+
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	_ = eventRows.Close()
+
+	if len(streamVersions) == 0 {
+		return nil
+	}
+
+	queryTemplate = `UPDATE %name% AS current SET chain_hash = chained.chain_hash
+						FROM unnest($2::integer[], $3::text[]) AS chained(stream_version, chain_hash)
+						WHERE current.stream_id = $1 AND current.stream_version = chained.stream_version`
+	query = strings.Replace(queryTemplate, "%name%", s.eventStoreTableName, 1)
+
+	if stmt, err = s.statements.inTx(tx, query); err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	if _, err = stmt.Exec(streamID.String(), pq.Array(streamVersions), pq.Array(chainHashes)); err != nil {
+		return shared.MarkAndWrapError(err, shared.ErrTechnical, wrapWithMsg)
+	}
+
+	return nil
+}
+
+func (s *PostgresEventStore) mapEventStorePostgresErrors(err error) error {
+	switch actualErr := err.(type) {
+	case *pq.Error:
+		switch actualErr.Code {
+		case "23505":
+			return errors.Mark(err, shared.ErrConcurrencyConflict)
+		}
+	}
+
+	return errors.Mark(err, shared.ErrTechnical) // some other DB error (Tx closed, wrong table, ...)
+}
+
+// payloadHashSQL hashes whichever of the two payload parameters is not NULL.
+func payloadHashSQL(jsonPayload, binaryPayload string) string {
+	return fmt.Sprintf(
+		`encode(sha256(COALESCE(convert_to(%s::jsonb::text, 'UTF8'), %s::bytea)), 'hex')`,
+		jsonPayload,
+		binaryPayload,
+	)
+}
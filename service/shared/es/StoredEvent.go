@@ -0,0 +1,9 @@
+package es
+
+// StoredEvent is an event together with its stream and its position in the whole event store.
+// Positions are increasing, but they can have gaps.
+type StoredEvent struct {
+	Position uint
+	StreamID StreamID
+	Event    DomainEvent
+}
@@ -0,0 +1,14 @@
+package es
+
+import (
+	"time"
+)
+
+// StoredEventMetadata describes a stored event without its payload, which can contain personal data.
+type StoredEventMetadata struct {
+	Position      uint
+	StreamID      StreamID
+	StreamVersion uint
+	EventName     string
+	OccurredAt    time.Time
+}
@@ -0,0 +1,15 @@
+package es
+
+import (
+	"time"
+)
+
+// StreamMetadata is maintained together with the events of a stream, so it can be read without loading them.
+// A purged stream keeps its metadata with IsDeleted, appending to it starts the stream again.
+type StreamMetadata struct {
+	StreamID       StreamID
+	CurrentVersion uint
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	IsDeleted      bool
+}
@@ -0,0 +1,26 @@
+package es
+
+import (
+	"fmt"
+
+	"github.com/AntonStoeckl/go-iddd/service/shared"
+	"github.com/cockroachdb/errors"
+)
+
+// WrongExpectedVersionError reports the actual version of a stream which did not have the expected version.
+// Callers can get it with errors.As, e.g. to tell clients which version they have to read first.
+type WrongExpectedVersionError struct {
+	ExpectedVersion ExpectedVersion
+	ActualVersion   uint
+}
+
+// NewWrongExpectedVersionError returns a WrongExpectedVersionError marked as shared.ErrConcurrencyConflict.
+func NewWrongExpectedVersionError(expectedVersion ExpectedVersion, actualVersion uint) error {
+	err := &WrongExpectedVersionError{ExpectedVersion: expectedVersion, ActualVersion: actualVersion}
+
+	return errors.Mark(err, shared.ErrConcurrencyConflict)
+}
+
+func (err *WrongExpectedVersionError) Error() string {
+	return fmt.Sprintf("expected version %s, actual version %d", err.ExpectedVersion, err.ActualVersion)
+}
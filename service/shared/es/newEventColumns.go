@@ -0,0 +1,10 @@
+package es
+
+// newEventColumns holds the column values of the events to append, one array per column.
+type newEventColumns struct {
+	streamVersions []int64
+	eventNames     []string
+	occurredAts    []string
+	jsonPayloads   []interface{}
+	binaryPayloads []interface{}
+}
@@ -0,0 +1,39 @@
+package es
+
+import (
+	"database/sql"
+	"sync"
+)
+
+// preparedStatements prepares each query once. database/sql then prepares the statement on every connection
+// where it is used for the first time and reuses it there, also within transactions (see sql.Tx.Stmt).
+type preparedStatements struct {
+	db         *sql.DB
+	mutex      sync.Mutex
+	statements map[string]*sql.Stmt
+}
+
+func newPreparedStatements(db *sql.DB) *preparedStatements {
+	return &preparedStatements{
+		db:         db,
+		statements: make(map[string]*sql.Stmt),
+	}
+}
+
+func (prepared *preparedStatements) inTx(tx *sql.Tx, query string) (*sql.Stmt, error) {
+	prepared.mutex.Lock()
+	defer prepared.mutex.Unlock()
+
+	statement, ok := prepared.statements[query]
+	if !ok {
+		var err error
+
+		if statement, err = prepared.db.Prepare(query); err != nil {
+			return nil, err
+		}
+
+		prepared.statements[query] = statement
+	}
+
+	return tx.Stmt(statement), nil
+}